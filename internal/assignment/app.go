@@ -0,0 +1,115 @@
+package assignment
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/infra/persistence"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/scheduler"
+	"github.com/gofiber/fiber/v3"
+)
+
+// Config holds the assignment module settings
+type Config struct {
+	// GracePeriod is how long after the due date a submission is still on
+	// time, zero means none
+	GracePeriod time.Duration
+}
+
+type Module struct {
+	name    string
+	engine  *fiber.App
+	db      *sql.DB
+	storage shared.Storage
+	config  Config
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB, storage shared.Storage, config Config) Module {
+	return Module{name: name, engine: engine, db: db, storage: storage, config: config}
+}
+
+func (mod Module) submissions() application.SubmissionInteractor {
+	return application.NewSubmissionInteractor(
+		persistence.NewAssignmentRepository(mod.db),
+		persistence.NewSubmissionRepository(mod.db),
+		persistence.NewExtensionRepository(mod.db),
+		mod.storage,
+		application.SubmissionPolicy{GracePeriod: mod.config.GracePeriod},
+	)
+}
+
+// MissingSubmissionsJob marks missing the work of the students whose
+// deadline passed without a submission
+func (mod Module) MissingSubmissionsJob() scheduler.Job {
+	interactor := mod.submissions()
+
+	return func(ctx context.Context, now time.Time) error {
+		missing, err := interactor.SweepMissing(ctx, now)
+		for _, m := range missing {
+			log.Printf("submission of student %s on assignment %s (%s) marked missing", m.StudentID.String(), m.AssignmentTitle, m.AssignmentID.String())
+		}
+		return err
+	}
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.engine.Group(mod.name, httpx.Authenticate())
+
+	assignments := persistence.NewAssignmentRepository(mod.db)
+
+	h := infra.NewAssignmentHandler(application.NewAssignmentInteractor(assignments, mod.storage))
+	s := infra.NewSubmissionHandler(mod.submissions())
+	g := infra.NewGradingHandler(application.NewGradingInteractor(
+		assignments,
+		persistence.NewSubmissionRepository(mod.db),
+		persistence.NewRubricRepository(mod.db),
+		persistence.NewFeedbackRepository(mod.db),
+	))
+	b := infra.NewGradebookHandler(application.NewGradebookInteractor(
+		assignments,
+		persistence.NewGradebookRepository(mod.db),
+	))
+	x := infra.NewExtensionHandler(application.NewExtensionInteractor(
+		assignments,
+		persistence.NewExtensionRepository(mod.db),
+	))
+
+	professor := httpx.RequireRoles(shared.RoleProfessor)
+	student := httpx.RequireRoles(shared.RoleStudent)
+	staff := httpx.RequireRoles(shared.RoleAdmin, shared.RoleProfessor)
+
+	group.Get("/:id", h.GetAssignment)
+	group.Put("/:id", professor, h.UpdateAssignment)
+	group.Post("/:id/publish", professor, h.Publish)
+	group.Post("/:id/unpublish", professor, h.Unpublish)
+	group.Post("/:id/attachments", professor, h.Attach)
+	group.Delete("/:id/attachments/:attachmentId", professor, h.Detach)
+
+	group.Post("/:id/submissions", student, s.Submit)
+	group.Get("/:id/submissions", staff, s.List)
+	group.Get("/:id/submissions/:studentId", s.Get)
+	group.Get("/:id/submissions/:studentId/history", s.History)
+
+	group.Get("/:id/rubric", g.GetRubric)
+	group.Put("/:id/rubric", professor, g.SetRubric)
+	group.Delete("/:id/rubric", professor, g.DeleteRubric)
+	group.Get("/:id/submissions/:studentId/feedback", g.Feedback)
+	group.Put("/:id/submissions/:studentId/feedback", professor, g.Grade)
+	group.Post("/:id/submissions/:studentId/feedback/release", professor, g.Release)
+
+	// assignments are created and listed from their section
+	sections := mod.engine.Group("/sections", httpx.Authenticate())
+	sections.Get("/:id/assignments", h.ListBySection)
+	sections.Post("/:id/assignments", professor, h.CreateAssignment)
+	sections.Get("/:id/extensions", staff, x.List)
+	sections.Post("/:id/extensions", professor, x.Grant)
+	sections.Delete("/:id/extensions/:extensionId", professor, x.Revoke)
+	sections.Get("/:id/gradebook", staff, b.Export)
+	sections.Post("/:id/gradebook", professor, b.Import)
+}
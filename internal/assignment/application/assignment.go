@@ -0,0 +1,360 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// assignmentsFolder is the storage folder of the assignment attachments
+const assignmentsFolder = "assignments"
+
+type (
+	AssignmentInput struct {
+		Title       string  `json:"title" validate:"required,max=200"`
+		Description string  `json:"description" validate:"max=10000"`
+		Type        string  `json:"assignment_type" validate:"required,oneof=homework project exam quiz essay"`
+		MaxPoints   float64 `json:"max_points" validate:"required,gt=0,lt=1000"`
+		// DueDate is an RFC 3339 timestamp, empty for no deadline
+		DueDate string `json:"due_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	}
+
+	// FileInput is an uploaded file
+	FileInput struct {
+		Name        string
+		ContentType string
+		Size        int64
+		Content     io.Reader
+	}
+)
+
+type AssignmentInteractor interface {
+	Create(ctx context.Context, actor shared.Actor, sectionID string, in AssignmentInput) (*domain.Assignment, error)
+	Update(ctx context.Context, actor shared.Actor, id string, in AssignmentInput) (*domain.Assignment, error)
+	Publish(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error)
+	Unpublish(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error)
+	Get(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error)
+	// ListBySection returns every assignment to the professor and admins,
+	// students only get the published ones
+	ListBySection(ctx context.Context, actor shared.Actor, sectionID string) ([]*domain.Assignment, error)
+	Attach(ctx context.Context, actor shared.Actor, id string, file FileInput) (*domain.Assignment, error)
+	Detach(ctx context.Context, actor shared.Actor, id, attachmentID string) (*domain.Assignment, error)
+}
+
+type assignmentInteractor struct {
+	repository domain.AssignmentRepository
+	storage    shared.Storage
+}
+
+func NewAssignmentInteractor(r domain.AssignmentRepository, s shared.Storage) *assignmentInteractor {
+	return &assignmentInteractor{r, s}
+}
+
+func (interactor assignmentInteractor) Create(ctx context.Context, actor shared.Actor, sectionID string, in AssignmentInput) (*domain.Assignment, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	if err := authorizeProfessor(ctx, interactor.repository, actor, section); err != nil {
+		return nil, err
+	}
+
+	details, err := assignmentDetails(in)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := domain.NewAssignment(section, actor.ID, details)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Create(ctx, assignment); err != nil {
+		return nil, mapError(err)
+	}
+
+	return assignment, nil
+}
+
+func (interactor assignmentInteractor) Update(ctx context.Context, actor shared.Actor, id string, in AssignmentInput) (*domain.Assignment, error) {
+	assignment, err := interactor.owned(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := assignmentDetails(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := assignment.Edit(details); err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Update(ctx, assignment); err != nil {
+		return nil, mapError(err)
+	}
+
+	return assignment, nil
+}
+
+func (interactor assignmentInteractor) Publish(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error) {
+	assignment, err := interactor.owned(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment.Publish()
+
+	if err := interactor.repository.Update(ctx, assignment); err != nil {
+		return nil, mapError(err)
+	}
+
+	return assignment, nil
+}
+
+func (interactor assignmentInteractor) Unpublish(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error) {
+	assignment, err := interactor.owned(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment.Unpublish()
+
+	if err := interactor.repository.Update(ctx, assignment); err != nil {
+		return nil, mapError(err)
+	}
+
+	return assignment, nil
+}
+
+func (interactor assignmentInteractor) Get(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error) {
+	assignment, err := interactor.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedOnly, err := visibility(ctx, interactor.repository, actor, assignment.SectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// unpublished assignments don't exist for students
+	if publishedOnly && !assignment.IsPublished {
+		return nil, mapError(domain.ErrAssignmentNotFound)
+	}
+
+	return assignment, nil
+}
+
+func (interactor assignmentInteractor) ListBySection(ctx context.Context, actor shared.Actor, sectionID string) ([]*domain.Assignment, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	publishedOnly, err := visibility(ctx, interactor.repository, actor, section)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := interactor.repository.ListBySection(ctx, section, publishedOnly)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if assignments == nil {
+		assignments = []*domain.Assignment{}
+	}
+
+	return assignments, nil
+}
+
+func (interactor assignmentInteractor) Attach(ctx context.Context, actor shared.Actor, id string, file FileInput) (*domain.Assignment, error) {
+	assignment, err := interactor.owned(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := shared.NewAttachment(file.Name, file.ContentType, file.Size, time.Now())
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := assignment.Attach(attachment); err != nil {
+		return nil, mapError(err)
+	}
+
+	if attachment.URL, err = interactor.storage.Save(ctx, assignmentsFolder, assignment.ID.String(), attachment.StoredName(), file.Content); err != nil {
+		return nil, shared.ErrInternalWith(err, "cannot store the attachment")
+	}
+
+	// the limit is checked again when appending, another upload may have
+	// taken the last place meanwhile
+	updated, err := interactor.repository.Attach(ctx, assignment.ID, attachment, time.Now())
+	if err != nil {
+		// the file is useless without the record pointing to it
+		_ = interactor.storage.Delete(ctx, assignmentsFolder, assignment.ID.String(), attachment.StoredName())
+		return nil, mapError(err)
+	}
+
+	return updated, nil
+}
+
+func (interactor assignmentInteractor) Detach(ctx context.Context, actor shared.Actor, id, attachmentID string) (*domain.Assignment, error) {
+	assignment, err := interactor.owned(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fileID, err := valueobject.IDFromString(attachmentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid attachment id")
+	}
+
+	file, err := assignment.Detach(fileID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	updated, err := interactor.repository.Detach(ctx, assignment.ID, fileID, time.Now())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	// a file left behind is harmless, the record no longer points to it
+	_ = interactor.storage.Delete(ctx, assignmentsFolder, assignment.ID.String(), file.StoredName())
+
+	return updated, nil
+}
+
+func (interactor assignmentInteractor) find(ctx context.Context, id string) (*domain.Assignment, error) {
+	assignmentID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid assignment id")
+	}
+
+	assignment, err := interactor.repository.FindByID(ctx, assignmentID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return assignment, nil
+}
+
+// owned returns the assignment when the actor is the professor of its section
+func (interactor assignmentInteractor) owned(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error) {
+	assignment, err := interactor.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeProfessor(ctx, interactor.repository, actor, assignment.SectionID); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// authorizeProfessor checks that the actor is the professor assigned to the
+// section, the assignment may have been created by a previous one
+func authorizeProfessor(ctx context.Context, r domain.AssignmentRepository, actor shared.Actor, sectionID valueobject.ID) error {
+	if !actor.IsProfessor() {
+		return mapError(domain.ErrNotSectionProfessor)
+	}
+
+	membership, err := r.Membership(ctx, sectionID, actor.ID)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if !membership.IsProfessor {
+		return mapError(domain.ErrNotSectionProfessor)
+	}
+	return nil
+}
+
+// visibility tells if the actor only gets the published assignments of the section
+func visibility(ctx context.Context, r domain.AssignmentRepository, actor shared.Actor, sectionID valueobject.ID) (bool, error) {
+	membership, err := r.Membership(ctx, sectionID, actor.ID)
+	if err != nil {
+		return false, mapError(err)
+	}
+
+	switch {
+	case actor.IsAdmin(), actor.IsProfessor() && membership.IsProfessor:
+		return false, nil
+	case actor.IsStudent() && membership.IsStudent:
+		return true, nil
+	default:
+		return false, mapError(domain.ErrNotSectionMember)
+	}
+}
+
+func assignmentDetails(in AssignmentInput) (domain.Details, error) {
+	details := domain.Details{
+		Title:       in.Title,
+		Description: in.Description,
+		Type:        domain.Type(in.Type),
+		MaxPoints:   in.MaxPoints,
+	}
+
+	if in.DueDate != "" {
+		dueDate, err := time.Parse(time.RFC3339, in.DueDate)
+		if err != nil {
+			return domain.Details{}, shared.ErrInvalidInputWith(err, "invalid due date")
+		}
+		details.DueDate = &dueDate
+	}
+
+	return details, nil
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var appErr *shared.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, domain.ErrAssignmentNotFound),
+		errors.Is(err, domain.ErrSectionNotFound),
+		errors.Is(err, domain.ErrSubmissionNotFound),
+		errors.Is(err, domain.ErrExtensionNotFound),
+		errors.Is(err, domain.ErrRubricNotFound),
+		errors.Is(err, domain.ErrFeedbackNotFound),
+		errors.Is(err, shared.ErrAttachmentNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrNotSectionProfessor),
+		errors.Is(err, domain.ErrNotSectionMember),
+		errors.Is(err, domain.ErrNotEnrolledInSection):
+		return shared.ErrForbiddenWith(err, err.Error())
+	case errors.Is(err, domain.ErrEmptySubmission),
+		errors.Is(err, domain.ErrTooManySubmitFiles),
+		errors.Is(err, domain.ErrStudentNotInSection),
+		errors.Is(err, domain.ErrAssignmentNotInSection),
+		errors.Is(err, domain.ErrInvalidRubric),
+		errors.Is(err, domain.ErrInvalidEvaluation),
+		errors.Is(err, domain.ErrEvaluationRequired),
+		errors.Is(err, domain.ErrUnexpectedEvaluation),
+		errors.Is(err, domain.ErrPointsRequired),
+		errors.Is(err, domain.ErrPointsOutOfRange),
+		errors.Is(err, domain.ErrFeedbackTooLong):
+		return shared.ErrInvalidInputWith(err, err.Error())
+	case errors.Is(err, domain.ErrTooManyAttachments),
+		errors.Is(err, domain.ErrResubmissionClosed),
+		errors.Is(err, domain.ErrSubmissionGraded),
+		errors.Is(err, domain.ErrSubmissionConflict),
+		errors.Is(err, domain.ErrRubricInUse),
+		errors.Is(err, domain.ErrFeedbackReleased),
+		errors.Is(err, domain.ErrFeedbackOutdated):
+		return shared.ErrConflictWith(err, err.Error())
+	default:
+		return shared.ErrInternalWith(err, "cannot process assignment")
+	}
+}
@@ -0,0 +1,154 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	GrantExtensionInput struct {
+		StudentID string `json:"student_id" validate:"required,uuid"`
+		// AssignmentID is empty for an extension on every assignment of the section
+		AssignmentID string `json:"assignment_id" validate:"omitempty,uuid"`
+		// Percentage of the time the assignment gave, e.g. 50 for time and a half
+		Percentage int `json:"percentage" validate:"omitempty,min=1,max=300"`
+		// OffsetMinutes is a fixed extra time, it excludes Percentage
+		OffsetMinutes int    `json:"offset_minutes" validate:"omitempty,min=1,max=43200"`
+		Reason        string `json:"reason" validate:"required,max=1000"`
+	}
+)
+
+type ExtensionInteractor interface {
+	// Grant gives a student enrolled in the section extra time, it replaces
+	// the extension the student had on the same assignment
+	Grant(ctx context.Context, actor shared.Actor, sectionID string, in GrantExtensionInput) (*domain.Extension, error)
+	Revoke(ctx context.Context, actor shared.Actor, sectionID, extensionID string) error
+	List(ctx context.Context, actor shared.Actor, sectionID string) ([]domain.Extension, error)
+}
+
+type extensionInteractor struct {
+	assignments domain.AssignmentRepository
+	repository  domain.ExtensionRepository
+	now         func() time.Time
+}
+
+func NewExtensionInteractor(a domain.AssignmentRepository, r domain.ExtensionRepository) *extensionInteractor {
+	return &extensionInteractor{a, r, time.Now}
+}
+
+func (interactor extensionInteractor) Grant(ctx context.Context, actor shared.Actor, sectionID string, in GrantExtensionInput) (*domain.Extension, error) {
+	section, err := interactor.section(ctx, actor, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	student, err := valueobject.IDFromString(in.StudentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	membership, err := interactor.assignments.Membership(ctx, section, student)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if !membership.IsStudent {
+		return nil, mapError(domain.ErrStudentNotInSection)
+	}
+
+	var assignmentID *valueobject.ID
+	if in.AssignmentID != "" {
+		id, err := valueobject.IDFromString(in.AssignmentID)
+		if err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid assignment id")
+		}
+
+		assignment, err := interactor.assignments.FindByID(ctx, id)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		if !assignment.SectionID.Equals(section) {
+			return nil, mapError(domain.ErrAssignmentNotInSection)
+		}
+		assignmentID = &assignment.ID
+	}
+
+	extension, err := domain.NewExtension(
+		section,
+		student,
+		assignmentID,
+		in.Percentage,
+		time.Duration(in.OffsetMinutes)*time.Minute,
+		in.Reason,
+		actor.ID,
+		interactor.now(),
+	)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Grant(ctx, extension); err != nil {
+		return nil, mapError(err)
+	}
+
+	return extension, nil
+}
+
+func (interactor extensionInteractor) Revoke(ctx context.Context, actor shared.Actor, sectionID, extensionID string) error {
+	section, err := interactor.section(ctx, actor, sectionID)
+	if err != nil {
+		return err
+	}
+
+	id, err := valueobject.IDFromString(extensionID)
+	if err != nil {
+		return shared.ErrInvalidInputWith(err, "invalid extension id")
+	}
+
+	if err := interactor.repository.Revoke(ctx, section, id); err != nil {
+		return mapError(err)
+	}
+
+	return nil
+}
+
+func (interactor extensionInteractor) List(ctx context.Context, actor shared.Actor, sectionID string) ([]domain.Extension, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	if !actor.IsAdmin() {
+		if err := authorizeProfessor(ctx, interactor.assignments, actor, section); err != nil {
+			return nil, err
+		}
+	}
+
+	extensions, err := interactor.repository.ListBySection(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if extensions == nil {
+		extensions = []domain.Extension{}
+	}
+
+	return extensions, nil
+}
+
+// section parses the section id and checks the actor is its professor
+func (interactor extensionInteractor) section(ctx context.Context, actor shared.Actor, sectionID string) (valueobject.ID, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return valueobject.ID{}, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	if err := authorizeProfessor(ctx, interactor.assignments, actor, section); err != nil {
+		return valueobject.ID{}, err
+	}
+
+	return section, nil
+}
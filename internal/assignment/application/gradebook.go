@@ -0,0 +1,308 @@
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// gradebook columns that are not assignments, only student_id is required
+// to import
+const (
+	studentIDColumn = "student_id"
+	lastNameColumn  = "last_name"
+	firstNameColumn = "first_name"
+)
+
+// assignmentColumn matches the assignment id at the end of a column header,
+// e.g. "Essay 1 (max 20) [2f1c...]"
+var assignmentColumn = regexp.MustCompile(`\[([0-9a-fA-F-]{36})\]\s*$`)
+
+type (
+	// ImportReport lists the grades an import changes
+	ImportReport struct {
+		DryRun  bool          `json:"dry_run"`
+		Applied bool          `json:"applied"`
+		Changes []GradeChange `json:"changes"`
+	}
+
+	GradeChange struct {
+		Row             int            `json:"row"`
+		StudentID       valueobject.ID `json:"student_id"`
+		AssignmentID    valueobject.ID `json:"assignment_id"`
+		AssignmentTitle string         `json:"assignment_title"`
+		Previous        *float64       `json:"previous,omitempty"`
+		PointsEarned    float64        `json:"points_earned"`
+	}
+
+	// RowError is a problem found on a row of the imported file, rows are
+	// numbered from 1 with the header
+	RowError struct {
+		Row       int    `json:"row"`
+		StudentID string `json:"student_id,omitempty"`
+		Column    string `json:"column,omitempty"`
+		Error     string `json:"error"`
+	}
+)
+
+type GradebookInteractor interface {
+	// Export writes the students of the section by its assignments as CSV
+	Export(ctx context.Context, actor shared.Actor, sectionID string, w io.Writer) error
+	// Import grades the section from a CSV shaped like the export. Empty
+	// cells are left as they are and any error rejects the whole file.
+	Import(ctx context.Context, actor shared.Actor, sectionID string, r io.Reader, dryRun bool) (*ImportReport, error)
+}
+
+type gradebookInteractor struct {
+	assignments domain.AssignmentRepository
+	repository  domain.GradebookRepository
+}
+
+func NewGradebookInteractor(a domain.AssignmentRepository, r domain.GradebookRepository) *gradebookInteractor {
+	return &gradebookInteractor{a, r}
+}
+
+func (interactor gradebookInteractor) Export(ctx context.Context, actor shared.Actor, sectionID string, w io.Writer) error {
+	gradebook, err := interactor.load(ctx, actor, sectionID, true)
+	if err != nil {
+		return err
+	}
+
+	out := csv.NewWriter(w)
+
+	header := []string{studentIDColumn, lastNameColumn, firstNameColumn}
+	for _, c := range gradebook.Columns {
+		header = append(header, fmt.Sprintf("%s (max %s) [%s]", c.Assignment.Title, formatPoints(c.Assignment.MaxPoints), c.Assignment.ID.String()))
+	}
+	if err := out.Write(header); err != nil {
+		return shared.ErrInternalWith(err, "cannot write the gradebook")
+	}
+
+	for _, s := range gradebook.Students {
+		record := []string{s.ID.String(), s.LastName, s.FirstName}
+		for _, c := range gradebook.Columns {
+			cell := ""
+			if points := gradebook.Grade(c.Assignment.ID, s.ID); points != nil {
+				cell = formatPoints(*points)
+			}
+			record = append(record, cell)
+		}
+		if err := out.Write(record); err != nil {
+			return shared.ErrInternalWith(err, "cannot write the gradebook")
+		}
+	}
+
+	out.Flush()
+	if err := out.Error(); err != nil {
+		return shared.ErrInternalWith(err, "cannot write the gradebook")
+	}
+	return nil
+}
+
+func (interactor gradebookInteractor) Import(ctx context.Context, actor shared.Actor, sectionID string, r io.Reader, dryRun bool) (*ImportReport, error) {
+	gradebook, err := interactor.load(ctx, actor, sectionID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := csv.NewReader(r)
+	in.FieldsPerRecord = -1
+	in.TrimLeadingSpace = true
+
+	records, err := in.ReadAll()
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "the file is not a valid CSV")
+	}
+	if len(records) == 0 {
+		return nil, shared.ErrInvalidInputWith(domain.ErrInvalidGradebook, "the file is empty")
+	}
+
+	var (
+		rowErrors []RowError
+		changes   []GradeChange
+		pending   []domain.GradeChange
+	)
+
+	studentCol, columns, headerErrors := parseHeader(records[0], gradebook)
+	rowErrors = append(rowErrors, headerErrors...)
+
+	seen := make(map[valueobject.ID]int)
+	for i, record := range records[1:] {
+		row := i + 2
+		if isBlank(record) {
+			continue
+		}
+
+		if len(record) != len(records[0]) {
+			rowErrors = append(rowErrors, RowError{Row: row, Error: fmt.Sprintf("expected %d cells, got %d", len(records[0]), len(record))})
+			continue
+		}
+
+		if studentCol < 0 {
+			continue
+		}
+
+		raw := strings.TrimSpace(record[studentCol])
+		student, err := valueobject.IDFromString(raw)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Row: row, StudentID: raw, Column: studentIDColumn, Error: "invalid student id"})
+			continue
+		}
+		if !gradebook.HasStudent(student) {
+			rowErrors = append(rowErrors, RowError{Row: row, StudentID: raw, Column: studentIDColumn, Error: "the student is not enrolled in the section"})
+			continue
+		}
+		if first, ok := seen[student]; ok {
+			rowErrors = append(rowErrors, RowError{Row: row, StudentID: raw, Column: studentIDColumn, Error: fmt.Sprintf("the student is already on row %d", first)})
+			continue
+		}
+		seen[student] = row
+
+		for index, column := range columns {
+			if column == nil {
+				continue
+			}
+
+			cell := strings.TrimSpace(record[index])
+			if cell == "" {
+				continue
+			}
+
+			// ParseFloat takes NaN and Inf, they are not grades
+			points, err := strconv.ParseFloat(cell, 64)
+			if err != nil || math.IsNaN(points) || math.IsInf(points, 0) {
+				rowErrors = append(rowErrors, RowError{Row: row, StudentID: raw, Column: records[0][index], Error: fmt.Sprintf("%q is not a number", cell)})
+				continue
+			}
+
+			change, err := gradebook.Change(*column, student, points)
+			if err != nil {
+				rowErrors = append(rowErrors, RowError{Row: row, StudentID: raw, Column: records[0][index], Error: err.Error()})
+				continue
+			}
+			if change == nil {
+				continue
+			}
+
+			pending = append(pending, *change)
+			changes = append(changes, GradeChange{
+				Row:             row,
+				StudentID:       student,
+				AssignmentID:    column.Assignment.ID,
+				AssignmentTitle: column.Assignment.Title,
+				Previous:        change.Previous,
+				PointsEarned:    change.PointsEarned,
+			})
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, shared.ErrInvalidInputWith(domain.ErrInvalidGradebook, domain.ErrInvalidGradebook.Error()).WithDetails(rowErrors)
+	}
+
+	report := &ImportReport{DryRun: dryRun, Changes: changes}
+	if report.Changes == nil {
+		report.Changes = []GradeChange{}
+	}
+
+	if dryRun || len(pending) == 0 {
+		return report, nil
+	}
+
+	if err := interactor.repository.Apply(ctx, pending); err != nil {
+		return nil, mapError(err)
+	}
+
+	report.Applied = true
+	return report, nil
+}
+
+// load returns the gradebook of the section, admins may only read it
+func (interactor gradebookInteractor) load(ctx context.Context, actor shared.Actor, sectionID string, read bool) (*domain.Gradebook, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	if !read || !actor.IsAdmin() {
+		if err := authorizeProfessor(ctx, interactor.assignments, actor, section); err != nil {
+			return nil, err
+		}
+	}
+
+	gradebook, err := interactor.repository.Load(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return gradebook, nil
+}
+
+// parseHeader finds the student id column and the assignment of each
+// column, columns of other sections or unknown ones are errors
+func parseHeader(header []string, gradebook *domain.Gradebook) (int, []*domain.GradebookColumn, []RowError) {
+	var (
+		studentCol = -1
+		columns    = make([]*domain.GradebookColumn, len(header))
+		errs       []RowError
+	)
+
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+
+		switch strings.ToLower(name) {
+		case studentIDColumn:
+			studentCol = i
+			continue
+		case lastNameColumn, firstNameColumn, "":
+			continue
+		}
+
+		match := assignmentColumn.FindStringSubmatch(name)
+		if match == nil {
+			errs = append(errs, RowError{Row: 1, Column: name, Error: "unknown column, assignment columns end with the assignment id in brackets"})
+			continue
+		}
+
+		id, err := valueobject.IDFromString(match[1])
+		if err != nil {
+			errs = append(errs, RowError{Row: 1, Column: name, Error: "invalid assignment id"})
+			continue
+		}
+
+		column, ok := gradebook.Column(id)
+		if !ok {
+			errs = append(errs, RowError{Row: 1, Column: name, Error: domain.ErrAssignmentNotInSection.Error()})
+			continue
+		}
+		columns[i] = &column
+	}
+
+	if studentCol < 0 {
+		errs = append(errs, RowError{Row: 1, Error: "the student_id column is required"})
+	}
+
+	return studentCol, columns, errs
+}
+
+func isBlank(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func formatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
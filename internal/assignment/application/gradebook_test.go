@@ -0,0 +1,255 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// professorOf lets the actor in as the professor of every section, other
+// calls are not expected
+type professorOf struct {
+	domain.AssignmentRepository
+}
+
+func (professorOf) Membership(context.Context, valueobject.ID, valueobject.ID) (domain.Membership, error) {
+	return domain.Membership{IsProfessor: true}, nil
+}
+
+// gradebookStore serves a gradebook and records what is applied
+type gradebookStore struct {
+	gradebook *domain.Gradebook
+	applied   [][]domain.GradeChange
+}
+
+func (s *gradebookStore) Load(context.Context, valueobject.ID) (*domain.Gradebook, error) {
+	return s.gradebook, nil
+}
+
+func (s *gradebookStore) Apply(_ context.Context, changes []domain.GradeChange) error {
+	s.applied = append(s.applied, changes)
+	return nil
+}
+
+type gradebookFixture struct {
+	gradebook      *domain.Gradebook
+	essay, quiz    *domain.Assignment
+	alice, bob     valueobject.ID
+	essayH, quizH  string
+	store          *gradebookStore
+	gradebookInter application.GradebookInteractor
+}
+
+// newGradebook has an essay worth 20 points where alice has 15, and a quiz
+// graded with its rubric
+func newGradebook() *gradebookFixture {
+	section := valueobject.NewID()
+	essay := &domain.Assignment{ID: valueobject.NewID(), SectionID: section, Title: "Essay", MaxPoints: 20}
+	quiz := &domain.Assignment{ID: valueobject.NewID(), SectionID: section, Title: "Quiz", MaxPoints: 10}
+	alice, bob := valueobject.NewID(), valueobject.NewID()
+
+	gradebook := &domain.Gradebook{
+		SectionID: section,
+		Columns:   []domain.GradebookColumn{{Assignment: essay}, {Assignment: quiz, HasRubric: true}},
+		Students:  []domain.GradebookStudent{{ID: alice, FirstName: "Alice", LastName: "Liddell"}, {ID: bob, FirstName: "Bob", LastName: "Builder"}},
+		Grades: map[domain.GradeKey]float64{
+			{AssignmentID: essay.ID, StudentID: alice}: 15,
+			{AssignmentID: quiz.ID, StudentID: alice}:  8,
+		},
+	}
+
+	store := &gradebookStore{gradebook: gradebook}
+	return &gradebookFixture{
+		gradebook:      gradebook,
+		essay:          essay,
+		quiz:           quiz,
+		alice:          alice,
+		bob:            bob,
+		essayH:         fmt.Sprintf("Essay (max 20) [%s]", essay.ID),
+		quizH:          fmt.Sprintf("Quiz (max 10) [%s]", quiz.ID),
+		store:          store,
+		gradebookInter: application.NewGradebookInteractor(professorOf{}, store),
+	}
+}
+
+func (f *gradebookFixture) importCSV(t *testing.T, rows []string, dryRun bool) (*application.ImportReport, error) {
+	t.Helper()
+	actor := shared.Actor{ID: valueobject.NewID(), Role: shared.RoleProfessor}
+	return f.gradebookInter.Import(context.Background(), actor, f.gradebook.SectionID.String(), strings.NewReader(strings.Join(rows, "\n")), dryRun)
+}
+
+func TestGradebookImport(t *testing.T) {
+	f := newGradebook()
+
+	// the BOM spreadsheets add, the header in another case, blank rows,
+	// empty cells and unchanged grades are all fine
+	rows := []string{
+		"\ufeffStudent_ID,last_name,first_name," + f.essayH + "," + f.quizH,
+		f.alice.String() + ",Liddell,Alice,17.5,8",
+		",,,,",
+		f.bob.String() + ",Builder,Bob,12,",
+		" , , , , ",
+	}
+
+	report, err := f.importCSV(t, rows, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !report.DryRun || report.Applied || len(f.store.applied) != 0 {
+		t.Fatalf("got %+v with %d applies, want a dry run that changes nothing", report, len(f.store.applied))
+	}
+
+	previous := 15.0
+	want := []application.GradeChange{
+		{Row: 2, StudentID: f.alice, AssignmentID: f.essay.ID, AssignmentTitle: "Essay", Previous: &previous, PointsEarned: 17.5},
+		{Row: 4, StudentID: f.bob, AssignmentID: f.essay.ID, AssignmentTitle: "Essay", PointsEarned: 12},
+	}
+	if !reflect.DeepEqual(report.Changes, want) {
+		t.Errorf("got changes %+v, want %+v", report.Changes, want)
+	}
+
+	report, err = f.importCSV(t, rows, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.DryRun || !report.Applied || len(f.store.applied) != 1 || len(f.store.applied[0]) != 2 {
+		t.Fatalf("got %+v with %v applied, want both changes applied at once", report, f.store.applied)
+	}
+	if got := f.store.applied[0][1]; !got.StudentID.Equals(f.bob) || got.PointsEarned != 12 || got.GradePercentage != 60 {
+		t.Errorf("got change %+v, want 12 points, 60%%, for bob", got)
+	}
+
+	t.Run("nothing to change", func(t *testing.T) {
+		f := newGradebook()
+		report, err := f.importCSV(t, []string{"student_id," + f.essayH, f.alice.String() + ",15"}, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if report.Applied || len(report.Changes) != 0 || report.Changes == nil || len(f.store.applied) != 0 {
+			t.Errorf("got %+v with %d applies, want an empty report", report, len(f.store.applied))
+		}
+	})
+}
+
+func TestGradebookImportErrors(t *testing.T) {
+	f := newGradebook()
+	alice, bob := f.alice.String(), f.bob.String()
+	stranger := valueobject.NewID().String()
+	elsewhere := fmt.Sprintf("History (max 10) [%s]", valueobject.NewID())
+	header := "student_id," + f.essayH + "," + f.quizH
+
+	tests := []struct {
+		name string
+		rows []string
+		want []application.RowError
+	}{
+		{
+			name: "no student id column",
+			rows: []string{"last_name," + f.essayH, "Liddell,10"},
+			want: []application.RowError{{Row: 1, Error: "the student_id column is required"}},
+		},
+		{
+			name: "unknown column",
+			rows: []string{"student_id,Bonus", alice + ",1"},
+			want: []application.RowError{{Row: 1, Column: "Bonus", Error: "unknown column, assignment columns end with the assignment id in brackets"}},
+		},
+		{
+			name: "invalid assignment id",
+			rows: []string{"student_id,Essay [------------------------------------]", alice + ",1"},
+			want: []application.RowError{{Row: 1, Column: "Essay [------------------------------------]", Error: "invalid assignment id"}},
+		},
+		{
+			name: "assignment of another section",
+			rows: []string{"student_id," + elsewhere, alice + ",1"},
+			want: []application.RowError{{Row: 1, Column: elsewhere, Error: domain.ErrAssignmentNotInSection.Error()}},
+		},
+		{
+			name: "missing cells",
+			rows: []string{header, alice + ",10"},
+			want: []application.RowError{{Row: 2, Error: "expected 3 cells, got 2"}},
+		},
+		{
+			name: "invalid student id",
+			rows: []string{header, "alice,10,"},
+			want: []application.RowError{{Row: 2, StudentID: "alice", Column: "student_id", Error: "invalid student id"}},
+		},
+		{
+			name: "student not in the section",
+			rows: []string{header, stranger + ",10,"},
+			want: []application.RowError{{Row: 2, StudentID: stranger, Column: "student_id", Error: "the student is not enrolled in the section"}},
+		},
+		{
+			name: "student twice",
+			rows: []string{header, bob + ",10,", alice + ",16,", bob + ",11,"},
+			want: []application.RowError{{Row: 4, StudentID: bob, Column: "student_id", Error: "the student is already on row 2"}},
+		},
+		{
+			name: "not numbers",
+			rows: []string{header, alice + ",ten,", bob + ",NaN,", stranger + ",,"},
+			want: []application.RowError{
+				{Row: 2, StudentID: alice, Column: f.essayH, Error: `"ten" is not a number`},
+				{Row: 3, StudentID: bob, Column: f.essayH, Error: `"NaN" is not a number`},
+				{Row: 4, StudentID: stranger, Column: "student_id", Error: "the student is not enrolled in the section"},
+			},
+		},
+		{
+			name: "infinite points",
+			rows: []string{header, alice + ",+Inf,"},
+			want: []application.RowError{{Row: 2, StudentID: alice, Column: f.essayH, Error: `"+Inf" is not a number`}},
+		},
+		{
+			name: "points over the max",
+			rows: []string{header, alice + ",20.5,"},
+			want: []application.RowError{{Row: 2, StudentID: alice, Column: f.essayH, Error: domain.ErrPointsOutOfRange.Error()}},
+		},
+		{
+			name: "negative points",
+			rows: []string{header, bob + ",-1,"},
+			want: []application.RowError{{Row: 2, StudentID: bob, Column: f.essayH, Error: domain.ErrPointsOutOfRange.Error()}},
+		},
+		{
+			name: "changing a rubric grade",
+			rows: []string{header, alice + ",,9"},
+			want: []application.RowError{{Row: 2, StudentID: alice, Column: f.quizH, Error: domain.ErrGradedWithRubric.Error()}},
+		},
+		{
+			name: "header and row errors together",
+			rows: []string{"student_id,Bonus," + f.essayH, alice + ",1,30"},
+			want: []application.RowError{
+				{Row: 1, Column: "Bonus", Error: "unknown column, assignment columns end with the assignment id in brackets"},
+				{Row: 2, StudentID: alice, Column: f.essayH, Error: domain.ErrPointsOutOfRange.Error()},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.importCSV(t, tt.rows, false)
+
+			var appErr *shared.AppError
+			if !errors.As(err, &appErr) || !errors.Is(err, domain.ErrInvalidGradebook) {
+				t.Fatalf("got error %v, want %v", err, domain.ErrInvalidGradebook)
+			}
+			if got, _ := appErr.Details.([]application.RowError); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got row errors %+v, want %+v", got, tt.want)
+			}
+			if len(f.store.applied) != 0 {
+				t.Errorf("got %d applies, want none on error", len(f.store.applied))
+			}
+		})
+	}
+
+	t.Run("empty file", func(t *testing.T) {
+		if _, err := f.importCSV(t, nil, false); !errors.Is(err, domain.ErrInvalidGradebook) {
+			t.Errorf("got error %v, want %v", err, domain.ErrInvalidGradebook)
+		}
+	})
+}
@@ -0,0 +1,323 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	RubricInput struct {
+		Criteria []CriterionInput `json:"criteria" validate:"required,min=1,max=20,dive"`
+	}
+
+	CriterionInput struct {
+		Title       string       `json:"title" validate:"required,max=200"`
+		Description string       `json:"description" validate:"max=2000"`
+		Levels      []LevelInput `json:"levels" validate:"required,min=2,max=10,dive"`
+	}
+
+	LevelInput struct {
+		Title       string  `json:"title" validate:"required,max=200"`
+		Description string  `json:"description" validate:"max=2000"`
+		Points      float64 `json:"points" validate:"gte=0,lt=1000"`
+	}
+
+	GradeInput struct {
+		Comment string `json:"comment" validate:"max=10000"`
+		// Evaluation grades assignments with a rubric
+		Evaluation []CriterionScoreInput `json:"rubric_evaluation" validate:"dive"`
+		// PointsEarned grades assignments without a rubric
+		PointsEarned *float64 `json:"points_earned"`
+		// IsDraft keeps the feedback and the grade hidden from the student
+		IsDraft bool `json:"is_draft"`
+	}
+
+	CriterionScoreInput struct {
+		CriterionID string `json:"criterion_id" validate:"required,uuid"`
+		LevelID     string `json:"level_id" validate:"required,uuid"`
+		Comment     string `json:"comment" validate:"max=2000"`
+	}
+)
+
+type GradingInteractor interface {
+	// SetRubric replaces the rubric of the assignment, it can't change once
+	// submissions were graded with it
+	SetRubric(ctx context.Context, actor shared.Actor, assignmentID string, in RubricInput) (*domain.Rubric, error)
+	GetRubric(ctx context.Context, actor shared.Actor, assignmentID string) (*domain.Rubric, error)
+	DeleteRubric(ctx context.Context, actor shared.Actor, assignmentID string) error
+	// Grade writes the feedback of a submission, the score comes from the
+	// rubric when the assignment has one
+	Grade(ctx context.Context, actor shared.Actor, assignmentID, studentID string, in GradeInput) (*domain.Feedback, error)
+	// Release shows a draft feedback to the student and grades the submission
+	Release(ctx context.Context, actor shared.Actor, assignmentID, studentID string) (*domain.Feedback, error)
+	// Feedback returns the feedback of a submission, students only get it
+	// once released
+	Feedback(ctx context.Context, actor shared.Actor, assignmentID, studentID string) (*domain.Feedback, error)
+}
+
+type gradingInteractor struct {
+	assignments domain.AssignmentRepository
+	submissions domain.SubmissionRepository
+	rubrics     domain.RubricRepository
+	repository  domain.FeedbackRepository
+	now         func() time.Time
+}
+
+func NewGradingInteractor(
+	a domain.AssignmentRepository,
+	s domain.SubmissionRepository,
+	r domain.RubricRepository,
+	f domain.FeedbackRepository,
+) *gradingInteractor {
+	return &gradingInteractor{a, s, r, f, time.Now}
+}
+
+func (interactor gradingInteractor) SetRubric(ctx context.Context, actor shared.Actor, assignmentID string, in RubricInput) (*domain.Rubric, error) {
+	assignment, err := interactor.owned(ctx, actor, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := interactor.checkUnused(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	criteria := make([]domain.Criterion, 0, len(in.Criteria))
+	for _, c := range in.Criteria {
+		levels := make([]domain.Level, 0, len(c.Levels))
+		for _, l := range c.Levels {
+			levels = append(levels, domain.Level{Title: l.Title, Description: l.Description, Points: l.Points})
+		}
+		criteria = append(criteria, domain.Criterion{Title: c.Title, Description: c.Description, Levels: levels})
+	}
+
+	rubric, err := domain.NewRubric(assignment.ID, criteria, interactor.now())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.rubrics.Save(ctx, rubric); err != nil {
+		return nil, mapError(err)
+	}
+
+	return rubric, nil
+}
+
+func (interactor gradingInteractor) GetRubric(ctx context.Context, actor shared.Actor, assignmentID string) (*domain.Rubric, error) {
+	assignment, err := interactor.assignment(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedOnly, err := visibility(ctx, interactor.assignments, actor, assignment.SectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if publishedOnly && !assignment.IsPublished {
+		return nil, mapError(domain.ErrAssignmentNotFound)
+	}
+
+	rubric, err := interactor.rubrics.FindByAssignment(ctx, assignment.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return rubric, nil
+}
+
+func (interactor gradingInteractor) DeleteRubric(ctx context.Context, actor shared.Actor, assignmentID string) error {
+	assignment, err := interactor.owned(ctx, actor, assignmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := interactor.checkUnused(ctx, assignment); err != nil {
+		return err
+	}
+
+	if err := interactor.rubrics.Delete(ctx, assignment.ID); err != nil {
+		return mapError(err)
+	}
+
+	return nil
+}
+
+func (interactor gradingInteractor) Grade(ctx context.Context, actor shared.Actor, assignmentID, studentID string, in GradeInput) (*domain.Feedback, error) {
+	assignment, err := interactor.owned(ctx, actor, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := interactor.submission(ctx, assignment, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	rubric, err := interactor.rubrics.FindByAssignment(ctx, assignment.ID)
+	if errors.Is(err, domain.ErrRubricNotFound) {
+		rubric, err = nil, nil
+	}
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	evaluation := make(domain.Evaluation, 0, len(in.Evaluation))
+	for _, e := range in.Evaluation {
+		criterion, err := valueobject.IDFromString(e.CriterionID)
+		if err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid criterion id")
+		}
+		level, err := valueobject.IDFromString(e.LevelID)
+		if err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid level id")
+		}
+		evaluation = append(evaluation, domain.CriterionScore{CriterionID: criterion, LevelID: level, Comment: e.Comment})
+	}
+
+	score, err := assignment.Score(rubric, evaluation, in.PointsEarned)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	now := interactor.now()
+
+	feedback, err := interactor.repository.FindBySubmission(ctx, submission.ID)
+	switch {
+	case errors.Is(err, domain.ErrFeedbackNotFound):
+		feedback, err = domain.NewFeedback(submission, actor.ID, in.Comment, evaluation, score, in.IsDraft, now)
+	case err == nil:
+		err = feedback.Revise(submission, actor.ID, in.Comment, evaluation, score, in.IsDraft, now)
+	}
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.repository.Save(ctx, feedback, submission); err != nil {
+		return nil, mapError(err)
+	}
+
+	return feedback, nil
+}
+
+func (interactor gradingInteractor) Release(ctx context.Context, actor shared.Actor, assignmentID, studentID string) (*domain.Feedback, error) {
+	assignment, err := interactor.owned(ctx, actor, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := interactor.submission(ctx, assignment, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	feedback, err := interactor.repository.FindBySubmission(ctx, submission.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := feedback.Release(submission, interactor.now()); err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.repository.Save(ctx, feedback, submission); err != nil {
+		return nil, mapError(err)
+	}
+
+	return feedback, nil
+}
+
+func (interactor gradingInteractor) Feedback(ctx context.Context, actor shared.Actor, assignmentID, studentID string) (*domain.Feedback, error) {
+	assignment, err := interactor.assignment(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !actor.IsStudent() {
+		if err := authorizeStaff(ctx, interactor.assignments, actor, assignment); err != nil {
+			return nil, err
+		}
+	}
+
+	submission, err := interactor.submission(ctx, assignment, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if actor.IsStudent() && !actor.ID.Equals(submission.StudentID) {
+		return nil, shared.ErrForbiddenWith(shared.ErrForbidden, "students can only see their own feedback")
+	}
+
+	feedback, err := interactor.repository.FindBySubmission(ctx, submission.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	// drafts don't exist for students
+	if actor.IsStudent() && feedback.IsDraft {
+		return nil, mapError(domain.ErrFeedbackNotFound)
+	}
+
+	return feedback, nil
+}
+
+func (interactor gradingInteractor) assignment(ctx context.Context, id string) (*domain.Assignment, error) {
+	assignmentID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid assignment id")
+	}
+
+	assignment, err := interactor.assignments.FindByID(ctx, assignmentID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return assignment, nil
+}
+
+// owned returns the assignment when the actor is the professor of its section
+func (interactor gradingInteractor) owned(ctx context.Context, actor shared.Actor, id string) (*domain.Assignment, error) {
+	assignment, err := interactor.assignment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeProfessor(ctx, interactor.assignments, actor, assignment.SectionID); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (interactor gradingInteractor) submission(ctx context.Context, assignment *domain.Assignment, studentID string) (*domain.Submission, error) {
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	submission, err := interactor.submissions.FindByStudent(ctx, assignment.ID, student)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return submission, nil
+}
+
+// checkUnused keeps the rubric of an assignment from changing under the
+// evaluations given with it
+func (interactor gradingInteractor) checkUnused(ctx context.Context, assignment *domain.Assignment) error {
+	used, err := interactor.rubrics.IsInUse(ctx, assignment.ID)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if used {
+		return mapError(domain.ErrRubricInUse)
+	}
+	return nil
+}
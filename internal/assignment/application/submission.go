@@ -0,0 +1,297 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// submissionsFolder is the storage folder of the submission attachments
+const submissionsFolder = "submissions"
+
+type (
+	SubmitInput struct {
+		Content string `json:"content" validate:"max=50000"`
+		// Files are the uploaded attachments, they are read from multipart requests
+		Files []FileInput `json:"-"`
+	}
+
+	// MissingSubmission is a submission marked missing by SweepMissing
+	MissingSubmission struct {
+		SubmissionID    valueobject.ID `json:"submission_id"`
+		AssignmentID    valueobject.ID `json:"assignment_id"`
+		AssignmentTitle string         `json:"assignment_title"`
+		StudentID       valueobject.ID `json:"student_id"`
+	}
+)
+
+// SubmissionPolicy holds the configurable rules of the submissions
+type SubmissionPolicy struct {
+	// GracePeriod is how long after the due date work is still on time
+	GracePeriod time.Duration
+}
+
+type SubmissionInteractor interface {
+	// Submit hands in a new version of the work of the student, versions
+	// after the first one are only accepted before the deadline
+	Submit(ctx context.Context, actor shared.Actor, assignmentID string, in SubmitInput) (*domain.Submission, error)
+	List(ctx context.Context, actor shared.Actor, assignmentID string) ([]*domain.Submission, error)
+	Get(ctx context.Context, actor shared.Actor, assignmentID, studentID string) (*domain.Submission, error)
+	History(ctx context.Context, actor shared.Actor, assignmentID, studentID string) ([]domain.SubmissionVersion, error)
+	// SweepMissing marks missing the work of the enrolled students whose
+	// deadline passed without a submission, running it again changes nothing
+	SweepMissing(ctx context.Context, now time.Time) ([]MissingSubmission, error)
+}
+
+type submissionInteractor struct {
+	assignments domain.AssignmentRepository
+	repository  domain.SubmissionRepository
+	extensions  domain.ExtensionRepository
+	storage     shared.Storage
+	policy      SubmissionPolicy
+	now         func() time.Time
+}
+
+func NewSubmissionInteractor(
+	a domain.AssignmentRepository,
+	r domain.SubmissionRepository,
+	e domain.ExtensionRepository,
+	s shared.Storage,
+	p SubmissionPolicy,
+) *submissionInteractor {
+	return &submissionInteractor{a, r, e, s, p, time.Now}
+}
+
+func (interactor submissionInteractor) Submit(ctx context.Context, actor shared.Actor, assignmentID string, in SubmitInput) (*domain.Submission, error) {
+	if !actor.IsStudent() {
+		return nil, mapError(domain.ErrNotEnrolledInSection)
+	}
+
+	assignment, err := interactor.assignment(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// unpublished assignments don't exist for students
+	if !assignment.IsPublished {
+		return nil, mapError(domain.ErrAssignmentNotFound)
+	}
+
+	membership, err := interactor.assignments.Membership(ctx, assignment.SectionID, actor.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if !membership.IsEnrolled {
+		return nil, mapError(domain.ErrNotEnrolledInSection)
+	}
+
+	submission, err := interactor.repository.FindByStudent(ctx, assignment.ID, actor.ID)
+	if errors.Is(err, domain.ErrSubmissionNotFound) {
+		submission, err = domain.NewSubmission(assignment.ID, actor.ID), nil
+	}
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	extensions, err := interactor.extensions.ForStudent(ctx, assignment, actor.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	now := interactor.now()
+
+	attachments := make(shared.Attachments, 0, len(in.Files))
+	for _, file := range in.Files {
+		attachment, err := shared.NewAttachment(file.Name, file.ContentType, file.Size, now)
+		if err != nil {
+			return nil, shared.ErrInvalidInputWith(err, err.Error())
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err := submission.Submit(in.Content, attachments, assignment.Deadline(interactor.policy.GracePeriod, extensions), now); err != nil {
+		return nil, mapError(err)
+	}
+
+	// files of previous versions are kept, the history points to them
+	for i, file := range in.Files {
+		url, err := interactor.storage.Save(ctx, submissionsFolder, submission.ID.String(), attachments[i].StoredName(), file.Content)
+		if err != nil {
+			interactor.discard(ctx, submission.ID, attachments[:i])
+			return nil, shared.ErrInternalWith(err, "cannot store the attachment")
+		}
+		submission.Attachments[i].URL = url
+	}
+
+	if err := interactor.repository.Save(ctx, submission); err != nil {
+		interactor.discard(ctx, submission.ID, attachments)
+		return nil, mapError(err)
+	}
+
+	return submission, nil
+}
+
+func (interactor submissionInteractor) List(ctx context.Context, actor shared.Actor, assignmentID string) ([]*domain.Submission, error) {
+	assignment, err := interactor.assignment(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeStaff(ctx, interactor.assignments, actor, assignment); err != nil {
+		return nil, err
+	}
+
+	submissions, err := interactor.repository.ListByAssignment(ctx, assignment.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if submissions == nil {
+		submissions = []*domain.Submission{}
+	}
+
+	return submissions, nil
+}
+
+func (interactor submissionInteractor) Get(ctx context.Context, actor shared.Actor, assignmentID, studentID string) (*domain.Submission, error) {
+	assignment, student, err := interactor.studentSubmission(ctx, actor, assignmentID, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := interactor.repository.FindByStudent(ctx, assignment.ID, student)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return submission, nil
+}
+
+func (interactor submissionInteractor) History(ctx context.Context, actor shared.Actor, assignmentID, studentID string) ([]domain.SubmissionVersion, error) {
+	assignment, student, err := interactor.studentSubmission(ctx, actor, assignmentID, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := interactor.repository.FindByStudent(ctx, assignment.ID, student)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	versions, err := interactor.repository.Versions(ctx, submission.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if versions == nil {
+		versions = []domain.SubmissionVersion{}
+	}
+
+	return versions, nil
+}
+
+func (interactor submissionInteractor) SweepMissing(ctx context.Context, now time.Time) ([]MissingSubmission, error) {
+	candidates, err := interactor.repository.Unsubmitted(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		missing []*domain.Submission
+		titles  = make(map[valueobject.ID]string)
+	)
+	for _, c := range candidates {
+		deadline := c.Deadline
+		deadline.Grace = interactor.policy.GracePeriod
+
+		// the student may still be on time, under the grace period or an
+		// extension
+		if !deadline.IsLate(now) {
+			continue
+		}
+
+		missing = append(missing, domain.NewMissingSubmission(c.AssignmentID, c.StudentID, now))
+		titles[c.AssignmentID] = c.AssignmentTitle
+	}
+
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	created, err := interactor.repository.MarkMissing(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	marked := make([]MissingSubmission, 0, len(created))
+	for _, s := range created {
+		marked = append(marked, MissingSubmission{
+			SubmissionID:    s.ID,
+			AssignmentID:    s.AssignmentID,
+			AssignmentTitle: titles[s.AssignmentID],
+			StudentID:       s.StudentID,
+		})
+	}
+
+	return marked, nil
+}
+
+func (interactor submissionInteractor) assignment(ctx context.Context, id string) (*domain.Assignment, error) {
+	assignmentID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid assignment id")
+	}
+
+	assignment, err := interactor.assignments.FindByID(ctx, assignmentID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return assignment, nil
+}
+
+// studentSubmission resolves the assignment and the student of a request on
+// a single submission, students only reach their own
+func (interactor submissionInteractor) studentSubmission(ctx context.Context, actor shared.Actor, assignmentID, studentID string) (*domain.Assignment, valueobject.ID, error) {
+	assignment, err := interactor.assignment(ctx, assignmentID)
+	if err != nil {
+		return nil, valueobject.ID{}, err
+	}
+
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return nil, valueobject.ID{}, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	if actor.IsStudent() {
+		if !actor.ID.Equals(student) {
+			return nil, valueobject.ID{}, shared.ErrForbiddenWith(shared.ErrForbidden, "students can only see their own submissions")
+		}
+		return assignment, student, nil
+	}
+
+	if err := authorizeStaff(ctx, interactor.assignments, actor, assignment); err != nil {
+		return nil, valueobject.ID{}, err
+	}
+
+	return assignment, student, nil
+}
+
+// authorizeStaff lets admins and the professor of the section through
+func authorizeStaff(ctx context.Context, r domain.AssignmentRepository, actor shared.Actor, assignment *domain.Assignment) error {
+	if actor.IsAdmin() {
+		return nil
+	}
+	return authorizeProfessor(ctx, r, actor, assignment.SectionID)
+}
+
+// discard removes the files stored for a version that was not saved
+func (interactor submissionInteractor) discard(ctx context.Context, submissionID valueobject.ID, files shared.Attachments) {
+	for _, file := range files {
+		_ = interactor.storage.Delete(ctx, submissionsFolder, submissionID.String(), file.StoredName())
+	}
+}
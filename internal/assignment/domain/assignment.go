@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// MaxAttachments is how many files an assignment can hold
+const MaxAttachments = 10
+
+var (
+	ErrAssignmentNotFound    = errors.New("assignment does not exist")
+	ErrSectionNotFound       = errors.New("course section does not exist")
+	ErrEmptyTitle            = errors.New("assignment title cannot be empty")
+	ErrTitleTooLong          = errors.New("assignment title cannot exceed 200 characters")
+	ErrInvalidAssignmentType = errors.New("assignment type must be homework, project, exam, quiz or essay")
+	ErrInvalidMaxPoints      = errors.New("max points must be greater than zero and lower than 1000")
+	ErrTooManyAttachments    = errors.New("an assignment cannot have more than 10 attachments")
+	ErrNotSectionProfessor   = errors.New("only the professor assigned to the section can manage its assignments")
+	ErrNotSectionMember      = errors.New("only the professor and the students of the section can see its assignments")
+)
+
+type AssignmentRepository interface {
+	Create(ctx context.Context, a *Assignment) (err error)
+	// Update stores the assignment but its attachments, they only change
+	// through Attach and Detach
+	Update(ctx context.Context, a *Assignment) (err error)
+	// Attach appends the file to the stored attachments in a single
+	// statement, so concurrent uploads don't overwrite each other
+	Attach(ctx context.Context, id valueobject.ID, file shared.Attachment, now time.Time) (*Assignment, error)
+	// Detach removes the file from the stored attachments in a single statement
+	Detach(ctx context.Context, id, fileID valueobject.ID, now time.Time) (*Assignment, error)
+	FindByID(ctx context.Context, id valueobject.ID) (*Assignment, error)
+	// ListBySection returns the assignments of the section by due date, only
+	// the published ones when publishedOnly is set
+	ListBySection(ctx context.Context, sectionID valueobject.ID, publishedOnly bool) ([]*Assignment, error)
+	// Membership returns how the user takes part in the section
+	Membership(ctx context.Context, sectionID, userID valueobject.ID) (Membership, error)
+}
+
+// Membership is the relation of a user with a section
+type Membership struct {
+	// IsProfessor is set for the professor assigned to the section
+	IsProfessor bool
+	// IsStudent is set for students holding an enrollment that wasn't dropped
+	IsStudent bool
+	// IsEnrolled is set for students currently taking the section
+	IsEnrolled bool
+}
+
+// Type is the kind of work asked in an assignment
+type Type string
+
+const (
+	TypeHomework Type = "homework"
+	TypeProject  Type = "project"
+	TypeExam     Type = "exam"
+	TypeQuiz     Type = "quiz"
+	TypeEssay    Type = "essay"
+)
+
+// IsValid checks if the assignment type is valid
+func (t Type) IsValid() bool {
+	switch t {
+	case TypeHomework, TypeProject, TypeExam, TypeQuiz, TypeEssay:
+		return true
+	default:
+		return false
+	}
+}
+
+// Assignment is a graded piece of work of a section, students only see it
+// once published
+type Assignment struct {
+	ID          valueobject.ID `json:"id"`
+	SectionID   valueobject.ID `json:"course_section_id"`
+	ProfessorID valueobject.ID `json:"professor_id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Type        Type           `json:"assignment_type"`
+	MaxPoints   float64        `json:"max_points"`
+	// DueDate is nil for assignments without a deadline
+	DueDate     *time.Time         `json:"due_date,omitempty"`
+	IsPublished bool               `json:"is_published"`
+	Attachments shared.Attachments `json:"attachments"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// Details groups the editable fields of an assignment
+type Details struct {
+	Title       string
+	Description string
+	Type        Type
+	MaxPoints   float64
+	DueDate     *time.Time
+}
+
+// NewAssignment creates a new unpublished Assignment with validation
+func NewAssignment(sectionID, professorID valueobject.ID, details Details) (*Assignment, error) {
+	if err := sectionID.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	assignment := &Assignment{
+		ID:          valueobject.NewID(),
+		SectionID:   sectionID,
+		ProfessorID: professorID,
+		Attachments: shared.Attachments{},
+		CreatedAt:   now,
+	}
+
+	if err := assignment.Edit(details); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// AssignmentFromPersistence creates an Assignment instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func AssignmentFromPersistence(
+	id valueobject.ID,
+	sectionID valueobject.ID,
+	professorID valueobject.ID,
+	title string,
+	description string,
+	assignmentType Type,
+	maxPoints float64,
+	dueDate *time.Time,
+	isPublished bool,
+	attachments shared.Attachments,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Assignment {
+	if attachments == nil {
+		attachments = shared.Attachments{}
+	}
+
+	return &Assignment{
+		ID:          id,
+		SectionID:   sectionID,
+		ProfessorID: professorID,
+		Title:       title,
+		Description: description,
+		Type:        assignmentType,
+		MaxPoints:   maxPoints,
+		DueDate:     dueDate,
+		IsPublished: isPublished,
+		Attachments: attachments,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// Business Logic Methods
+
+// Edit replaces the editable fields of the assignment
+func (a *Assignment) Edit(d Details) error {
+	title := strings.TrimSpace(d.Title)
+	if title == "" {
+		return ErrEmptyTitle
+	}
+
+	if len(title) > 200 {
+		return ErrTitleTooLong
+	}
+
+	if !d.Type.IsValid() {
+		return ErrInvalidAssignmentType
+	}
+
+	// max_points is a DECIMAL(5,2)
+	if d.MaxPoints <= 0 || d.MaxPoints >= 1000 {
+		return ErrInvalidMaxPoints
+	}
+
+	a.Title = title
+	a.Description = strings.TrimSpace(d.Description)
+	a.Type = d.Type
+	a.MaxPoints = d.MaxPoints
+	a.DueDate = d.DueDate
+	a.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Publish makes the assignment visible to the students of the section
+func (a *Assignment) Publish() {
+	a.IsPublished = true
+	a.UpdatedAt = time.Now()
+}
+
+// Unpublish hides the assignment from the students of the section
+func (a *Assignment) Unpublish() {
+	a.IsPublished = false
+	a.UpdatedAt = time.Now()
+}
+
+// Attach adds a stored file to the assignment
+func (a *Assignment) Attach(file shared.Attachment) error {
+	if len(a.Attachments) >= MaxAttachments {
+		return ErrTooManyAttachments
+	}
+
+	a.Attachments = append(a.Attachments, file)
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// Detach removes a file from the assignment and returns it so it can be
+// deleted from the storage
+func (a *Assignment) Detach(id valueobject.ID) (shared.Attachment, error) {
+	file, ok := a.Attachments.Find(id)
+	if !ok {
+		return shared.Attachment{}, shared.ErrAttachmentNotFound
+	}
+
+	a.Attachments = a.Attachments.Without(id)
+	a.UpdatedAt = time.Now()
+	return file, nil
+}
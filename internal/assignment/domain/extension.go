@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	// MaxExtensionPercentage caps relative extensions, 300% gives four
+	// times the original time
+	MaxExtensionPercentage = 300
+	// MaxExtensionOffset caps absolute extensions
+	MaxExtensionOffset = 30 * 24 * time.Hour
+)
+
+var (
+	ErrExtensionNotFound      = errors.New("extension does not exist")
+	ErrInvalidExtension       = errors.New("an extension needs either a percentage or an offset")
+	ErrInvalidExtensionAmount = errors.New("extensions go from 1% to 300% or from 1 minute to 30 days")
+	ErrEmptyExtensionReason   = errors.New("a reason is required to grant an extension")
+	ErrStudentNotInSection    = errors.New("the student is not enrolled in the section")
+	ErrAssignmentNotInSection = errors.New("the assignment does not belong to the section")
+)
+
+type ExtensionRepository interface {
+	// Grant stores the extension replacing the one the student had for the
+	// same assignment, or the blanket one of the section
+	Grant(ctx context.Context, e *Extension) (err error)
+	Revoke(ctx context.Context, sectionID, id valueobject.ID) (err error)
+	ListBySection(ctx context.Context, sectionID valueobject.ID) ([]Extension, error)
+	// ForStudent returns the extensions of the student that apply to the
+	// assignment, the specific one and the blanket one of its section
+	ForStudent(ctx context.Context, a *Assignment, studentID valueobject.ID) ([]Extension, error)
+}
+
+// Extension moves the deadlines of a student, on a single assignment or on
+// every assignment of a section when AssignmentID is nil. The extra time is
+// either a percentage of the time the assignment gave, from its creation to
+// its due date, or a fixed offset.
+type Extension struct {
+	ID           valueobject.ID  `json:"id"`
+	SectionID    valueobject.ID  `json:"course_section_id"`
+	StudentID    valueobject.ID  `json:"student_id"`
+	AssignmentID *valueobject.ID `json:"assignment_id,omitempty"`
+	Percentage   int             `json:"percentage,omitempty"`
+	Offset       time.Duration   `json:"-"`
+	// OffsetMinutes mirrors Offset for the clients
+	OffsetMinutes int            `json:"offset_minutes,omitempty"`
+	Reason        string         `json:"reason"`
+	GrantedBy     valueobject.ID `json:"granted_by"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// NewExtension creates a new Extension with validation, exactly one of
+// percentage and offset must be set
+func NewExtension(
+	sectionID valueobject.ID,
+	studentID valueobject.ID,
+	assignmentID *valueobject.ID,
+	percentage int,
+	offset time.Duration,
+	reason string,
+	grantedBy valueobject.ID,
+	now time.Time,
+) (*Extension, error) {
+	if err := studentID.Validate(); err != nil {
+		return nil, err
+	}
+
+	if (percentage == 0) == (offset == 0) {
+		return nil, ErrInvalidExtension
+	}
+
+	if percentage < 0 || percentage > MaxExtensionPercentage ||
+		offset < 0 || (offset > 0 && offset < time.Minute) || offset > MaxExtensionOffset {
+		return nil, ErrInvalidExtensionAmount
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrEmptyExtensionReason
+	}
+
+	return ExtensionFromPersistence(
+		valueobject.NewID(),
+		sectionID,
+		studentID,
+		assignmentID,
+		percentage,
+		offset.Truncate(time.Minute),
+		reason,
+		grantedBy,
+		now,
+	), nil
+}
+
+// ExtensionFromPersistence creates an Extension instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func ExtensionFromPersistence(
+	id valueobject.ID,
+	sectionID valueobject.ID,
+	studentID valueobject.ID,
+	assignmentID *valueobject.ID,
+	percentage int,
+	offset time.Duration,
+	reason string,
+	grantedBy valueobject.ID,
+	createdAt time.Time,
+) *Extension {
+	return &Extension{
+		ID:            id,
+		SectionID:     sectionID,
+		StudentID:     studentID,
+		AssignmentID:  assignmentID,
+		Percentage:    percentage,
+		Offset:        offset,
+		OffsetMinutes: int(offset / time.Minute),
+		Reason:        reason,
+		GrantedBy:     grantedBy,
+		CreatedAt:     createdAt,
+	}
+}
+
+// IsBlanket checks if the extension covers every assignment of the section
+func (e Extension) IsBlanket() bool {
+	return e.AssignmentID == nil
+}
+
+// Length returns the extra time the extension gives on an assignment
+// created at start and due at dueDate
+func (e Extension) Length(start, dueDate time.Time) time.Duration {
+	if e.Offset > 0 {
+		return e.Offset
+	}
+
+	window := dueDate.Sub(start)
+	if window <= 0 {
+		return 0
+	}
+	return window * time.Duration(e.Percentage) / 100
+}
+
+// ExtensionFor returns the extra time the student gets on the assignment, an
+// extension specific to the assignment overrides the blanket one
+func ExtensionFor(a *Assignment, extensions []Extension) time.Duration {
+	if a.DueDate == nil {
+		return 0
+	}
+
+	var applied *Extension
+	for i, e := range extensions {
+		switch {
+		case e.IsBlanket() && e.SectionID.Equals(a.SectionID) && applied == nil:
+			applied = &extensions[i]
+		case !e.IsBlanket() && e.AssignmentID.Equals(a.ID):
+			return e.Length(a.CreatedAt, *a.DueDate)
+		}
+	}
+
+	if applied == nil {
+		return 0
+	}
+	return applied.Length(a.CreatedAt, *a.DueDate)
+}
@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func TestNewExtension(t *testing.T) {
+	tests := []struct {
+		name       string
+		percentage int
+		offset     time.Duration
+		reason     string
+		wantErr    error
+	}{
+		{name: "percentage", percentage: 50, reason: "medical leave"},
+		{name: "offset", offset: 48 * time.Hour, reason: "medical leave"},
+		{name: "neither", reason: "medical leave", wantErr: domain.ErrInvalidExtension},
+		{name: "both", percentage: 50, offset: time.Hour, reason: "medical leave", wantErr: domain.ErrInvalidExtension},
+		{name: "negative percentage", percentage: -10, reason: "medical leave", wantErr: domain.ErrInvalidExtensionAmount},
+		{name: "percentage over the max", percentage: domain.MaxExtensionPercentage + 1, reason: "medical leave", wantErr: domain.ErrInvalidExtensionAmount},
+		{name: "offset under a minute", offset: 30 * time.Second, reason: "medical leave", wantErr: domain.ErrInvalidExtensionAmount},
+		{name: "offset over the max", offset: domain.MaxExtensionOffset + time.Minute, reason: "medical leave", wantErr: domain.ErrInvalidExtensionAmount},
+		{name: "blank reason", percentage: 50, reason: " ", wantErr: domain.ErrEmptyExtensionReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewExtension(valueobject.NewID(), valueobject.NewID(), nil, tt.percentage, tt.offset, tt.reason, valueobject.NewID(), time.Now())
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtensionFor(t *testing.T) {
+	created := time.Date(2025, time.March, 3, 8, 0, 0, 0, time.UTC)
+	due := created.Add(10 * 24 * time.Hour)
+
+	assignment := &domain.Assignment{ID: valueobject.NewID(), SectionID: valueobject.NewID(), DueDate: &due, CreatedAt: created}
+	other := valueobject.NewID()
+
+	blanket := func(percentage int, offset time.Duration) domain.Extension {
+		return domain.Extension{SectionID: assignment.SectionID, Percentage: percentage, Offset: offset}
+	}
+	specific := func(id valueobject.ID, percentage int, offset time.Duration) domain.Extension {
+		return domain.Extension{SectionID: assignment.SectionID, AssignmentID: &id, Percentage: percentage, Offset: offset}
+	}
+
+	tests := []struct {
+		name       string
+		assignment *domain.Assignment
+		extensions []domain.Extension
+		want       time.Duration
+	}{
+		{name: "no extensions", assignment: assignment},
+		{name: "blanket percentage of the window", assignment: assignment, extensions: []domain.Extension{blanket(50, 0)}, want: 5 * 24 * time.Hour},
+		{name: "blanket offset", assignment: assignment, extensions: []domain.Extension{blanket(0, 36*time.Hour)}, want: 36 * time.Hour},
+		{name: "specific before the blanket", assignment: assignment, extensions: []domain.Extension{specific(assignment.ID, 0, 2*time.Hour), blanket(100, 0)}, want: 2 * time.Hour},
+		{name: "specific after the blanket", assignment: assignment, extensions: []domain.Extension{blanket(100, 0), specific(assignment.ID, 10, 0)}, want: 24 * time.Hour},
+		{name: "specific even when shorter", assignment: assignment, extensions: []domain.Extension{blanket(0, 72*time.Hour), specific(assignment.ID, 0, time.Hour)}, want: time.Hour},
+		{name: "the first blanket wins", assignment: assignment, extensions: []domain.Extension{blanket(0, time.Hour), blanket(0, 2*time.Hour)}, want: time.Hour},
+		{name: "other assignments are ignored", assignment: assignment, extensions: []domain.Extension{specific(other, 0, time.Hour), blanket(0, 3*time.Hour)}, want: 3 * time.Hour},
+		{name: "other sections are ignored", assignment: assignment, extensions: []domain.Extension{{SectionID: valueobject.NewID(), Offset: time.Hour}}},
+		{name: "no due date", assignment: &domain.Assignment{ID: assignment.ID, SectionID: assignment.SectionID, CreatedAt: created}, extensions: []domain.Extension{blanket(0, time.Hour)}},
+		{name: "due before creation", assignment: &domain.Assignment{ID: assignment.ID, SectionID: assignment.SectionID, DueDate: &created, CreatedAt: due}, extensions: []domain.Extension{blanket(50, 0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.ExtensionFor(tt.assignment, tt.extensions); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeadlineIsLate(t *testing.T) {
+	due := time.Date(2025, time.March, 13, 23, 59, 0, 0, time.UTC)
+	deadline := domain.Deadline{DueDate: &due, Grace: 15 * time.Minute, Extension: time.Hour}
+
+	tests := []struct {
+		name     string
+		deadline domain.Deadline
+		at       time.Time
+		want     bool
+	}{
+		{name: "before the due date", deadline: deadline, at: due.Add(-time.Minute)},
+		{name: "within the extension", deadline: deadline, at: due.Add(time.Hour)},
+		{name: "within the grace period", deadline: deadline, at: due.Add(time.Hour + 15*time.Minute)},
+		{name: "past the grace period", deadline: deadline, at: due.Add(time.Hour + 15*time.Minute + time.Second), want: true},
+		{name: "no due date", deadline: domain.Deadline{Grace: time.Minute}, at: due.AddDate(1, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.deadline.IsLate(tt.at); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrFeedbackNotFound = errors.New("feedback does not exist")
+	ErrFeedbackReleased = errors.New("released feedback cannot go back to draft")
+	ErrFeedbackOutdated = errors.New("the student handed in a new version since the feedback was written")
+	ErrFeedbackTooLong  = errors.New("feedback comment cannot exceed 10000 characters")
+)
+
+type FeedbackRepository interface {
+	// Save stores the feedback, once released it also stores the grade of the
+	// submission and fails with ErrSubmissionConflict when the submission
+	// changed since it was read
+	Save(ctx context.Context, f *Feedback, s *Submission) (err error)
+	FindBySubmission(ctx context.Context, submissionID valueobject.ID) (*Feedback, error)
+}
+
+// Feedback is the grading of a submission by the professor. Drafts are only
+// seen by the staff, the grade reaches the submission when it is released.
+type Feedback struct {
+	ID           valueobject.ID `json:"id"`
+	SubmissionID valueobject.ID `json:"submission_id"`
+	ProfessorID  valueobject.ID `json:"professor_id"`
+	// SubmissionVersion is the version of the submission that was graded
+	SubmissionVersion int        `json:"submission_version"`
+	Comment           string     `json:"comment"`
+	Evaluation        Evaluation `json:"rubric_evaluation,omitempty"`
+	Score
+	IsDraft    bool       `json:"is_draft"`
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// NewFeedback creates the feedback of a submission, it is released right
+// away unless it is a draft
+func NewFeedback(s *Submission, professorID valueobject.ID, comment string, ev Evaluation, score Score, draft bool, now time.Time) (*Feedback, error) {
+	feedback := &Feedback{
+		ID:           valueobject.NewID(),
+		SubmissionID: s.ID,
+		IsDraft:      true,
+		CreatedAt:    now,
+	}
+
+	if err := feedback.Revise(s, professorID, comment, ev, score, draft, now); err != nil {
+		return nil, err
+	}
+
+	return feedback, nil
+}
+
+// FeedbackFromPersistence creates a Feedback instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func FeedbackFromPersistence(
+	id valueobject.ID,
+	submissionID valueobject.ID,
+	professorID valueobject.ID,
+	submissionVersion int,
+	comment string,
+	evaluation Evaluation,
+	score Score,
+	isDraft bool,
+	releasedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Feedback {
+	return &Feedback{
+		ID:                id,
+		SubmissionID:      submissionID,
+		ProfessorID:       professorID,
+		SubmissionVersion: submissionVersion,
+		Comment:           comment,
+		Evaluation:        evaluation,
+		Score:             score,
+		IsDraft:           isDraft,
+		ReleasedAt:        releasedAt,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+	}
+}
+
+// Revise replaces the grading of the submission, released feedback stays
+// released and grades the submission again
+func (f *Feedback) Revise(s *Submission, professorID valueobject.ID, comment string, ev Evaluation, score Score, draft bool, now time.Time) error {
+	comment = strings.TrimSpace(comment)
+	if len(comment) > 10000 {
+		return ErrFeedbackTooLong
+	}
+
+	if draft && !f.IsDraft {
+		return ErrFeedbackReleased
+	}
+
+	f.ProfessorID = professorID
+	f.SubmissionVersion = s.Version
+	f.Comment = comment
+	f.Evaluation = ev
+	f.Score = score
+	f.UpdatedAt = now
+
+	if !draft {
+		return f.Release(s, now)
+	}
+	return nil
+}
+
+// Release shows the feedback to the student and grades the submission
+func (f *Feedback) Release(s *Submission, now time.Time) error {
+	if f.SubmissionVersion != s.Version {
+		return ErrFeedbackOutdated
+	}
+
+	if f.IsDraft {
+		f.IsDraft = false
+		f.ReleasedAt = &now
+	}
+	f.UpdatedAt = now
+
+	s.Grade(f.Score, now)
+	return nil
+}
+
+// Grade stores the score of the submission
+func (s *Submission) Grade(score Score, now time.Time) {
+	points, percentage := score.PointsEarned, score.GradePercentage
+
+	s.Status = SubmissionGraded
+	s.PointsEarned = &points
+	s.GradePercentage = &percentage
+	s.UpdatedAt = now
+}
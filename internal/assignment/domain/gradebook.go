@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrInvalidGradebook = errors.New("the gradebook has errors, nothing was applied")
+	ErrGradedWithRubric = errors.New("the assignment is graded with its rubric")
+)
+
+type GradebookRepository interface {
+	// Load returns the assignments of the section, the students taking it
+	// and their current grades
+	Load(ctx context.Context, sectionID valueobject.ID) (*Gradebook, error)
+	// Apply stores every change or none of them
+	Apply(ctx context.Context, changes []GradeChange) (err error)
+}
+
+// Gradebook is the grid of the students of a section by its assignments
+type Gradebook struct {
+	SectionID valueobject.ID
+	// Columns are ordered by due date
+	Columns []GradebookColumn
+	// Students are ordered by name
+	Students []GradebookStudent
+	// Grades holds the points of the graded and missing submissions
+	Grades map[GradeKey]float64
+}
+
+type GradebookColumn struct {
+	Assignment *Assignment
+	HasRubric  bool
+}
+
+type GradebookStudent struct {
+	ID        valueobject.ID `json:"id"`
+	FirstName string         `json:"first_name"`
+	LastName  string         `json:"last_name"`
+}
+
+// GradeKey is a cell of the gradebook
+type GradeKey struct {
+	AssignmentID valueobject.ID
+	StudentID    valueobject.ID
+}
+
+// GradeChange is a grade set from the gradebook, it grades the submission
+// of the student or creates it when the work was handed in offline
+type GradeChange struct {
+	AssignmentID valueobject.ID
+	StudentID    valueobject.ID
+	// Previous is nil when the cell was empty
+	Previous *float64
+	Score
+}
+
+// Column returns the column of the assignment
+func (g *Gradebook) Column(assignmentID valueobject.ID) (GradebookColumn, bool) {
+	for _, c := range g.Columns {
+		if c.Assignment.ID.Equals(assignmentID) {
+			return c, true
+		}
+	}
+	return GradebookColumn{}, false
+}
+
+// HasStudent checks if the student takes the section
+func (g *Gradebook) HasStudent(studentID valueobject.ID) bool {
+	for _, s := range g.Students {
+		if s.ID.Equals(studentID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Grade returns the points of the student on the assignment, nil when the
+// cell is empty
+func (g *Gradebook) Grade(assignmentID, studentID valueobject.ID) *float64 {
+	points, ok := g.Grades[GradeKey{AssignmentID: assignmentID, StudentID: studentID}]
+	if !ok {
+		return nil
+	}
+	return &points
+}
+
+// Change validates the points given to the student on the assignment of the
+// column, it returns nil when the cell already holds them
+func (g *Gradebook) Change(column GradebookColumn, studentID valueobject.ID, points float64) (*GradeChange, error) {
+	score, err := column.Assignment.Score(nil, nil, &points)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := g.Grade(column.Assignment.ID, studentID)
+	if previous != nil && *previous == score.PointsEarned {
+		return nil, nil
+	}
+
+	// the rubric decides the points, they can't be overwritten by hand
+	if column.HasRubric {
+		return nil, ErrGradedWithRubric
+	}
+
+	return &GradeChange{
+		AssignmentID: column.Assignment.ID,
+		StudentID:    studentID,
+		Previous:     previous,
+		Score:        score,
+	}, nil
+}
@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	// MaxRubricCriteria is how many criteria a rubric can have
+	MaxRubricCriteria = 20
+	// MaxCriterionLevels is how many levels a criterion can have
+	MaxCriterionLevels = 10
+)
+
+var (
+	ErrRubricNotFound       = errors.New("the assignment has no rubric")
+	ErrInvalidRubric        = errors.New("invalid rubric")
+	ErrRubricInUse          = errors.New("the rubric was already used to grade submissions")
+	ErrInvalidEvaluation    = errors.New("the evaluation does not match the rubric")
+	ErrEvaluationRequired   = errors.New("the assignment has a rubric, submissions are graded with an evaluation")
+	ErrPointsRequired       = errors.New("the assignment has no rubric, points earned are required")
+	ErrPointsOutOfRange     = errors.New("points earned must be between zero and the max points of the assignment")
+	ErrUnexpectedEvaluation = errors.New("the assignment has no rubric to evaluate against")
+)
+
+type RubricRepository interface {
+	// Save stores the rubric replacing the one the assignment had
+	Save(ctx context.Context, r *Rubric) (err error)
+	FindByAssignment(ctx context.Context, assignmentID valueobject.ID) (*Rubric, error)
+	Delete(ctx context.Context, assignmentID valueobject.ID) (err error)
+	// IsInUse checks if feedback was given with the rubric of the assignment
+	IsInUse(ctx context.Context, assignmentID valueobject.ID) (bool, error)
+}
+
+// Rubric is the grading guide of an assignment. Each criterion is graded by
+// choosing one of its levels, the points of the chosen levels make the score.
+type Rubric struct {
+	AssignmentID valueobject.ID `json:"assignment_id"`
+	Criteria     []Criterion    `json:"criteria"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type Criterion struct {
+	ID          valueobject.ID `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Levels      []Level        `json:"levels"`
+}
+
+type Level struct {
+	ID          valueobject.ID `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Points      float64        `json:"points"`
+}
+
+// NewRubric creates a new Rubric with validation, criteria and levels get
+// new ids
+func NewRubric(assignmentID valueobject.ID, criteria []Criterion, now time.Time) (*Rubric, error) {
+	if len(criteria) == 0 || len(criteria) > MaxRubricCriteria {
+		return nil, fmt.Errorf("%w: it needs from 1 to %d criteria", ErrInvalidRubric, MaxRubricCriteria)
+	}
+
+	rubric := &Rubric{AssignmentID: assignmentID, UpdatedAt: now}
+	for i, c := range criteria {
+		c.ID = valueobject.NewID()
+		c.Title = strings.TrimSpace(c.Title)
+		c.Description = strings.TrimSpace(c.Description)
+		if c.Title == "" {
+			return nil, fmt.Errorf("%w: criterion %d has no title", ErrInvalidRubric, i+1)
+		}
+
+		if len(c.Levels) < 2 || len(c.Levels) > MaxCriterionLevels {
+			return nil, fmt.Errorf("%w: criterion %q needs from 2 to %d levels", ErrInvalidRubric, c.Title, MaxCriterionLevels)
+		}
+
+		levels := make([]Level, 0, len(c.Levels))
+		for _, l := range c.Levels {
+			l.ID = valueobject.NewID()
+			l.Title = strings.TrimSpace(l.Title)
+			l.Description = strings.TrimSpace(l.Description)
+			if l.Title == "" {
+				return nil, fmt.Errorf("%w: criterion %q has a level without title", ErrInvalidRubric, c.Title)
+			}
+			if l.Points < 0 {
+				return nil, fmt.Errorf("%w: criterion %q has a level with negative points", ErrInvalidRubric, c.Title)
+			}
+			levels = append(levels, l)
+		}
+		c.Levels = levels
+
+		if c.MaxPoints() == 0 {
+			return nil, fmt.Errorf("%w: criterion %q gives no points", ErrInvalidRubric, c.Title)
+		}
+
+		rubric.Criteria = append(rubric.Criteria, c)
+	}
+
+	return rubric, nil
+}
+
+// MaxPoints returns the points of the best level of the criterion
+func (c Criterion) MaxPoints() float64 {
+	var max float64
+	for _, l := range c.Levels {
+		max = math.Max(max, l.Points)
+	}
+	return max
+}
+
+// MaxPoints returns the score of a submission that gets the best level on
+// every criterion
+func (r Rubric) MaxPoints() float64 {
+	var total float64
+	for _, c := range r.Criteria {
+		total += c.MaxPoints()
+	}
+	return total
+}
+
+// Evaluation is the level chosen for each criterion of a rubric
+type Evaluation []CriterionScore
+
+type CriterionScore struct {
+	CriterionID valueobject.ID `json:"criterion_id"`
+	LevelID     valueobject.ID `json:"level_id"`
+	Comment     string         `json:"comment,omitempty"`
+	// Points are the ones of the level, they are set by Evaluate
+	Points float64 `json:"points"`
+}
+
+// Evaluate checks that the evaluation picks one level of every criterion and
+// returns the points it earns, the evaluation gets the points of each level
+func (r Rubric) Evaluate(ev Evaluation) (float64, error) {
+	scored := make(map[valueobject.ID]bool, len(ev))
+	var total float64
+
+	for i, score := range ev {
+		criterion, ok := r.criterion(score.CriterionID)
+		if !ok {
+			return 0, fmt.Errorf("%w: unknown criterion %s", ErrInvalidEvaluation, score.CriterionID.String())
+		}
+
+		if scored[criterion.ID] {
+			return 0, fmt.Errorf("%w: criterion %q is scored twice", ErrInvalidEvaluation, criterion.Title)
+		}
+		scored[criterion.ID] = true
+
+		level, ok := criterion.level(score.LevelID)
+		if !ok {
+			return 0, fmt.Errorf("%w: criterion %q has no level %s", ErrInvalidEvaluation, criterion.Title, score.LevelID.String())
+		}
+
+		ev[i].Comment = strings.TrimSpace(score.Comment)
+		ev[i].Points = level.Points
+		total += level.Points
+	}
+
+	for _, c := range r.Criteria {
+		if !scored[c.ID] {
+			return 0, fmt.Errorf("%w: criterion %q is not scored", ErrInvalidEvaluation, c.Title)
+		}
+	}
+
+	return total, nil
+}
+
+func (r Rubric) criterion(id valueobject.ID) (Criterion, bool) {
+	for _, c := range r.Criteria {
+		if c.ID.Equals(id) {
+			return c, true
+		}
+	}
+	return Criterion{}, false
+}
+
+func (c Criterion) level(id valueobject.ID) (Level, bool) {
+	for _, l := range c.Levels {
+		if l.ID.Equals(id) {
+			return l, true
+		}
+	}
+	return Level{}, false
+}
+
+// Score is the grade of a submission
+type Score struct {
+	PointsEarned    float64 `json:"points_earned"`
+	GradePercentage float64 `json:"grade_percentage"`
+}
+
+// Score grades a submission of the assignment. With a rubric the evaluation
+// decides the points, scaled to the max points of the assignment, without it
+// the points are given directly.
+func (a *Assignment) Score(rubric *Rubric, ev Evaluation, points *float64) (Score, error) {
+	if rubric == nil {
+		if len(ev) > 0 {
+			return Score{}, ErrUnexpectedEvaluation
+		}
+		if points == nil {
+			return Score{}, ErrPointsRequired
+		}
+		if math.IsNaN(*points) || math.IsInf(*points, 0) || *points < 0 || *points > a.MaxPoints {
+			return Score{}, ErrPointsOutOfRange
+		}
+		return Score{
+			PointsEarned:    round2(*points),
+			GradePercentage: round2(*points / a.MaxPoints * 100),
+		}, nil
+	}
+
+	if len(ev) == 0 {
+		return Score{}, ErrEvaluationRequired
+	}
+
+	earned, err := rubric.Evaluate(ev)
+	if err != nil {
+		return Score{}, err
+	}
+
+	ratio := earned / rubric.MaxPoints()
+	return Score{
+		PointsEarned:    round2(ratio * a.MaxPoints),
+		GradePercentage: round2(ratio * 100),
+	}, nil
+}
+
+// round2 rounds to the two decimals the grades are stored with
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
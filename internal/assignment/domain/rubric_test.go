@@ -0,0 +1,140 @@
+package domain_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// essayRubric grades the structure from 0 to 4 points and the sources from
+// 0 to 6, 10 points in total
+func essayRubric(t *testing.T) *domain.Rubric {
+	t.Helper()
+	rubric, err := domain.NewRubric(valueobject.NewID(), []domain.Criterion{
+		{Title: "Structure", Levels: []domain.Level{{Title: "Poor", Points: 0}, {Title: "Good", Points: 2}, {Title: "Excellent", Points: 4}}},
+		{Title: "Sources", Levels: []domain.Level{{Title: "Missing", Points: 0}, {Title: "Cited", Points: 6}}},
+	}, time.Now())
+	if err != nil {
+		t.Fatalf("invalid rubric: %v", err)
+	}
+	return rubric
+}
+
+func score(c domain.Criterion, level int) domain.CriterionScore {
+	return domain.CriterionScore{CriterionID: c.ID, LevelID: c.Levels[level].ID}
+}
+
+func TestNewRubric(t *testing.T) {
+	levels := []domain.Level{{Title: "No", Points: 0}, {Title: "Yes", Points: 1}}
+
+	tests := []struct {
+		name     string
+		criteria []domain.Criterion
+		wantErr  bool
+	}{
+		{name: "valid", criteria: []domain.Criterion{{Title: "Clarity", Levels: levels}}},
+		{name: "no criteria", wantErr: true},
+		{name: "untitled criterion", criteria: []domain.Criterion{{Title: " ", Levels: levels}}, wantErr: true},
+		{name: "a single level", criteria: []domain.Criterion{{Title: "Clarity", Levels: levels[:1]}}, wantErr: true},
+		{name: "untitled level", criteria: []domain.Criterion{{Title: "Clarity", Levels: []domain.Level{{Title: "No"}, {Points: 1}}}}, wantErr: true},
+		{name: "negative points", criteria: []domain.Criterion{{Title: "Clarity", Levels: []domain.Level{{Title: "No", Points: -1}, {Title: "Yes", Points: 1}}}}, wantErr: true},
+		{name: "no points", criteria: []domain.Criterion{{Title: "Clarity", Levels: []domain.Level{{Title: "No"}, {Title: "Yes"}}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewRubric(valueobject.NewID(), tt.criteria, time.Now())
+			if tt.wantErr != errors.Is(err, domain.ErrInvalidRubric) {
+				t.Errorf("got error %v, want an invalid rubric: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRubricEvaluate(t *testing.T) {
+	rubric := essayRubric(t)
+	structure, sources := rubric.Criteria[0], rubric.Criteria[1]
+
+	tests := []struct {
+		name    string
+		ev      domain.Evaluation
+		want    float64
+		wantErr error
+	}{
+		{name: "best levels", ev: domain.Evaluation{score(structure, 2), score(sources, 1)}, want: 10},
+		{name: "any order", ev: domain.Evaluation{score(sources, 0), score(structure, 1)}, want: 2},
+		{name: "missing criterion", ev: domain.Evaluation{score(structure, 2)}, wantErr: domain.ErrInvalidEvaluation},
+		{name: "criterion scored twice", ev: domain.Evaluation{score(structure, 2), score(structure, 0), score(sources, 1)}, wantErr: domain.ErrInvalidEvaluation},
+		{name: "unknown criterion", ev: domain.Evaluation{score(structure, 2), score(sources, 1), {CriterionID: valueobject.NewID(), LevelID: structure.Levels[0].ID}}, wantErr: domain.ErrInvalidEvaluation},
+		{name: "level of another criterion", ev: domain.Evaluation{{CriterionID: structure.ID, LevelID: sources.Levels[1].ID}, score(sources, 1)}, wantErr: domain.ErrInvalidEvaluation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rubric.Evaluate(tt.ev)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v points, want %v", got, tt.want)
+			}
+		})
+	}
+
+	ev := domain.Evaluation{score(structure, 1), score(sources, 1)}
+	ev[0].Comment = "  clear outline  "
+	if _, err := rubric.Evaluate(ev); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ev[0].Points != 2 || ev[1].Points != 6 || ev[0].Comment != "clear outline" {
+		t.Errorf("the evaluation was not filled in: %+v", ev)
+	}
+}
+
+func TestAssignmentScore(t *testing.T) {
+	rubric := essayRubric(t)
+	structure, sources := rubric.Criteria[0], rubric.Criteria[1]
+	assignment := &domain.Assignment{MaxPoints: 20}
+
+	points := func(p float64) *float64 { return &p }
+
+	tests := []struct {
+		name    string
+		rubric  *domain.Rubric
+		ev      domain.Evaluation
+		points  *float64
+		want    domain.Score
+		wantErr error
+	}{
+		{name: "points", points: points(15), want: domain.Score{PointsEarned: 15, GradePercentage: 75}},
+		{name: "rounds to two decimals", points: points(13.3333), want: domain.Score{PointsEarned: 13.33, GradePercentage: 66.67}},
+		{name: "zero", points: points(0), want: domain.Score{}},
+		{name: "max points", points: points(20), want: domain.Score{PointsEarned: 20, GradePercentage: 100}},
+		{name: "points required", wantErr: domain.ErrPointsRequired},
+		{name: "negative points", points: points(-1), wantErr: domain.ErrPointsOutOfRange},
+		{name: "points over the max", points: points(20.01), wantErr: domain.ErrPointsOutOfRange},
+		{name: "NaN", points: points(math.NaN()), wantErr: domain.ErrPointsOutOfRange},
+		{name: "infinity", points: points(math.Inf(1)), wantErr: domain.ErrPointsOutOfRange},
+		{name: "evaluation without rubric", ev: domain.Evaluation{score(structure, 0)}, points: points(1), wantErr: domain.ErrUnexpectedEvaluation},
+		{name: "rubric scaled to the max points", rubric: rubric, ev: domain.Evaluation{score(structure, 1), score(sources, 1)}, want: domain.Score{PointsEarned: 16, GradePercentage: 80}},
+		{name: "rubric ignores points", rubric: rubric, ev: domain.Evaluation{score(structure, 0), score(sources, 0)}, points: points(20), want: domain.Score{}},
+		{name: "evaluation required", rubric: rubric, points: points(10), wantErr: domain.ErrEvaluationRequired},
+		{name: "invalid evaluation", rubric: rubric, ev: domain.Evaluation{score(structure, 0)}, wantErr: domain.ErrInvalidEvaluation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := assignment.Score(tt.rubric, tt.ev, tt.points)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// MaxSubmissionAttachments is how many files a submission can hold
+const MaxSubmissionAttachments = 5
+
+var (
+	ErrSubmissionNotFound   = errors.New("submission does not exist")
+	ErrEmptySubmission      = errors.New("a submission needs content or at least one attachment")
+	ErrTooManySubmitFiles   = errors.New("a submission cannot have more than 5 attachments")
+	ErrResubmissionClosed   = errors.New("the deadline passed, the submission can no longer be replaced")
+	ErrSubmissionGraded     = errors.New("the submission was already graded")
+	ErrNotEnrolledInSection = errors.New("only students enrolled in the section can submit")
+	ErrSubmissionConflict   = errors.New("the submission changed concurrently, try again")
+)
+
+type SubmissionRepository interface {
+	// Save stores the current version of the submission and appends it to
+	// its history, it fails with ErrSubmissionConflict when another version
+	// was stored since the submission was read
+	Save(ctx context.Context, s *Submission) (err error)
+	FindByStudent(ctx context.Context, assignmentID, studentID valueobject.ID) (*Submission, error)
+	ListByAssignment(ctx context.Context, assignmentID valueobject.ID) ([]*Submission, error)
+	// Versions returns the history of the submission, oldest first
+	Versions(ctx context.Context, submissionID valueobject.ID) ([]SubmissionVersion, error)
+	// Unsubmitted returns the enrolled students without a submission on the
+	// published assignments that were due before now
+	Unsubmitted(ctx context.Context, now time.Time) ([]Unsubmitted, error)
+	// MarkMissing stores the missing submissions and returns the ones it
+	// created, students that submitted meanwhile are skipped
+	MarkMissing(ctx context.Context, missing []*Submission) ([]*Submission, error)
+}
+
+// Unsubmitted is an enrolled student that never handed in an assignment
+type Unsubmitted struct {
+	AssignmentID    valueobject.ID
+	AssignmentTitle string
+	StudentID       valueobject.ID
+	// Deadline is the one of the student, it may differ from the due date
+	// of the assignment
+	Deadline Deadline
+}
+
+// SubmissionStatus is the state of the work of a student on an assignment
+type SubmissionStatus string
+
+const (
+	SubmissionSubmitted SubmissionStatus = "submitted"
+	SubmissionLate      SubmissionStatus = "late"
+	SubmissionGraded    SubmissionStatus = "graded"
+	SubmissionMissing   SubmissionStatus = "missing"
+)
+
+// Deadline is the due date of an assignment plus the grace period the
+// institution allows and the extension of the student, nil due dates never
+// close
+type Deadline struct {
+	DueDate   *time.Time
+	Grace     time.Duration
+	Extension time.Duration
+}
+
+// IsLate checks if work handed in at t is past the deadline
+func (d Deadline) IsLate(t time.Time) bool {
+	return d.DueDate != nil && t.After(d.DueDate.Add(d.Extension+d.Grace))
+}
+
+// Deadline returns the deadline of the assignment for a student under the
+// grace period and the extensions of the student
+func (a *Assignment) Deadline(grace time.Duration, extensions []Extension) Deadline {
+	return Deadline{DueDate: a.DueDate, Grace: grace, Extension: ExtensionFor(a, extensions)}
+}
+
+// Submission is the work of a student on an assignment, it holds its latest
+// version
+type Submission struct {
+	ID           valueobject.ID     `json:"id"`
+	AssignmentID valueobject.ID     `json:"assignment_id"`
+	StudentID    valueobject.ID     `json:"student_id"`
+	Content      string             `json:"content"`
+	Attachments  shared.Attachments `json:"attachments"`
+	Status       SubmissionStatus   `json:"status"`
+	// Version counts the times the student handed in, zero for missing
+	// submissions created by the sweeper
+	Version         int       `json:"version"`
+	SubmittedAt     time.Time `json:"submitted_at"`
+	PointsEarned    *float64  `json:"points_earned,omitempty"`
+	GradePercentage *float64  `json:"grade_percentage,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// SubmissionVersion is a past or current version of a submission
+type SubmissionVersion struct {
+	ID           valueobject.ID     `json:"id"`
+	SubmissionID valueobject.ID     `json:"submission_id"`
+	Version      int                `json:"version"`
+	Content      string             `json:"content"`
+	Attachments  shared.Attachments `json:"attachments"`
+	Status       SubmissionStatus   `json:"status"`
+	SubmittedAt  time.Time          `json:"submitted_at"`
+}
+
+// NewSubmission creates the submission of a student that has none yet, it
+// gets its first version with Submit
+func NewSubmission(assignmentID, studentID valueobject.ID) *Submission {
+	return &Submission{
+		ID:           valueobject.NewID(),
+		AssignmentID: assignmentID,
+		StudentID:    studentID,
+		Attachments:  shared.Attachments{},
+	}
+}
+
+// NewMissingSubmission records that the student didn't hand in the
+// assignment, it is worth zero points until the student submits late
+func NewMissingSubmission(assignmentID, studentID valueobject.ID, now time.Time) *Submission {
+	points, percentage := 0.0, 0.0
+
+	return &Submission{
+		ID:              valueobject.NewID(),
+		AssignmentID:    assignmentID,
+		StudentID:       studentID,
+		Attachments:     shared.Attachments{},
+		Status:          SubmissionMissing,
+		SubmittedAt:     now,
+		PointsEarned:    &points,
+		GradePercentage: &percentage,
+		UpdatedAt:       now,
+	}
+}
+
+// SubmissionFromPersistence creates a Submission instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func SubmissionFromPersistence(
+	id valueobject.ID,
+	assignmentID valueobject.ID,
+	studentID valueobject.ID,
+	content string,
+	attachments shared.Attachments,
+	status SubmissionStatus,
+	version int,
+	submittedAt time.Time,
+	pointsEarned *float64,
+	gradePercentage *float64,
+	updatedAt time.Time,
+) *Submission {
+	if attachments == nil {
+		attachments = shared.Attachments{}
+	}
+
+	return &Submission{
+		ID:              id,
+		AssignmentID:    assignmentID,
+		StudentID:       studentID,
+		Content:         content,
+		Attachments:     attachments,
+		Status:          status,
+		Version:         version,
+		SubmittedAt:     submittedAt,
+		PointsEarned:    pointsEarned,
+		GradePercentage: gradePercentage,
+		UpdatedAt:       updatedAt,
+	}
+}
+
+// Submit hands in a new version of the work. The first version is accepted
+// at any time and marked late past the deadline, later versions replace it
+// only until the deadline.
+func (s *Submission) Submit(content string, attachments shared.Attachments, deadline Deadline, now time.Time) error {
+	content = strings.TrimSpace(content)
+	if content == "" && len(attachments) == 0 {
+		return ErrEmptySubmission
+	}
+
+	if len(attachments) > MaxSubmissionAttachments {
+		return ErrTooManySubmitFiles
+	}
+
+	if s.Status == SubmissionGraded {
+		return ErrSubmissionGraded
+	}
+
+	// missing submissions were never handed in, the first version replaces them
+	if s.Version > 0 && deadline.IsLate(now) {
+		return ErrResubmissionClosed
+	}
+
+	s.Status = SubmissionSubmitted
+	if deadline.IsLate(now) {
+		s.Status = SubmissionLate
+	}
+
+	if attachments == nil {
+		attachments = shared.Attachments{}
+	}
+
+	s.Content = content
+	s.Attachments = attachments
+	s.PointsEarned = nil
+	s.GradePercentage = nil
+	s.Version++
+	s.SubmittedAt = now
+	s.UpdatedAt = now
+
+	return nil
+}
+
+// CurrentVersion returns the version the submission holds
+func (s *Submission) CurrentVersion() SubmissionVersion {
+	return SubmissionVersion{
+		ID:           valueobject.NewID(),
+		SubmissionID: s.ID,
+		Version:      s.Version,
+		Content:      s.Content,
+		Attachments:  s.Attachments,
+		Status:       s.Status,
+		SubmittedAt:  s.SubmittedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func TestSubmissionSubmit(t *testing.T) {
+	created := time.Date(2025, time.March, 3, 8, 0, 0, 0, time.UTC)
+	due := created.Add(10 * 24 * time.Hour)
+	assignment := &domain.Assignment{ID: valueobject.NewID(), SectionID: valueobject.NewID(), DueDate: &due, CreatedAt: created}
+
+	// 20% of the 10 day window, and 15 minutes of grace on top
+	extension := domain.Extension{SectionID: assignment.SectionID, AssignmentID: &assignment.ID, Percentage: 20}
+	deadline := assignment.Deadline(15*time.Minute, []domain.Extension{extension})
+	closes := due.Add(2*24*time.Hour + 15*time.Minute)
+
+	tests := []struct {
+		name     string
+		deadline domain.Deadline
+		from     domain.SubmissionStatus
+		version  int
+		at       time.Time
+		want     domain.SubmissionStatus
+		wantErr  error
+	}{
+		{name: "before the due date", deadline: deadline, at: due.Add(-time.Minute), want: domain.SubmissionSubmitted},
+		{name: "within the extension", deadline: deadline, at: due.Add(time.Hour), want: domain.SubmissionSubmitted},
+		{name: "at the end of the grace period", deadline: deadline, at: closes, want: domain.SubmissionSubmitted},
+		{name: "a nanosecond past the grace period", deadline: deadline, at: closes.Add(time.Nanosecond), want: domain.SubmissionLate},
+		{name: "without the extension", deadline: assignment.Deadline(15*time.Minute, nil), at: due.Add(16 * time.Minute), want: domain.SubmissionLate},
+		{name: "without grace nor extension", deadline: assignment.Deadline(0, nil), at: due.Add(time.Second), want: domain.SubmissionLate},
+		{name: "no due date", deadline: domain.Deadline{}, at: due.AddDate(1, 0, 0), want: domain.SubmissionSubmitted},
+		{name: "replaces a version until the deadline", deadline: deadline, from: domain.SubmissionSubmitted, version: 2, at: closes, want: domain.SubmissionSubmitted},
+		{name: "refuses replacing a version past the deadline", deadline: deadline, from: domain.SubmissionSubmitted, version: 2, at: closes.Add(time.Nanosecond), wantErr: domain.ErrResubmissionClosed},
+		{name: "refuses replacing a late version", deadline: deadline, from: domain.SubmissionLate, version: 1, at: closes.Add(time.Hour), wantErr: domain.ErrResubmissionClosed},
+		{name: "hands in a missing submission late", deadline: deadline, from: domain.SubmissionMissing, at: closes.Add(time.Hour), want: domain.SubmissionLate},
+		{name: "refuses replacing a graded submission", deadline: deadline, from: domain.SubmissionGraded, version: 1, at: due, wantErr: domain.ErrSubmissionGraded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := domain.NewSubmission(assignment.ID, valueobject.NewID())
+			s.Status, s.Version = tt.from, tt.version
+			if tt.from == domain.SubmissionMissing {
+				s = domain.NewMissingSubmission(assignment.ID, valueobject.NewID(), closes)
+			}
+
+			err := s.Submit(" my essay ", nil, tt.deadline, tt.at)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if s.Status != tt.from || s.Version != tt.version {
+					t.Errorf("got status %s version %d, the submission changed on error", s.Status, s.Version)
+				}
+				return
+			}
+
+			if s.Status != tt.want {
+				t.Errorf("got status %s, want %s", s.Status, tt.want)
+			}
+			if s.Version != tt.version+1 || !s.SubmittedAt.Equal(tt.at) || s.Content != "my essay" {
+				t.Errorf("got version %d submitted at %s with %q, want version %d", s.Version, s.SubmittedAt, s.Content, tt.version+1)
+			}
+			if s.PointsEarned != nil || s.GradePercentage != nil || s.Attachments == nil {
+				t.Errorf("got %+v, want it ungraded with no attachments", s)
+			}
+		})
+	}
+}
+
+func TestSubmissionSubmitContent(t *testing.T) {
+	files := func(n int) shared.Attachments {
+		list := make(shared.Attachments, n)
+		for i := range list {
+			list[i] = shared.Attachment{ID: valueobject.NewID(), Name: "essay.pdf"}
+		}
+		return list
+	}
+
+	tests := []struct {
+		name        string
+		content     string
+		attachments shared.Attachments
+		wantErr     error
+	}{
+		{name: "content only", content: "my essay"},
+		{name: "attachments only", attachments: files(1)},
+		{name: "the most attachments", attachments: files(domain.MaxSubmissionAttachments)},
+		{name: "blank content and no attachments", content: "  ", wantErr: domain.ErrEmptySubmission},
+		{name: "too many attachments", attachments: files(domain.MaxSubmissionAttachments + 1), wantErr: domain.ErrTooManySubmitFiles},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := domain.NewSubmission(valueobject.NewID(), valueobject.NewID())
+			if err := s.Submit(tt.content, tt.attachments, domain.Deadline{}, time.Now()); !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
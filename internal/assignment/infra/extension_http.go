@@ -0,0 +1,49 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type extensionHandler struct {
+	interactor application.ExtensionInteractor
+}
+
+func NewExtensionHandler(uc application.ExtensionInteractor) *extensionHandler {
+	return &extensionHandler{uc}
+}
+
+func (h extensionHandler) Grant(c fiber.Ctx) error {
+	var req application.GrantExtensionInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Grant(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h extensionHandler) Revoke(c fiber.Ctx) error {
+	if err := h.interactor.Revoke(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("extensionId")); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h extensionHandler) List(c fiber.Ctx) error {
+	data, err := h.interactor.List(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
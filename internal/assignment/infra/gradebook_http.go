@@ -0,0 +1,60 @@
+package infra
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type gradebookHandler struct {
+	interactor application.GradebookInteractor
+}
+
+func NewGradebookHandler(uc application.GradebookInteractor) *gradebookHandler {
+	return &gradebookHandler{uc}
+}
+
+func (h gradebookHandler) Export(c fiber.Ctx) error {
+	var out bytes.Buffer
+
+	if err := h.interactor.Export(c.Context(), httpx.Actor(c), c.Params("id"), &out); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="gradebook-%s.csv"`, c.Params("id")))
+	return c.Status(http.StatusOK).Send(out.Bytes())
+}
+
+// Import takes the CSV as the multipart field "file" or as the raw body,
+// dry_run=true only reports the changes
+func (h gradebookHandler) Import(c fiber.Ctx) error {
+	var content io.Reader = bytes.NewReader(c.Body())
+
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		file, err := c.FormFile("file")
+		if err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "a multipart file field named file is required"})
+		}
+
+		upload, err := file.Open()
+		if err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		defer upload.Close()
+		content = upload
+	}
+
+	data, err := h.interactor.Import(c.Context(), httpx.Actor(c), c.Params("id"), content, c.Query("dry_run") == "true")
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
@@ -0,0 +1,82 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type gradingHandler struct {
+	interactor application.GradingInteractor
+}
+
+func NewGradingHandler(uc application.GradingInteractor) *gradingHandler {
+	return &gradingHandler{uc}
+}
+
+func (h gradingHandler) SetRubric(c fiber.Ctx) error {
+	var req application.RubricInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.SetRubric(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h gradingHandler) GetRubric(c fiber.Ctx) error {
+	data, err := h.interactor.GetRubric(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h gradingHandler) DeleteRubric(c fiber.Ctx) error {
+	if err := h.interactor.DeleteRubric(c.Context(), httpx.Actor(c), c.Params("id")); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h gradingHandler) Grade(c fiber.Ctx) error {
+	var req application.GradeInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Grade(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h gradingHandler) Release(c fiber.Ctx) error {
+	data, err := h.interactor.Release(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h gradingHandler) Feedback(c fiber.Ctx) error {
+	data, err := h.interactor.Feedback(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
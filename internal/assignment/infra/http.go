@@ -0,0 +1,118 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type assignmentHandler struct {
+	interactor application.AssignmentInteractor
+}
+
+func NewAssignmentHandler(uc application.AssignmentInteractor) *assignmentHandler {
+	return &assignmentHandler{uc}
+}
+
+func (h assignmentHandler) CreateAssignment(c fiber.Ctx) error {
+	var req application.AssignmentInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Create(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h assignmentHandler) ListBySection(c fiber.Ctx) error {
+	data, err := h.interactor.ListBySection(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h assignmentHandler) GetAssignment(c fiber.Ctx) error {
+	data, err := h.interactor.Get(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h assignmentHandler) UpdateAssignment(c fiber.Ctx) error {
+	var req application.AssignmentInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Update(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h assignmentHandler) Publish(c fiber.Ctx) error {
+	data, err := h.interactor.Publish(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h assignmentHandler) Unpublish(c fiber.Ctx) error {
+	data, err := h.interactor.Unpublish(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+// Attach uploads the multipart field "file" as an attachment
+func (h assignmentHandler) Attach(c fiber.Ctx) error {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "a multipart file field named file is required"})
+	}
+
+	content, err := file.Open()
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	defer content.Close()
+
+	data, err := h.interactor.Attach(c.Context(), httpx.Actor(c), c.Params("id"), application.FileInput{
+		Name:        file.Filename,
+		ContentType: file.Header.Get("Content-Type"),
+		Size:        file.Size,
+		Content:     content,
+	})
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h assignmentHandler) Detach(c fiber.Ctx) error {
+	data, err := h.interactor.Detach(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("attachmentId"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
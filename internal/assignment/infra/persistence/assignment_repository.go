@@ -0,0 +1,300 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const assignmentColumns = `
+	a.id,
+	a.course_section_id,
+	a.professor_id,
+	a.title,
+	COALESCE(a.description, ''),
+	COALESCE(a.assignment_type, ''),
+	a.max_points,
+	a.due_date,
+	COALESCE(a.is_published, false),
+	a.attachments,
+	COALESCE(a.created_at, NOW()),
+	COALESCE(a.updated_at, NOW())
+`
+
+// storedAttachments reads the attachments of a as an array, rows imported
+// with another format are read as having none
+const storedAttachments = `CASE WHEN jsonb_typeof(a.attachments) = 'array' THEN a.attachments ELSE '[]'::jsonb END`
+
+type postgresAssignmentRepository struct {
+	pool *sql.DB
+}
+
+func NewAssignmentRepository(db *sql.DB) *postgresAssignmentRepository {
+	return &postgresAssignmentRepository{db}
+}
+
+func (r postgresAssignmentRepository) Create(ctx context.Context, a *domain.Assignment) error {
+	attachments, err := json.Marshal(a.Attachments)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO assignments (
+			id,
+			course_section_id,
+			professor_id,
+			title,
+			description,
+			assignment_type,
+			max_points,
+			due_date,
+			is_published,
+			attachments,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		a.ID.String(),
+		a.SectionID.String(),
+		a.ProfessorID.String(),
+		a.Title,
+		a.Description,
+		string(a.Type),
+		a.MaxPoints,
+		a.DueDate,
+		a.IsPublished,
+		attachments,
+		a.CreatedAt,
+		a.UpdatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r postgresAssignmentRepository) Update(ctx context.Context, a *domain.Assignment) error {
+	query := `
+		UPDATE assignments SET
+			title = $2,
+			description = $3,
+			assignment_type = $4,
+			max_points = $5,
+			due_date = $6,
+			is_published = $7,
+			updated_at = $8
+		WHERE id = $1
+	`
+	result, err := r.pool.ExecContext(ctx, query,
+		a.ID.String(),
+		a.Title,
+		a.Description,
+		string(a.Type),
+		a.MaxPoints,
+		a.DueDate,
+		a.IsPublished,
+		a.UpdatedAt,
+	)
+	if err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrAssignmentNotFound
+	}
+	return nil
+}
+
+func (r postgresAssignmentRepository) Attach(ctx context.Context, id valueobject.ID, file shared.Attachment, now time.Time) (*domain.Assignment, error) {
+	raw, err := json.Marshal(shared.Attachments{file})
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		UPDATE assignments a SET
+			attachments = ` + storedAttachments + ` || $2::jsonb,
+			updated_at = $3
+		WHERE a.id = $1 AND jsonb_array_length(` + storedAttachments + `) < $4
+		RETURNING ` + assignmentColumns
+	assignment, err := scanAssignment(r.pool.QueryRowContext(ctx, query, id.String(), raw, now, domain.MaxAttachments))
+	if errors.Is(err, sql.ErrNoRows) {
+		// the assignment is either gone or full
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrTooManyAttachments
+	}
+	return assignment, err
+}
+
+func (r postgresAssignmentRepository) Detach(ctx context.Context, id, fileID valueobject.ID, now time.Time) (*domain.Assignment, error) {
+	query := `
+		UPDATE assignments a SET
+			attachments = (
+				SELECT COALESCE(jsonb_agg(f.file ORDER BY f.position), '[]'::jsonb)
+				FROM jsonb_array_elements(` + storedAttachments + `) WITH ORDINALITY AS f(file, position)
+				WHERE f.file->>'id' IS DISTINCT FROM $2
+			),
+			updated_at = $3
+		WHERE a.id = $1 AND ` + storedAttachments + ` @> jsonb_build_array(jsonb_build_object('id', $2::text))
+		RETURNING ` + assignmentColumns
+	assignment, err := scanAssignment(r.pool.QueryRowContext(ctx, query, id.String(), fileID.String(), now))
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, shared.ErrAttachmentNotFound
+	}
+	return assignment, err
+}
+
+func (r postgresAssignmentRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.Assignment, error) {
+	query := `SELECT ` + assignmentColumns + ` FROM assignments a WHERE a.id = $1`
+
+	assignment, err := scanAssignment(r.pool.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAssignmentNotFound
+	}
+	return assignment, err
+}
+
+func (r postgresAssignmentRepository) ListBySection(ctx context.Context, sectionID valueobject.ID, publishedOnly bool) ([]*domain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM assignments a
+		WHERE a.course_section_id = $1 AND (NOT $2 OR COALESCE(a.is_published, false))
+		ORDER BY a.due_date NULLS LAST, a.created_at
+	`
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String(), publishedOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*domain.Assignment
+	for rows.Next() {
+		assignment, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, rows.Err()
+}
+
+func (r postgresAssignmentRepository) Membership(ctx context.Context, sectionID, userID valueobject.ID) (domain.Membership, error) {
+	var (
+		exists     bool
+		membership domain.Membership
+	)
+
+	query := `
+		SELECT
+			EXISTS (SELECT 1 FROM course_sections WHERE id = $1),
+			EXISTS (SELECT 1 FROM course_sections WHERE id = $1 AND professor_id = $2),
+			EXISTS (
+				SELECT 1 FROM enrollments
+				WHERE course_section_id = $1 AND student_id = $2 AND COALESCE(status, 'enrolled') <> 'dropped'
+			),
+			EXISTS (
+				SELECT 1 FROM enrollments
+				WHERE course_section_id = $1 AND student_id = $2 AND COALESCE(status, 'enrolled') = 'enrolled'
+			)
+	`
+	if err := r.pool.QueryRowContext(ctx, query, sectionID.String(), userID.String()).Scan(
+		&exists,
+		&membership.IsProfessor,
+		&membership.IsStudent,
+		&membership.IsEnrolled,
+	); err != nil {
+		return domain.Membership{}, err
+	}
+
+	if !exists {
+		return domain.Membership{}, domain.ErrSectionNotFound
+	}
+	return membership, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAssignment(row scanner) (*domain.Assignment, error) {
+	var (
+		id, sectionID, professorID valueobject.ID
+		title, description         string
+		assignmentType             string
+		maxPoints                  float64
+		dueDate                    sql.NullTime
+		isPublished                bool
+		attachments                []byte
+		createdAt, updatedAt       time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&sectionID,
+		&professorID,
+		&title,
+		&description,
+		&assignmentType,
+		&maxPoints,
+		&dueDate,
+		&isPublished,
+		&attachments,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var due *time.Time
+	if dueDate.Valid {
+		due = &dueDate.Time
+	}
+
+	return domain.AssignmentFromPersistence(
+		id,
+		sectionID,
+		professorID,
+		title,
+		description,
+		domain.Type(assignmentType),
+		maxPoints,
+		due,
+		isPublished,
+		decodeAttachments(attachments),
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// decodeAttachments reads the stored attachments, rows imported with another
+// format are read as having none
+func decodeAttachments(raw []byte) shared.Attachments {
+	var attachments shared.Attachments
+	if len(raw) == 0 {
+		return attachments
+	}
+	if err := json.Unmarshal(raw, &attachments); err != nil {
+		return nil
+	}
+	return attachments
+}
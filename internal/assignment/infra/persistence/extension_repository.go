@@ -0,0 +1,172 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const extensionColumns = `
+	id,
+	course_section_id,
+	student_id,
+	assignment_id,
+	COALESCE(percentage, 0),
+	COALESCE(offset_minutes, 0),
+	reason,
+	granted_by,
+	created_at
+`
+
+type postgresExtensionRepository struct {
+	pool *sql.DB
+}
+
+func NewExtensionRepository(db *sql.DB) *postgresExtensionRepository {
+	return &postgresExtensionRepository{db}
+}
+
+func (r postgresExtensionRepository) Grant(ctx context.Context, e *domain.Extension) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var assignmentID any
+	if e.AssignmentID != nil {
+		assignmentID = e.AssignmentID.String()
+	}
+
+	replace := `
+		DELETE FROM assignment_extensions
+		WHERE course_section_id = $1
+		  AND student_id = $2
+		  AND assignment_id IS NOT DISTINCT FROM $3::uuid
+	`
+	if _, err := tx.ExecContext(ctx, replace, e.SectionID.String(), e.StudentID.String(), assignmentID); err != nil {
+		return err
+	}
+
+	insert := `
+		INSERT INTO assignment_extensions (
+			id,
+			course_section_id,
+			student_id,
+			assignment_id,
+			percentage,
+			offset_minutes,
+			reason,
+			granted_by,
+			created_at
+		) VALUES ($1, $2, $3, $4, NULLIF($5, 0), NULLIF($6, 0), $7, $8, $9)
+	`
+	if _, err := tx.ExecContext(ctx, insert,
+		e.ID.String(),
+		e.SectionID.String(),
+		e.StudentID.String(),
+		assignmentID,
+		e.Percentage,
+		e.OffsetMinutes,
+		e.Reason,
+		e.GrantedBy.String(),
+		e.CreatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresExtensionRepository) Revoke(ctx context.Context, sectionID, id valueobject.ID) error {
+	query := `DELETE FROM assignment_extensions WHERE id = $1 AND course_section_id = $2`
+
+	result, err := r.pool.ExecContext(ctx, query, id.String(), sectionID.String())
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrExtensionNotFound
+	}
+	return nil
+}
+
+func (r postgresExtensionRepository) ListBySection(ctx context.Context, sectionID valueobject.ID) ([]domain.Extension, error) {
+	query := `
+		SELECT ` + extensionColumns + `
+		FROM assignment_extensions
+		WHERE course_section_id = $1
+		ORDER BY student_id, assignment_id NULLS FIRST
+	`
+	return r.list(ctx, query, sectionID.String())
+}
+
+func (r postgresExtensionRepository) ForStudent(ctx context.Context, a *domain.Assignment, studentID valueobject.ID) ([]domain.Extension, error) {
+	query := `
+		SELECT ` + extensionColumns + `
+		FROM assignment_extensions
+		WHERE course_section_id = $1
+		  AND student_id = $2
+		  AND (assignment_id IS NULL OR assignment_id = $3)
+	`
+	return r.list(ctx, query, a.SectionID.String(), studentID.String(), a.ID.String())
+}
+
+func (r postgresExtensionRepository) list(ctx context.Context, query string, args ...any) ([]domain.Extension, error) {
+	rows, err := r.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var extensions []domain.Extension
+	for rows.Next() {
+		var (
+			id, sectionID, studentID valueobject.ID
+			assignmentID, grantedBy  valueobject.ID
+			percentage, offset       int
+			reason                   string
+			createdAt                time.Time
+		)
+		if err := rows.Scan(
+			&id,
+			&sectionID,
+			&studentID,
+			&assignmentID,
+			&percentage,
+			&offset,
+			&reason,
+			&grantedBy,
+			&createdAt,
+		); err != nil {
+			return nil, err
+		}
+
+		var assignment *valueobject.ID
+		if !assignmentID.IsEmpty() {
+			assignment = &assignmentID
+		}
+
+		extensions = append(extensions, *domain.ExtensionFromPersistence(
+			id,
+			sectionID,
+			studentID,
+			assignment,
+			percentage,
+			time.Duration(offset)*time.Minute,
+			reason,
+			grantedBy,
+			createdAt,
+		))
+	}
+
+	return extensions, rows.Err()
+}
@@ -0,0 +1,196 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresFeedbackRepository struct {
+	pool *sql.DB
+}
+
+func NewFeedbackRepository(db *sql.DB) *postgresFeedbackRepository {
+	return &postgresFeedbackRepository{db}
+}
+
+func (r postgresFeedbackRepository) Save(ctx context.Context, f *domain.Feedback, s *domain.Submission) error {
+	var evaluation []byte
+	if len(f.Evaluation) > 0 {
+		raw, err := json.Marshal(f.Evaluation)
+		if err != nil {
+			return err
+		}
+		evaluation = raw
+	}
+
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO submission_feedbacks (
+			id,
+			submission_id,
+			professor_id,
+			submission_version,
+			comment,
+			rubric_evaluation,
+			points_earned,
+			grade_percentage,
+			is_draft,
+			released_at,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (submission_id) DO UPDATE SET
+			professor_id = EXCLUDED.professor_id,
+			submission_version = EXCLUDED.submission_version,
+			comment = EXCLUDED.comment,
+			rubric_evaluation = EXCLUDED.rubric_evaluation,
+			points_earned = EXCLUDED.points_earned,
+			grade_percentage = EXCLUDED.grade_percentage,
+			is_draft = EXCLUDED.is_draft,
+			released_at = EXCLUDED.released_at,
+			updated_at = EXCLUDED.updated_at
+	`
+	if _, err := tx.ExecContext(ctx, query,
+		f.ID.String(),
+		f.SubmissionID.String(),
+		f.ProfessorID.String(),
+		f.SubmissionVersion,
+		f.Comment,
+		evaluation,
+		f.PointsEarned,
+		f.GradePercentage,
+		f.IsDraft,
+		f.ReleasedAt,
+		f.CreatedAt,
+		f.UpdatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if !f.IsDraft {
+		// the student may have handed in a new version meanwhile
+		grade := `
+			UPDATE submissions SET
+				status = $3,
+				points_earned = $4,
+				grade_percentage = $5,
+				updated_at = $6
+			WHERE id = $1 AND COALESCE(version, 1) = $2
+		`
+		result, err := tx.ExecContext(ctx, grade,
+			s.ID.String(),
+			s.Version,
+			string(s.Status),
+			s.PointsEarned,
+			s.GradePercentage,
+			s.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return domain.ErrSubmissionConflict
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresFeedbackRepository) FindBySubmission(ctx context.Context, submissionID valueobject.ID) (*domain.Feedback, error) {
+	var (
+		id, submission       valueobject.ID
+		professorID          valueobject.ID
+		version              int
+		comment              string
+		evaluation           []byte
+		points, percentage   sql.NullFloat64
+		isDraft              bool
+		releasedAt           sql.NullTime
+		createdAt, updatedAt time.Time
+	)
+
+	query := `
+		SELECT
+			id,
+			submission_id,
+			professor_id,
+			submission_version,
+			comment,
+			rubric_evaluation,
+			points_earned,
+			grade_percentage,
+			COALESCE(is_draft, false),
+			released_at,
+			COALESCE(created_at, NOW()),
+			COALESCE(updated_at, created_at, NOW())
+		FROM submission_feedbacks
+		WHERE submission_id = $1
+	`
+	if err := r.pool.QueryRowContext(ctx, query, submissionID.String()).Scan(
+		&id,
+		&submission,
+		&professorID,
+		&version,
+		&comment,
+		&evaluation,
+		&points,
+		&percentage,
+		&isDraft,
+		&releasedAt,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrFeedbackNotFound
+		}
+		return nil, err
+	}
+
+	var released *time.Time
+	if releasedAt.Valid {
+		released = &releasedAt.Time
+	}
+
+	return domain.FeedbackFromPersistence(
+		id,
+		submission,
+		professorID,
+		version,
+		comment,
+		decodeEvaluation(evaluation),
+		domain.Score{PointsEarned: points.Float64, GradePercentage: percentage.Float64},
+		isDraft,
+		released,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// decodeEvaluation reads the stored rubric evaluation, evaluations stored
+// with another format are read as having none
+func decodeEvaluation(raw []byte) domain.Evaluation {
+	var evaluation domain.Evaluation
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, &evaluation); err != nil {
+		return nil
+	}
+	return evaluation
+}
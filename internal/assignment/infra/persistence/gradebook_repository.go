@@ -0,0 +1,185 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresGradebookRepository struct {
+	pool *sql.DB
+}
+
+func NewGradebookRepository(db *sql.DB) *postgresGradebookRepository {
+	return &postgresGradebookRepository{db}
+}
+
+func (r postgresGradebookRepository) Load(ctx context.Context, sectionID valueobject.ID) (*domain.Gradebook, error) {
+	var exists bool
+	if err := r.pool.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM course_sections WHERE id = $1)`, sectionID.String()).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrSectionNotFound
+	}
+
+	gradebook := &domain.Gradebook{SectionID: sectionID, Grades: make(map[domain.GradeKey]float64)}
+
+	assignments, err := NewAssignmentRepository(r.pool).ListBySection(ctx, sectionID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rubrics, err := r.rubrics(ctx, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range assignments {
+		gradebook.Columns = append(gradebook.Columns, domain.GradebookColumn{Assignment: a, HasRubric: rubrics[a.ID]})
+	}
+
+	if gradebook.Students, err = r.students(ctx, sectionID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT s.assignment_id, s.student_id, s.points_earned
+		FROM submissions s
+		JOIN assignments a ON a.id = s.assignment_id
+		WHERE a.course_section_id = $1 AND s.points_earned IS NOT NULL
+	`
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			key    domain.GradeKey
+			points float64
+		)
+		if err := rows.Scan(&key.AssignmentID, &key.StudentID, &points); err != nil {
+			return nil, err
+		}
+		gradebook.Grades[key] = points
+	}
+
+	return gradebook, rows.Err()
+}
+
+func (r postgresGradebookRepository) rubrics(ctx context.Context, sectionID valueobject.ID) (map[valueobject.ID]bool, error) {
+	query := `
+		SELECT r.assignment_id
+		FROM assignment_rubrics r
+		JOIN assignments a ON a.id = r.assignment_id
+		WHERE a.course_section_id = $1
+	`
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rubrics := make(map[valueobject.ID]bool)
+	for rows.Next() {
+		var id valueobject.ID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		rubrics[id] = true
+	}
+
+	return rubrics, rows.Err()
+}
+
+func (r postgresGradebookRepository) students(ctx context.Context, sectionID valueobject.ID) ([]domain.GradebookStudent, error) {
+	query := `
+		SELECT u.id, u.first_name, u.last_name
+		FROM enrollments e
+		JOIN users u ON u.id = e.student_id
+		WHERE e.course_section_id = $1 AND COALESCE(e.status, 'enrolled') NOT IN ('dropped', 'withdrawn')
+		ORDER BY u.last_name, u.first_name, u.id
+	`
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var students []domain.GradebookStudent
+	for rows.Next() {
+		var s domain.GradebookStudent
+		if err := rows.Scan(&s.ID, &s.FirstName, &s.LastName); err != nil {
+			return nil, err
+		}
+		students = append(students, s)
+	}
+
+	return students, rows.Err()
+}
+
+func (r postgresGradebookRepository) Apply(ctx context.Context, changes []domain.GradeChange) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// work handed in offline has no submission yet, it gets one without
+	// versions like the missing ones
+	grade := `
+		INSERT INTO submissions (
+			id,
+			assignment_id,
+			student_id,
+			submitted_at,
+			content,
+			attachments,
+			status,
+			points_earned,
+			grade_percentage,
+			version,
+			updated_at
+		) VALUES ($1, $2, $3, NOW(), '', '[]', 'graded', $4, $5, 0, NOW())
+		ON CONFLICT (assignment_id, student_id) DO UPDATE SET
+			status = 'graded',
+			points_earned = EXCLUDED.points_earned,
+			grade_percentage = EXCLUDED.grade_percentage,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id
+	`
+	// a draft released later must not bring back the previous score
+	feedback := `
+		UPDATE submission_feedbacks SET
+			points_earned = $2,
+			grade_percentage = $3,
+			updated_at = NOW()
+		WHERE submission_id = $1
+	`
+	for _, c := range changes {
+		var id string
+		if err := tx.QueryRowContext(ctx, grade,
+			valueobject.NewID().String(),
+			c.AssignmentID.String(),
+			c.StudentID.String(),
+			c.PointsEarned,
+			c.GradePercentage,
+		).Scan(&id); err != nil {
+			if ok, pgerr := db.IsPgError(err); ok {
+				return db.ExchangePGError(pgerr)
+			}
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, feedback, id, c.PointsEarned, c.GradePercentage); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
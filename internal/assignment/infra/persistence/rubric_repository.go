@@ -0,0 +1,89 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresRubricRepository struct {
+	pool *sql.DB
+}
+
+func NewRubricRepository(db *sql.DB) *postgresRubricRepository {
+	return &postgresRubricRepository{db}
+}
+
+func (r postgresRubricRepository) Save(ctx context.Context, rubric *domain.Rubric) error {
+	criteria, err := json.Marshal(rubric.Criteria)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO assignment_rubrics (assignment_id, criteria, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (assignment_id) DO UPDATE SET
+			criteria = EXCLUDED.criteria,
+			updated_at = EXCLUDED.updated_at
+	`
+	if _, err := r.pool.ExecContext(ctx, query, rubric.AssignmentID.String(), criteria, rubric.UpdatedAt); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r postgresRubricRepository) FindByAssignment(ctx context.Context, assignmentID valueobject.ID) (*domain.Rubric, error) {
+	var (
+		rubric   = &domain.Rubric{AssignmentID: assignmentID}
+		criteria []byte
+	)
+
+	query := `SELECT criteria, updated_at FROM assignment_rubrics WHERE assignment_id = $1`
+	if err := r.pool.QueryRowContext(ctx, query, assignmentID.String()).Scan(&criteria, &rubric.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRubricNotFound
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(criteria, &rubric.Criteria); err != nil {
+		return nil, err
+	}
+	return rubric, nil
+}
+
+func (r postgresRubricRepository) Delete(ctx context.Context, assignmentID valueobject.ID) error {
+	result, err := r.pool.ExecContext(ctx, `DELETE FROM assignment_rubrics WHERE assignment_id = $1`, assignmentID.String())
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrRubricNotFound
+	}
+	return nil
+}
+
+func (r postgresRubricRepository) IsInUse(ctx context.Context, assignmentID valueobject.ID) (bool, error) {
+	var used bool
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM submission_feedbacks f
+			JOIN submissions s ON s.id = f.submission_id
+			WHERE s.assignment_id = $1 AND f.rubric_evaluation IS NOT NULL
+		)
+	`
+	err := r.pool.QueryRowContext(ctx, query, assignmentID.String()).Scan(&used)
+	return used, err
+}
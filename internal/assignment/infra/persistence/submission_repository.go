@@ -0,0 +1,357 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const submissionColumns = `
+	s.id,
+	s.assignment_id,
+	s.student_id,
+	COALESCE(s.content, ''),
+	s.attachments,
+	COALESCE(s.status, 'submitted'),
+	COALESCE(s.version, 1),
+	COALESCE(s.submitted_at, NOW()),
+	s.points_earned,
+	s.grade_percentage,
+	COALESCE(s.updated_at, s.submitted_at, NOW())
+`
+
+type postgresSubmissionRepository struct {
+	pool *sql.DB
+}
+
+func NewSubmissionRepository(db *sql.DB) *postgresSubmissionRepository {
+	return &postgresSubmissionRepository{db}
+}
+
+func (r postgresSubmissionRepository) Save(ctx context.Context, s *domain.Submission) error {
+	attachments, err := json.Marshal(s.Attachments)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// the row is only replaced when it still holds the previous version, a
+	// concurrent submission or a grade given meanwhile leaves nothing to update
+	upsert := `
+		INSERT INTO submissions (
+			id,
+			assignment_id,
+			student_id,
+			submitted_at,
+			content,
+			attachments,
+			status,
+			points_earned,
+			grade_percentage,
+			version,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (assignment_id, student_id) DO UPDATE SET
+			submitted_at = EXCLUDED.submitted_at,
+			content = EXCLUDED.content,
+			attachments = EXCLUDED.attachments,
+			status = EXCLUDED.status,
+			points_earned = EXCLUDED.points_earned,
+			grade_percentage = EXCLUDED.grade_percentage,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE COALESCE(submissions.version, 1) = EXCLUDED.version - 1
+		  AND COALESCE(submissions.status, 'submitted') <> 'graded'
+		RETURNING id
+	`
+	var id string
+	if err := tx.QueryRowContext(ctx, upsert,
+		s.ID.String(),
+		s.AssignmentID.String(),
+		s.StudentID.String(),
+		s.SubmittedAt,
+		s.Content,
+		attachments,
+		string(s.Status),
+		s.PointsEarned,
+		s.GradePercentage,
+		s.Version,
+		s.UpdatedAt,
+	).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSubmissionConflict
+		}
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	version := s.CurrentVersion()
+	insert := `
+		INSERT INTO submission_versions (
+			id,
+			submission_id,
+			version,
+			content,
+			attachments,
+			status,
+			submitted_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	if _, err := tx.ExecContext(ctx, insert,
+		version.ID.String(),
+		id,
+		version.Version,
+		version.Content,
+		attachments,
+		string(version.Status),
+		version.SubmittedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsUniqueConstraintViolation(pgerr) {
+				return domain.ErrSubmissionConflict
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresSubmissionRepository) FindByStudent(ctx context.Context, assignmentID, studentID valueobject.ID) (*domain.Submission, error) {
+	query := `SELECT ` + submissionColumns + ` FROM submissions s WHERE s.assignment_id = $1 AND s.student_id = $2`
+
+	submission, err := scanSubmission(r.pool.QueryRowContext(ctx, query, assignmentID.String(), studentID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrSubmissionNotFound
+	}
+	return submission, err
+}
+
+func (r postgresSubmissionRepository) ListByAssignment(ctx context.Context, assignmentID valueobject.ID) ([]*domain.Submission, error) {
+	query := `
+		SELECT ` + submissionColumns + `
+		FROM submissions s
+		JOIN users u ON u.id = s.student_id
+		WHERE s.assignment_id = $1
+		ORDER BY u.last_name, u.first_name
+	`
+	rows, err := r.pool.QueryContext(ctx, query, assignmentID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []*domain.Submission
+	for rows.Next() {
+		submission, err := scanSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, rows.Err()
+}
+
+func (r postgresSubmissionRepository) Versions(ctx context.Context, submissionID valueobject.ID) ([]domain.SubmissionVersion, error) {
+	query := `
+		SELECT id, submission_id, version, COALESCE(content, ''), attachments, status, submitted_at
+		FROM submission_versions
+		WHERE submission_id = $1
+		ORDER BY version
+	`
+	rows, err := r.pool.QueryContext(ctx, query, submissionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []domain.SubmissionVersion
+	for rows.Next() {
+		var (
+			v           domain.SubmissionVersion
+			status      string
+			attachments []byte
+		)
+		if err := rows.Scan(&v.ID, &v.SubmissionID, &v.Version, &v.Content, &attachments, &status, &v.SubmittedAt); err != nil {
+			return nil, err
+		}
+		v.Status = domain.SubmissionStatus(status)
+		v.Attachments = decodeAttachments(attachments)
+		versions = append(versions, v)
+	}
+
+	return versions, rows.Err()
+}
+
+func scanSubmission(row scanner) (*domain.Submission, error) {
+	var (
+		id, assignmentID, studentID valueobject.ID
+		content, status             string
+		attachments                 []byte
+		version                     int
+		submittedAt, updatedAt      time.Time
+		points, percentage          sql.NullFloat64
+	)
+
+	if err := row.Scan(
+		&id,
+		&assignmentID,
+		&studentID,
+		&content,
+		&attachments,
+		&status,
+		&version,
+		&submittedAt,
+		&points,
+		&percentage,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return domain.SubmissionFromPersistence(
+		id,
+		assignmentID,
+		studentID,
+		content,
+		decodeAttachments(attachments),
+		domain.SubmissionStatus(status),
+		version,
+		submittedAt,
+		nullableFloat(points),
+		nullableFloat(percentage),
+		updatedAt,
+	), nil
+}
+
+func nullableFloat(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+func (r postgresSubmissionRepository) Unsubmitted(ctx context.Context, now time.Time) ([]domain.Unsubmitted, error) {
+	// an extension on the assignment overrides the blanket one of the section
+	query := `
+		SELECT
+			a.id,
+			a.title,
+			COALESCE(a.created_at, a.due_date),
+			a.due_date,
+			e.student_id,
+			COALESCE(x.percentage, 0),
+			COALESCE(x.offset_minutes, 0)
+		FROM assignments a
+		JOIN enrollments e ON e.course_section_id = a.course_section_id
+		LEFT JOIN LATERAL (
+			SELECT percentage, offset_minutes
+			FROM assignment_extensions
+			WHERE course_section_id = a.course_section_id
+			  AND student_id = e.student_id
+			  AND (assignment_id IS NULL OR assignment_id = a.id)
+			ORDER BY assignment_id NULLS LAST
+			LIMIT 1
+		) x ON true
+		WHERE a.is_published
+		  AND a.due_date < $1
+		  AND COALESCE(e.status, 'enrolled') = 'enrolled'
+		  AND NOT EXISTS (
+			SELECT 1 FROM submissions s
+			WHERE s.assignment_id = a.id AND s.student_id = e.student_id
+		  )
+		ORDER BY a.due_date, a.id
+	`
+	rows, err := r.pool.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var unsubmitted []domain.Unsubmitted
+	for rows.Next() {
+		var (
+			u                  domain.Unsubmitted
+			createdAt, dueDate time.Time
+			percentage, offset int
+		)
+		if err := rows.Scan(&u.AssignmentID, &u.AssignmentTitle, &createdAt, &dueDate, &u.StudentID, &percentage, &offset); err != nil {
+			return nil, err
+		}
+
+		extension := domain.Extension{Percentage: percentage, Offset: time.Duration(offset) * time.Minute}
+		u.Deadline = domain.Deadline{DueDate: &dueDate, Extension: extension.Length(createdAt, dueDate)}
+		unsubmitted = append(unsubmitted, u)
+	}
+
+	return unsubmitted, rows.Err()
+}
+
+func (r postgresSubmissionRepository) MarkMissing(ctx context.Context, missing []*domain.Submission) ([]*domain.Submission, error) {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// a submission handed in since the candidates were read wins
+	query := `
+		INSERT INTO submissions (
+			id,
+			assignment_id,
+			student_id,
+			submitted_at,
+			content,
+			attachments,
+			status,
+			points_earned,
+			grade_percentage,
+			version,
+			updated_at
+		) VALUES ($1, $2, $3, $4, '', '[]', $5, $6, $7, 0, $8)
+		ON CONFLICT (assignment_id, student_id) DO NOTHING
+	`
+	var created []*domain.Submission
+	for _, s := range missing {
+		result, err := tx.ExecContext(ctx, query,
+			s.ID.String(),
+			s.AssignmentID.String(),
+			s.StudentID.String(),
+			s.SubmittedAt,
+			string(s.Status),
+			s.PointsEarned,
+			s.GradePercentage,
+			s.UpdatedAt,
+		)
+		if err != nil {
+			if ok, pgerr := db.IsPgError(err); ok {
+				return nil, db.ExchangePGError(pgerr)
+			}
+			return nil, err
+		}
+
+		if n, err := result.RowsAffected(); err == nil && n > 0 {
+			created = append(created, s)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
@@ -0,0 +1,86 @@
+package infra
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/assignment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type submissionHandler struct {
+	interactor application.SubmissionInteractor
+}
+
+func NewSubmissionHandler(uc application.SubmissionInteractor) *submissionHandler {
+	return &submissionHandler{uc}
+}
+
+// Submit takes a JSON body with the content, or a multipart form with a
+// content field and the files under the field "files"
+func (h submissionHandler) Submit(c fiber.Ctx) error {
+	var req application.SubmitInput
+
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		form, err := c.MultipartForm()
+		if err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		if content := form.Value["content"]; len(content) > 0 {
+			req.Content = content[0]
+		}
+
+		for _, file := range form.File["files"] {
+			content, err := file.Open()
+			if err != nil {
+				return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+			}
+			defer content.Close()
+
+			req.Files = append(req.Files, application.FileInput{
+				Name:        file.Filename,
+				ContentType: file.Header.Get("Content-Type"),
+				Size:        file.Size,
+				Content:     content,
+			})
+		}
+	} else if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Submit(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h submissionHandler) List(c fiber.Ctx) error {
+	data, err := h.interactor.List(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h submissionHandler) Get(c fiber.Ctx) error {
+	data, err := h.interactor.Get(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h submissionHandler) History(c fiber.Ctx) error {
+	data, err := h.interactor.History(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
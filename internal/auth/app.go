@@ -6,20 +6,32 @@ import (
 	"github.com/Jose-Salazar-27/go-university-server/internal/auth/application"
 	"github.com/Jose-Salazar-27/go-university-server/internal/auth/infra"
 	"github.com/Jose-Salazar-27/go-university-server/internal/auth/infra/persistence"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
 	"github.com/gofiber/fiber/v3"
 )
 
 type Module struct {
-	name   string
-	engine *fiber.App
-	db     *sql.DB
+	name     string
+	engine   *fiber.App
+	db       *sql.DB
+	students application.StudentProfileCreator
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB, students application.StudentProfileCreator) Module {
+	return Module{name: name, engine: engine, db: db, students: students}
 }
 
 func (mod Module) ConfigureEnpoints() {
 	group := mod.engine.Group(mod.name)
 
 	userRepository := persistence.NewUserRepository(mod.db)
-	h := infra.NewUserHandler(application.NewCreateUserInteractor(userRepository, nil))
+	h := infra.NewUserHandler(application.NewCreateUserInteractor(userRepository, nil, mod.students, db.NewTransactor(mod.db)))
 
 	group.Post("", h.CreateUser)
 }
+
+// Accounts builds the account use cases used by other modules, e.g. to
+// turn off the login of students that drop out and back on when readmitted
+func Accounts(db *sql.DB) application.AccountInteractor {
+	return application.NewAccountInteractor(persistence.NewUserRepository(db))
+}
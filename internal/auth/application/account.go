@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/auth/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type AccountInteractor interface {
+	// Deactivate tells whether the login was on and got turned off
+	Deactivate(ctx context.Context, userID string) (bool, error)
+	Reactivate(ctx context.Context, userID string) error
+}
+
+type accountInteractor struct {
+	repository domain.UserRepository
+}
+
+func NewAccountInteractor(r domain.UserRepository) *accountInteractor {
+	return &accountInteractor{r}
+}
+
+// Deactivate disables the login of the given user
+func (interactor accountInteractor) Deactivate(ctx context.Context, userID string) (bool, error) {
+	user, err := interactor.find(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if !user.IsActive {
+		return false, nil
+	}
+
+	user.Deactivate()
+
+	if err := interactor.repository.Update(ctx, user); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Reactivate enables again the login of the given user
+func (interactor accountInteractor) Reactivate(ctx context.Context, userID string) error {
+	user, err := interactor.find(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.IsActive {
+		return nil
+	}
+
+	user.Activate()
+
+	return interactor.repository.Update(ctx, user)
+}
+
+func (interactor accountInteractor) find(ctx context.Context, userID string) (*domain.User, error) {
+	id, err := valueobject.IDFromString(userID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid user id")
+	}
+
+	user, err := interactor.repository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, shared.ErrNotFoundWith(err, err.Error())
+		}
+		return nil, err
+	}
+	return user, nil
+}
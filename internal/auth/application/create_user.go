@@ -17,6 +17,8 @@ type (
 		FirstName string          `json:"first_name" validate:"required"`
 		LastName  string          `json:"last_name" validate:"required"`
 		UserType  domain.UserType `json:"user_type" validate:"required"`
+		// DegreeID is only required for students, their profile is created along with the user
+		DegreeID string `json:"degree_id" validate:"required_if=UserType student,omitempty,uuid"`
 	}
 
 	CreateUserOutput struct {
@@ -30,13 +32,20 @@ type UserInteractor interface {
 	Create(input CreateUserInput) (CreateUserOutput, error)
 }
 
+// StudentProfileCreator creates the academic profile of student users
+type StudentProfileCreator interface {
+	CreateProfile(ctx context.Context, userID, degreeID string) error
+}
+
 type userInteractor struct {
 	repository domain.UserRepository
 	storage    shared.Storage
+	students   StudentProfileCreator
+	tx         shared.Transactor
 }
 
-func NewCreateUserInteractor(r domain.UserRepository, s shared.Storage) *userInteractor {
-	return &userInteractor{r, s}
+func NewCreateUserInteractor(r domain.UserRepository, s shared.Storage, p StudentProfileCreator, tx shared.Transactor) *userInteractor {
+	return &userInteractor{r, s, p, tx}
 }
 
 func (interactor userInteractor) Create(in CreateUserInput) (CreateUserOutput, error) {
@@ -51,13 +60,26 @@ func (interactor userInteractor) Create(in CreateUserInput) (CreateUserOutput, e
 		return CreateUserOutput{}, err
 	}
 
-	avatarUrl := interactor.storage.BuildObjectURL("users", user.ID.String(), "avatar.jpg")
-	user.AvatarURL = &avatarUrl
+	if interactor.storage != nil {
+		avatarUrl := interactor.storage.BuildObjectURL("users", user.ID.String(), "avatar.jpg")
+		user.AvatarURL = &avatarUrl
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := interactor.repository.Create(ctx, user); err != nil {
+	// a student without profile is not left behind when the profile fails
+	err = interactor.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := interactor.repository.Create(ctx, user); err != nil {
+			return err
+		}
+
+		if user.IsStudent() && interactor.students != nil {
+			return interactor.students.CreateProfile(ctx, user.ID.String(), in.DegreeID)
+		}
+		return nil
+	})
+	if err != nil {
 		return CreateUserOutput{}, err
 	}
 
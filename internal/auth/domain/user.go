@@ -17,10 +17,13 @@ var (
 	ErrEmptyLastName     = errors.New("last name cannot be empty")
 	ErrInvalidUserType   = errors.New("invalid user type")
 	ErrEmptyPasswordHash = errors.New("password hash cannot be empty")
+	ErrUserNotFound      = errors.New("user does not exist")
 )
 
 type UserRepository interface {
 	Create(ctx context.Context, u *User) (err error)
+	FindByID(ctx context.Context, id valueobject.ID) (*User, error)
+	Update(ctx context.Context, u *User) (err error)
 }
 
 // User represents a user entity in the domain
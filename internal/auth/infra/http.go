@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/Jose-Salazar-27/go-university-server/internal/auth/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
 	"github.com/gofiber/fiber/v3"
 )
 
@@ -18,14 +19,14 @@ func NewUserHandler(uc application.UserInteractor) *userHandler {
 func (h userHandler) CreateUser(c fiber.Ctx) error {
 	var req application.CreateUserInput
 
-	if err := c.Bind().Body(req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
 	data, err := h.interactor.Create(req)
 	if err != nil {
-		return c.Status(http.StatusOK).JSON(data)
+		return httpx.ErrorResponse(c, err)
 	}
 
-	return nil
+	return c.Status(http.StatusCreated).JSON(data)
 }
@@ -3,9 +3,11 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/Jose-Salazar-27/go-university-server/internal/auth/domain"
 	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
 )
 
 type postgresUserRepository struct {
@@ -26,9 +28,23 @@ func (r postgresUserRepository) Create(ctx context.Context, u *domain.User) erro
 		last_name,
 		user_type,
 		avatar_url,
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		is_active,
+		created_at,
+		updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
-	if _, err := r.pool.ExecContext(ctx, query); err != nil {
+	if _, err := db.Conn(ctx, r.pool).ExecContext(ctx, query,
+		u.ID.String(),
+		u.Email,
+		u.PasswordHash,
+		u.FirstName,
+		u.LastName,
+		u.UserType.String(),
+		u.AvatarURL,
+		u.IsActive,
+		u.CreatedAt,
+		u.UpdatedAt,
+	); err != nil {
 		if ok, pgerr := db.IsPgError(err); ok {
 			return db.ExchangePGError(pgerr)
 		}
@@ -36,3 +52,96 @@ func (r postgresUserRepository) Create(ctx context.Context, u *domain.User) erro
 	}
 	return nil
 }
+
+func (r postgresUserRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.User, error) {
+	query := `
+		SELECT
+		email,
+		password_hash,
+		first_name,
+		last_name,
+		user_type,
+		avatar_url,
+		COALESCE(is_active, true),
+		created_at,
+		updated_at
+		FROM users
+		WHERE id = $1
+	`
+	var (
+		user      domain.User
+		userType  string
+		avatarURL sql.NullString
+	)
+
+	err := db.Conn(ctx, r.pool).QueryRowContext(ctx, query, id.String()).Scan(
+		&user.Email,
+		&user.PasswordHash,
+		&user.FirstName,
+		&user.LastName,
+		&userType,
+		&avatarURL,
+		&user.IsActive,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var avatar *string
+	if avatarURL.Valid {
+		avatar = &avatarURL.String
+	}
+
+	return domain.UserFromPersistence(
+		id,
+		user.Email,
+		user.PasswordHash,
+		user.FirstName,
+		user.LastName,
+		domain.UserType(userType),
+		avatar,
+		user.IsActive,
+		user.CreatedAt,
+		user.UpdatedAt,
+	), nil
+}
+
+func (r postgresUserRepository) Update(ctx context.Context, u *domain.User) error {
+	query := `
+		UPDATE users SET
+		email = $2,
+		password_hash = $3,
+		first_name = $4,
+		last_name = $5,
+		avatar_url = $6,
+		is_active = $7,
+		updated_at = $8
+		WHERE id = $1
+	`
+	result, err := db.Conn(ctx, r.pool).ExecContext(ctx, query,
+		u.ID.String(),
+		u.Email,
+		u.PasswordHash,
+		u.FirstName,
+		u.LastName,
+		u.AvatarURL,
+		u.IsActive,
+		u.UpdatedAt,
+	)
+	if err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
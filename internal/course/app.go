@@ -0,0 +1,63 @@
+package course
+
+import (
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/infra/persistence"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Module struct {
+	name   string
+	engine *fiber.App
+	db     *sql.DB
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB) Module {
+	return Module{name: name, engine: engine, db: db}
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.engine.Group(mod.name, httpx.Authenticate())
+
+	courses := persistence.NewCourseRepository(mod.db)
+	ch := infra.NewCourseHandler(application.NewCourseInteractor(courses))
+	ph := infra.NewPrerequisiteHandler(application.NewPrerequisiteInteractor(
+		courses,
+		persistence.NewPrerequisiteRepository(mod.db),
+	))
+	oh := infra.NewPrerequisiteOverrideHandler(application.NewPrerequisiteOverrideInteractor(
+		courses,
+		persistence.NewPrerequisiteOverrideRepository(mod.db),
+	))
+
+	admin := httpx.RequireRoles(shared.RoleAdmin)
+	// professors are let through so the interactor can check the department head
+	granters := httpx.RequireRoles(shared.RoleAdmin, shared.RoleProfessor)
+
+	group.Get("", ch.ListCourses)
+	group.Post("", admin, ch.CreateCourse)
+	group.Get("/:id", ch.GetCourse)
+	group.Put("/:id", admin, ch.UpdateCourse)
+
+	group.Get("/:id/prerequisites", ph.ListPrerequisites)
+	group.Get("/:id/prerequisites/tree", ph.GetTree)
+	group.Post("/:id/prerequisites", admin, ph.AddPrerequisite)
+	group.Delete("/:id/prerequisites/:prerequisiteId", admin, ph.RemovePrerequisite)
+
+	group.Get("/:id/prerequisite-overrides", granters, oh.ListOverrides)
+	group.Post("/:id/prerequisite-overrides", granters, oh.GrantOverride)
+	group.Delete("/:id/prerequisite-overrides/:studentId", granters, oh.RevokeOverride)
+}
+
+// LegacyMigrator exposes the migration of free text prerequisites for command line tools
+func LegacyMigrator(db *sql.DB) application.LegacyPrerequisiteMigrator {
+	return application.NewLegacyPrerequisiteMigrator(
+		persistence.NewLegacyPrerequisiteRepository(db),
+		persistence.NewPrerequisiteRepository(db),
+	)
+}
@@ -0,0 +1,178 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	CreateCourseInput struct {
+		DepartmentID     string `json:"department_id" validate:"required,uuid"`
+		Code             string `json:"code" validate:"required,max=20"`
+		Name             string `json:"name" validate:"required,max=200"`
+		Description      string `json:"description"`
+		Credits          int    `json:"credits" validate:"required,min=1"`
+		TheoreticalHours int    `json:"theoretical_hours" validate:"min=0"`
+		PracticalHours   int    `json:"practical_hours" validate:"min=0"`
+		Level            int    `json:"level" validate:"min=0"`
+	}
+
+	UpdateCourseInput struct {
+		Code             string `json:"code" validate:"required,max=20"`
+		Name             string `json:"name" validate:"required,max=200"`
+		Description      string `json:"description"`
+		Credits          int    `json:"credits" validate:"required,min=1"`
+		TheoreticalHours int    `json:"theoretical_hours" validate:"min=0"`
+		PracticalHours   int    `json:"practical_hours" validate:"min=0"`
+		Level            int    `json:"level" validate:"min=0"`
+		IsActive         *bool  `json:"is_active"`
+	}
+
+	ListCoursesInput struct {
+		DepartmentID string `query:"department_id" validate:"omitempty,uuid"`
+		Search       string `query:"q" validate:"max=100"`
+		OnlyActive   bool   `query:"active"`
+		Limit        int    `query:"limit" validate:"omitempty,min=1,max=200"`
+		Offset       int    `query:"offset" validate:"omitempty,min=0"`
+	}
+)
+
+type CourseInteractor interface {
+	Create(ctx context.Context, in CreateCourseInput) (*domain.Course, error)
+	Update(ctx context.Context, id string, in UpdateCourseInput) (*domain.Course, error)
+	Get(ctx context.Context, id string) (*domain.Course, error)
+	List(ctx context.Context, in ListCoursesInput) ([]*domain.Course, error)
+}
+
+type courseInteractor struct {
+	repository domain.CourseRepository
+}
+
+func NewCourseInteractor(r domain.CourseRepository) *courseInteractor {
+	return &courseInteractor{r}
+}
+
+func (interactor courseInteractor) Create(ctx context.Context, in CreateCourseInput) (*domain.Course, error) {
+	departmentID, err := valueobject.IDFromString(in.DepartmentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid department id")
+	}
+
+	course, err := domain.NewCourse(
+		departmentID,
+		in.Code,
+		in.Name,
+		in.Description,
+		in.Credits,
+		in.TheoreticalHours,
+		in.PracticalHours,
+		in.Level,
+	)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Create(ctx, course); err != nil {
+		return nil, mapError(err)
+	}
+
+	return course, nil
+}
+
+func (interactor courseInteractor) Update(ctx context.Context, id string, in UpdateCourseInput) (*domain.Course, error) {
+	course, err := interactor.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := course.UpdateDetails(
+		in.Code,
+		in.Name,
+		in.Description,
+		in.Credits,
+		in.TheoreticalHours,
+		in.PracticalHours,
+		in.Level,
+	); err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if in.IsActive != nil {
+		if *in.IsActive {
+			course.Activate()
+		} else {
+			course.Deactivate()
+		}
+	}
+
+	if err := interactor.repository.Update(ctx, course); err != nil {
+		return nil, mapError(err)
+	}
+
+	return course, nil
+}
+
+func (interactor courseInteractor) Get(ctx context.Context, id string) (*domain.Course, error) {
+	courseID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid course id")
+	}
+
+	course, err := interactor.repository.FindByID(ctx, courseID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return course, nil
+}
+
+func (interactor courseInteractor) List(ctx context.Context, in ListCoursesInput) ([]*domain.Course, error) {
+	filter := domain.CourseFilter{
+		Search:     in.Search,
+		OnlyActive: in.OnlyActive,
+		Limit:      in.Limit,
+		Offset:     in.Offset,
+	}
+
+	if in.DepartmentID != "" {
+		departmentID, err := valueobject.IDFromString(in.DepartmentID)
+		if err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid department id")
+		}
+		filter.DepartmentID = departmentID
+	}
+
+	courses, err := interactor.repository.List(ctx, filter)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if courses == nil {
+		courses = []*domain.Course{}
+	}
+
+	return courses, nil
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var appErr *shared.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, domain.ErrCourseNotFound), errors.Is(err, domain.ErrPrerequisiteNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrPrerequisiteCycle):
+		return shared.ErrConflictWith(err, err.Error())
+	case errors.Is(err, shared.ErrConflict):
+		return shared.ErrConflictWith(err, "course or prerequisite already exists")
+	case errors.Is(err, shared.ErrNotFound):
+		return shared.ErrInvalidInputWith(err, "referenced department or course does not exist")
+	default:
+		return shared.ErrInternalWith(err, "cannot process course")
+	}
+}
@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	ReasonNotACode        = "not a course code"
+	ReasonUnknownCode     = "code not found in department"
+	ReasonNoDepartment    = "course has no department"
+	ReasonSelfReference   = "course references itself"
+	ReasonCycle           = "would create a prerequisite cycle"
+	ReasonAlreadyMigrated = "prerequisite already exists"
+)
+
+type (
+	// UnresolvedToken is a piece of legacy text that couldn't be migrated
+	UnresolvedToken struct {
+		Course domain.CourseRef
+		Token  string
+		Reason string
+	}
+
+	LegacyMigrationReport struct {
+		DryRun     bool
+		Courses    int
+		Migrated   []domain.Prerequisite
+		Skipped    []UnresolvedToken
+		Unresolved []UnresolvedToken
+	}
+)
+
+type LegacyPrerequisiteMigrator interface {
+	// Migrate converts the free text prerequisites into relational ones. With
+	// dryRun nothing is written but the report is built the same way.
+	Migrate(ctx context.Context, dryRun bool) (LegacyMigrationReport, error)
+}
+
+type legacyPrerequisiteMigrator struct {
+	legacy        domain.LegacyPrerequisiteRepository
+	prerequisites domain.PrerequisiteRepository
+}
+
+func NewLegacyPrerequisiteMigrator(l domain.LegacyPrerequisiteRepository, p domain.PrerequisiteRepository) *legacyPrerequisiteMigrator {
+	return &legacyPrerequisiteMigrator{l, p}
+}
+
+func (m legacyPrerequisiteMigrator) Migrate(ctx context.Context, dryRun bool) (LegacyMigrationReport, error) {
+	report := LegacyMigrationReport{DryRun: dryRun}
+
+	courses, err := m.legacy.ListLegacy(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.Courses = len(courses)
+
+	departments := make(map[valueobject.ID]map[string]domain.CourseRef)
+
+	for _, course := range courses {
+		tokens := domain.ParseLegacyPrerequisites(course.Text)
+
+		if course.DepartmentID.IsEmpty() {
+			for _, token := range tokens {
+				report.unresolved(course.Course, token.Raw, ReasonNoDepartment)
+			}
+			continue
+		}
+
+		catalog, ok := departments[course.DepartmentID]
+		if !ok {
+			catalog, err = m.catalog(ctx, course.DepartmentID)
+			if err != nil {
+				return report, err
+			}
+			departments[course.DepartmentID] = catalog
+		}
+
+		for _, token := range tokens {
+			if token.Code == "" {
+				report.unresolved(course.Course, token.Raw, ReasonNotACode)
+				continue
+			}
+
+			required, ok := catalog[token.Code]
+			if !ok {
+				report.unresolved(course.Course, token.Raw, ReasonUnknownCode)
+				continue
+			}
+
+			prerequisite, err := domain.NewPrerequisite(course.Course, required, !token.Alternative, nil)
+			if err != nil {
+				report.unresolved(course.Course, token.Raw, ReasonSelfReference)
+				continue
+			}
+
+			if err := m.add(ctx, prerequisite, dryRun); err != nil {
+				switch {
+				case errors.Is(err, domain.ErrPrerequisiteCycle):
+					report.unresolved(course.Course, token.Raw, ReasonCycle)
+				case errors.Is(err, shared.ErrConflict):
+					report.Skipped = append(report.Skipped, UnresolvedToken{course.Course, token.Raw, ReasonAlreadyMigrated})
+				default:
+					return report, err
+				}
+				continue
+			}
+
+			report.Migrated = append(report.Migrated, prerequisite)
+		}
+	}
+
+	return report, nil
+}
+
+func (m legacyPrerequisiteMigrator) add(ctx context.Context, p domain.Prerequisite, dryRun bool) error {
+	if !dryRun {
+		return m.prerequisites.Add(ctx, p)
+	}
+
+	// a dry run only sees the edges already stored, cycles formed exclusively
+	// by edges of this same run are detected when migrating for real
+	graph, err := m.prerequisites.Subgraph(ctx, p.Prerequisite.ID)
+	if err != nil {
+		return err
+	}
+	if err := graph.CheckAddition(p); err != nil {
+		return err
+	}
+
+	direct, err := m.prerequisites.ListDirect(ctx, p.Course.ID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range direct {
+		if existing.Prerequisite.ID.Equals(p.Prerequisite.ID) {
+			return shared.ErrConflict
+		}
+	}
+
+	return nil
+}
+
+func (m legacyPrerequisiteMigrator) catalog(ctx context.Context, departmentID valueobject.ID) (map[string]domain.CourseRef, error) {
+	courses, err := m.legacy.ListDepartmentCourses(ctx, departmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := make(map[string]domain.CourseRef, len(courses))
+	for _, course := range courses {
+		catalog[domain.NormalizeCourseCode(course.Code)] = course
+	}
+	return catalog, nil
+}
+
+func (r *LegacyMigrationReport) unresolved(course domain.CourseRef, token, reason string) {
+	r.Unresolved = append(r.Unresolved, UnresolvedToken{Course: course, Token: token, Reason: reason})
+}
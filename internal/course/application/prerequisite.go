@@ -0,0 +1,170 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	AddPrerequisiteInput struct {
+		PrerequisiteID string   `json:"prerequisite_id" validate:"required,uuid"`
+		IsMandatory    *bool    `json:"is_mandatory"`
+		MinimumGrade   *float64 `json:"minimum_grade" validate:"omitempty,min=0,max=100"`
+		IsCorequisite  bool     `json:"is_corequisite"`
+	}
+)
+
+type PrerequisiteInteractor interface {
+	Add(ctx context.Context, courseID string, in AddPrerequisiteInput) (domain.Prerequisite, error)
+	Remove(ctx context.Context, courseID, prerequisiteID string) error
+	ListDirect(ctx context.Context, courseID string) ([]domain.Prerequisite, error)
+	Tree(ctx context.Context, courseID string) (domain.PrerequisiteNode, error)
+	DOT(ctx context.Context, courseID string) (string, error)
+}
+
+type prerequisiteInteractor struct {
+	courses       domain.CourseRepository
+	prerequisites domain.PrerequisiteRepository
+}
+
+func NewPrerequisiteInteractor(c domain.CourseRepository, p domain.PrerequisiteRepository) *prerequisiteInteractor {
+	return &prerequisiteInteractor{c, p}
+}
+
+func (interactor prerequisiteInteractor) Add(ctx context.Context, courseID string, in AddPrerequisiteInput) (domain.Prerequisite, error) {
+	course, err := interactor.findCourse(ctx, courseID)
+	if err != nil {
+		return domain.Prerequisite{}, err
+	}
+
+	required, err := interactor.findCourse(ctx, in.PrerequisiteID)
+	if err != nil {
+		return domain.Prerequisite{}, err
+	}
+
+	if !required.IsActive {
+		return domain.Prerequisite{}, shared.ErrInvalidInputWith(domain.ErrPrerequisiteNotActive, domain.ErrPrerequisiteNotActive.Error())
+	}
+
+	mandatory := true
+	if in.IsMandatory != nil {
+		mandatory = *in.IsMandatory
+	}
+
+	prerequisite, err := domain.NewPrerequisite(ref(course), ref(required), mandatory, in.MinimumGrade)
+	if err != nil {
+		return domain.Prerequisite{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+	prerequisite.IsCorequisite = in.IsCorequisite
+
+	// every course reachable from the new prerequisite is loaded, if the
+	// dependent course is among them the new edge would close a cycle
+	graph, err := interactor.prerequisites.Subgraph(ctx, required.ID)
+	if err != nil {
+		return domain.Prerequisite{}, mapError(err)
+	}
+
+	if err := graph.CheckAddition(prerequisite); err != nil {
+		if errors.Is(err, domain.ErrPrerequisiteCycle) {
+			return domain.Prerequisite{}, shared.ErrConflictWith(err, err.Error())
+		}
+		return domain.Prerequisite{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.prerequisites.Add(ctx, prerequisite); err != nil {
+		if errors.Is(err, shared.ErrConflict) {
+			return domain.Prerequisite{}, shared.ErrConflictWith(err, domain.ErrPrerequisiteExists.Error())
+		}
+		return domain.Prerequisite{}, mapError(err)
+	}
+
+	return prerequisite, nil
+}
+
+func (interactor prerequisiteInteractor) Remove(ctx context.Context, courseID, prerequisiteID string) error {
+	course, err := valueobject.IDFromString(courseID)
+	if err != nil {
+		return shared.ErrInvalidInputWith(err, "invalid course id")
+	}
+
+	prerequisite, err := valueobject.IDFromString(prerequisiteID)
+	if err != nil {
+		return shared.ErrInvalidInputWith(err, "invalid prerequisite id")
+	}
+
+	if err := interactor.prerequisites.Remove(ctx, course, prerequisite); err != nil {
+		return mapError(err)
+	}
+
+	return nil
+}
+
+func (interactor prerequisiteInteractor) ListDirect(ctx context.Context, courseID string) ([]domain.Prerequisite, error) {
+	course, err := interactor.findCourse(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	prerequisites, err := interactor.prerequisites.ListDirect(ctx, course.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if prerequisites == nil {
+		prerequisites = []domain.Prerequisite{}
+	}
+
+	return prerequisites, nil
+}
+
+func (interactor prerequisiteInteractor) Tree(ctx context.Context, courseID string) (domain.PrerequisiteNode, error) {
+	course, graph, err := interactor.subgraph(ctx, courseID)
+	if err != nil {
+		return domain.PrerequisiteNode{}, err
+	}
+	return graph.Tree(ref(course)), nil
+}
+
+func (interactor prerequisiteInteractor) DOT(ctx context.Context, courseID string) (string, error) {
+	course, graph, err := interactor.subgraph(ctx, courseID)
+	if err != nil {
+		return "", err
+	}
+	return graph.DOT(ref(course)), nil
+}
+
+func (interactor prerequisiteInteractor) subgraph(ctx context.Context, courseID string) (*domain.Course, *domain.PrerequisiteGraph, error) {
+	course, err := interactor.findCourse(ctx, courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	graph, err := interactor.prerequisites.Subgraph(ctx, course.ID)
+	if err != nil {
+		return nil, nil, mapError(err)
+	}
+
+	return course, graph, nil
+}
+
+func (interactor prerequisiteInteractor) findCourse(ctx context.Context, id string) (*domain.Course, error) {
+	courseID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid course id")
+	}
+
+	course, err := interactor.courses.FindByID(ctx, courseID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return course, nil
+}
+
+func ref(c *domain.Course) domain.CourseRef {
+	return domain.CourseRef{ID: c.ID, Code: c.Code, Name: c.Name}
+}
@@ -0,0 +1,136 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	GrantOverrideInput struct {
+		StudentID string `json:"student_id" validate:"required,uuid"`
+		Reason    string `json:"reason" validate:"required,max=1000"`
+	}
+)
+
+type PrerequisiteOverrideInteractor interface {
+	Grant(ctx context.Context, actor shared.Actor, courseID string, in GrantOverrideInput) (*domain.PrerequisiteOverride, error)
+	Revoke(ctx context.Context, actor shared.Actor, courseID, studentID string) error
+	List(ctx context.Context, actor shared.Actor, courseID string) ([]*domain.PrerequisiteOverride, error)
+}
+
+type prerequisiteOverrideInteractor struct {
+	courses   domain.CourseRepository
+	overrides domain.PrerequisiteOverrideRepository
+	now       func() time.Time
+}
+
+func NewPrerequisiteOverrideInteractor(c domain.CourseRepository, o domain.PrerequisiteOverrideRepository) *prerequisiteOverrideInteractor {
+	return &prerequisiteOverrideInteractor{c, o, time.Now}
+}
+
+func (interactor prerequisiteOverrideInteractor) Grant(ctx context.Context, actor shared.Actor, courseID string, in GrantOverrideInput) (*domain.PrerequisiteOverride, error) {
+	course, err := interactor.authorize(ctx, actor, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	student, err := valueobject.IDFromString(in.StudentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	override, err := domain.NewPrerequisiteOverride(course.ID, student, actor.ID, in.Reason, interactor.now())
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.overrides.Grant(ctx, override); err != nil {
+		return nil, overrideError(err)
+	}
+
+	return override, nil
+}
+
+func (interactor prerequisiteOverrideInteractor) Revoke(ctx context.Context, actor shared.Actor, courseID, studentID string) error {
+	course, err := interactor.authorize(ctx, actor, courseID)
+	if err != nil {
+		return err
+	}
+
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	if err := interactor.overrides.Revoke(ctx, course.ID, student); err != nil {
+		return overrideError(err)
+	}
+
+	return nil
+}
+
+func (interactor prerequisiteOverrideInteractor) List(ctx context.Context, actor shared.Actor, courseID string) ([]*domain.PrerequisiteOverride, error) {
+	course, err := interactor.authorize(ctx, actor, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := interactor.overrides.ListByCourse(ctx, course.ID)
+	if err != nil {
+		return nil, overrideError(err)
+	}
+
+	if overrides == nil {
+		overrides = []*domain.PrerequisiteOverride{}
+	}
+
+	return overrides, nil
+}
+
+// authorize loads the course and checks that the actor is an admin or the
+// head of the department offering it
+func (interactor prerequisiteOverrideInteractor) authorize(ctx context.Context, actor shared.Actor, courseID string) (*domain.Course, error) {
+	id, err := valueobject.IDFromString(courseID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid course id")
+	}
+
+	course, err := interactor.courses.FindByID(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if actor.IsAdmin() {
+		return course, nil
+	}
+
+	if actor.IsProfessor() {
+		head, err := interactor.overrides.IsDepartmentHead(ctx, course.ID, actor.ID)
+		if err != nil {
+			return nil, overrideError(err)
+		}
+		if head {
+			return course, nil
+		}
+	}
+
+	return nil, shared.ErrForbiddenWith(shared.ErrForbidden, "only admins and the department head can see and manage prerequisite overrides")
+}
+
+func overrideError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOverrideNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, shared.ErrConflict):
+		return shared.ErrConflictWith(err, domain.ErrOverrideExists.Error())
+	case errors.Is(err, shared.ErrNotFound):
+		return shared.ErrInvalidInputWith(err, "referenced student does not exist")
+	default:
+		return mapError(err)
+	}
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrCourseNotFound   = errors.New("course does not exist")
+	ErrEmptyCourseCode  = errors.New("course code cannot be empty")
+	ErrEmptyCourseName  = errors.New("course name cannot be empty")
+	ErrInvalidCredits   = errors.New("credits must be greater than zero")
+	ErrInvalidHours     = errors.New("hours cannot be negative")
+	ErrDepartmentNeeded = errors.New("department cannot be empty")
+)
+
+type CourseRepository interface {
+	Create(ctx context.Context, c *Course) (err error)
+	Update(ctx context.Context, c *Course) (err error)
+	FindByID(ctx context.Context, id valueobject.ID) (*Course, error)
+	List(ctx context.Context, filter CourseFilter) ([]*Course, error)
+}
+
+// CourseFilter narrows the list of courses. Zero values are ignored.
+type CourseFilter struct {
+	DepartmentID valueobject.ID
+	Search       string
+	OnlyActive   bool
+	Limit        int
+	Offset       int
+}
+
+// Course is a subject of the catalog offered by a department
+type Course struct {
+	ID               valueobject.ID `json:"id"`
+	DepartmentID     valueobject.ID `json:"department_id"`
+	Code             string         `json:"code"`
+	Name             string         `json:"name"`
+	Description      string         `json:"description,omitempty"`
+	Credits          int            `json:"credits"`
+	TheoreticalHours int            `json:"theoretical_hours"`
+	PracticalHours   int            `json:"practical_hours"`
+	Level            int            `json:"level,omitempty"`
+	IsActive         bool           `json:"is_active"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+}
+
+// NewCourse creates a new active Course with validation
+func NewCourse(
+	departmentID valueobject.ID,
+	code string,
+	name string,
+	description string,
+	credits int,
+	theoreticalHours int,
+	practicalHours int,
+	level int,
+) (*Course, error) {
+	if departmentID.IsEmpty() {
+		return nil, ErrDepartmentNeeded
+	}
+
+	now := time.Now()
+
+	course := &Course{
+		ID:           valueobject.NewID(),
+		DepartmentID: departmentID,
+		IsActive:     true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := course.UpdateDetails(code, name, description, credits, theoreticalHours, practicalHours, level); err != nil {
+		return nil, err
+	}
+
+	return course, nil
+}
+
+// CourseFromPersistence creates a Course instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func CourseFromPersistence(
+	id valueobject.ID,
+	departmentID valueobject.ID,
+	code string,
+	name string,
+	description string,
+	credits int,
+	theoreticalHours int,
+	practicalHours int,
+	level int,
+	isActive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Course {
+	return &Course{
+		ID:               id,
+		DepartmentID:     departmentID,
+		Code:             code,
+		Name:             name,
+		Description:      description,
+		Credits:          credits,
+		TheoreticalHours: theoreticalHours,
+		PracticalHours:   practicalHours,
+		Level:            level,
+		IsActive:         isActive,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+	}
+}
+
+// Business Logic Methods
+
+// UpdateDetails changes the descriptive data of the course
+func (c *Course) UpdateDetails(code, name, description string, credits, theoreticalHours, practicalHours, level int) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return ErrEmptyCourseCode
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return ErrEmptyCourseName
+	}
+
+	if credits <= 0 {
+		return ErrInvalidCredits
+	}
+
+	if theoreticalHours < 0 || practicalHours < 0 {
+		return ErrInvalidHours
+	}
+
+	c.Code = code
+	c.Name = strings.TrimSpace(name)
+	c.Description = strings.TrimSpace(description)
+	c.Credits = credits
+	c.TheoreticalHours = theoreticalHours
+	c.PracticalHours = practicalHours
+	c.Level = level
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Deactivate removes the course from the active catalog
+func (c *Course) Deactivate() {
+	c.IsActive = false
+	c.UpdatedAt = time.Now()
+}
+
+// Activate puts the course back in the active catalog
+func (c *Course) Activate() {
+	c.IsActive = true
+	c.UpdatedAt = time.Now()
+}
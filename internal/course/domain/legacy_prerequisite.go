@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	legacySeparator = regexp.MustCompile(`(?i)\s*(,|;|&|\band\b|\bor\b)\s*`)
+	legacyCode      = regexp.MustCompile(`^[A-Za-z]{2,6}[\s-]?\d{2,4}[A-Za-z]?$`)
+	// legacyEmpty lists the placeholders imports used for "no prerequisites"
+	legacyEmpty = map[string]bool{"none": true, "n/a": true, "na": true, "-": true}
+)
+
+type LegacyPrerequisiteRepository interface {
+	// ListLegacy returns the courses whose free text prerequisites column is not empty
+	ListLegacy(ctx context.Context) ([]LegacyCourse, error)
+	ListDepartmentCourses(ctx context.Context, departmentID valueobject.ID) ([]CourseRef, error)
+}
+
+// LegacyCourse is a course with the prerequisites text loaded by old imports
+type LegacyCourse struct {
+	Course       CourseRef
+	DepartmentID valueobject.ID
+	Text         string
+}
+
+// LegacyToken is a piece of the free text prerequisites column
+type LegacyToken struct {
+	Raw string
+	// Code is the normalized course code, empty when Raw doesn't look like one
+	Code string
+	// Alternative is set for codes joined with "or", any of them is enough
+	// so they are migrated as optional prerequisites
+	Alternative bool
+}
+
+// ParseLegacyPrerequisites splits the free text prerequisites of a course
+// into tokens. Codes may be separated by commas, semicolons, "and" or "or".
+func ParseLegacyPrerequisites(text string) []LegacyToken {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+
+	bounds := legacySeparator.FindAllStringSubmatchIndex(text, -1)
+
+	var (
+		tokens []LegacyToken
+		start  int
+	)
+	for i := 0; i <= len(bounds); i++ {
+		end := len(text)
+		if i < len(bounds) {
+			end = bounds[i][0]
+		}
+
+		raw := strings.Trim(text[start:end], " \t\r\n.()[]")
+		if raw != "" && !legacyEmpty[strings.ToLower(raw)] {
+			token := LegacyToken{
+				Raw:         raw,
+				Alternative: isOr(text, bounds, i-1) || isOr(text, bounds, i),
+			}
+			if legacyCode.MatchString(raw) {
+				token.Code = NormalizeCourseCode(raw)
+			}
+			tokens = append(tokens, token)
+		}
+
+		if i < len(bounds) {
+			start = bounds[i][1]
+		}
+	}
+
+	return tokens
+}
+
+func isOr(text string, bounds [][]int, i int) bool {
+	if i < 0 || i >= len(bounds) {
+		return false
+	}
+	return strings.EqualFold(text[bounds[i][2]:bounds[i][3]], "or")
+}
+
+// NormalizeCourseCode makes codes comparable regardless of case, spaces and dashes
+func NormalizeCourseCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '\t' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+)
+
+func TestParseLegacyPrerequisites(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []domain.LegacyToken
+	}{
+		{name: "empty", text: "  "},
+		{name: "placeholder", text: "N/A"},
+		{
+			name: "single code",
+			text: "MAT101",
+			want: []domain.LegacyToken{{Raw: "MAT101", Code: "MAT101"}},
+		},
+		{
+			name: "commas and semicolons",
+			text: "MAT101, phy-101; CS 110",
+			want: []domain.LegacyToken{
+				{Raw: "MAT101", Code: "MAT101"},
+				{Raw: "phy-101", Code: "PHY101"},
+				{Raw: "CS 110", Code: "CS110"},
+			},
+		},
+		{
+			name: "and is required",
+			text: "MAT101 and MAT102 & MAT103",
+			want: []domain.LegacyToken{
+				{Raw: "MAT101", Code: "MAT101"},
+				{Raw: "MAT102", Code: "MAT102"},
+				{Raw: "MAT103", Code: "MAT103"},
+			},
+		},
+		{
+			name: "or marks both sides as alternatives",
+			text: "MAT101 and (PHY101 OR PHY105)",
+			want: []domain.LegacyToken{
+				{Raw: "MAT101", Code: "MAT101"},
+				{Raw: "PHY101", Code: "PHY101", Alternative: true},
+				{Raw: "PHY105", Code: "PHY105", Alternative: true},
+			},
+		},
+		{
+			name: "codes with a suffix letter",
+			text: "BIO201L.",
+			want: []domain.LegacyToken{{Raw: "BIO201L", Code: "BIO201L"}},
+		},
+		{
+			name: "free text is kept without a code",
+			text: "MAT101, consent of the instructor",
+			want: []domain.LegacyToken{
+				{Raw: "MAT101", Code: "MAT101"},
+				{Raw: "consent of the instructor"},
+			},
+		},
+		{
+			name: "separators inside words are not split",
+			text: "Organic chemistry, Handbook",
+			want: []domain.LegacyToken{
+				{Raw: "Organic chemistry"},
+				{Raw: "Handbook"},
+			},
+		},
+		{
+			name: "placeholders between codes are skipped",
+			text: "MAT101; none",
+			want: []domain.LegacyToken{{Raw: "MAT101", Code: "MAT101"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.ParseLegacyPrerequisites(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeCourseCode(t *testing.T) {
+	tests := map[string]string{
+		"MAT101":   "MAT101",
+		"mat101":   "MAT101",
+		"mat 101":  "MAT101",
+		"Mat-101":  "MAT101",
+		"cs\t110a": "CS110A",
+	}
+
+	for code, want := range tests {
+		if got := domain.NormalizeCourseCode(code); got != want {
+			t.Errorf("NormalizeCourseCode(%q): got %q, want %q", code, got, want)
+		}
+	}
+}
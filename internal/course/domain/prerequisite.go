@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrSelfPrerequisite      = errors.New("a course cannot be its own prerequisite")
+	ErrPrerequisiteCycle     = errors.New("prerequisite would create a cycle")
+	ErrPrerequisiteNotFound  = errors.New("prerequisite does not exist")
+	ErrPrerequisiteExists    = errors.New("prerequisite already exists")
+	ErrInvalidMinimumGrade   = errors.New("minimum grade must be between 0 and 100")
+	ErrPrerequisiteNotActive = errors.New("inactive courses cannot be prerequisites")
+)
+
+type PrerequisiteRepository interface {
+	// Add stores the prerequisite, implementations must reject it with
+	// ErrPrerequisiteCycle when a concurrent change made it cyclic
+	Add(ctx context.Context, p Prerequisite) (err error)
+	Remove(ctx context.Context, courseID, prerequisiteID valueobject.ID) (err error)
+	ListDirect(ctx context.Context, courseID valueobject.ID) ([]Prerequisite, error)
+	// Subgraph returns every prerequisite reachable from the given course
+	Subgraph(ctx context.Context, courseID valueobject.ID) (*PrerequisiteGraph, error)
+}
+
+// CourseRef identifies a course inside the prerequisite graph
+type CourseRef struct {
+	ID   valueobject.ID `json:"id"`
+	Code string         `json:"code"`
+	Name string         `json:"name"`
+}
+
+// Prerequisite states that Course requires PrerequisiteCourse to be passed first
+type Prerequisite struct {
+	Course       CourseRef `json:"course"`
+	Prerequisite CourseRef `json:"prerequisite"`
+	IsMandatory  bool      `json:"is_mandatory"`
+	// MinimumGrade is the lowest final grade (0-100) accepted, nil means passing is enough
+	MinimumGrade *float64 `json:"minimum_grade,omitempty"`
+	// IsCorequisite allows taking the required course in the same period
+	// instead of passing it first
+	IsCorequisite bool `json:"is_corequisite"`
+}
+
+// NewPrerequisite creates a Prerequisite with validation
+func NewPrerequisite(course, prerequisite CourseRef, isMandatory bool, minimumGrade *float64) (Prerequisite, error) {
+	if course.ID.Equals(prerequisite.ID) {
+		return Prerequisite{}, ErrSelfPrerequisite
+	}
+
+	if minimumGrade != nil && (*minimumGrade < 0 || *minimumGrade > 100) {
+		return Prerequisite{}, ErrInvalidMinimumGrade
+	}
+
+	return Prerequisite{
+		Course:       course,
+		Prerequisite: prerequisite,
+		IsMandatory:  isMandatory,
+		MinimumGrade: minimumGrade,
+	}, nil
+}
+
+// PrerequisiteGraph is a directed graph where an edge goes from a course to
+// each of its prerequisites
+type PrerequisiteGraph struct {
+	courses map[valueobject.ID]CourseRef
+	edges   map[valueobject.ID][]Prerequisite
+}
+
+// NewPrerequisiteGraph builds a graph from a list of prerequisites
+func NewPrerequisiteGraph(prerequisites []Prerequisite) *PrerequisiteGraph {
+	g := &PrerequisiteGraph{
+		courses: make(map[valueobject.ID]CourseRef),
+		edges:   make(map[valueobject.ID][]Prerequisite),
+	}
+	for _, p := range prerequisites {
+		g.add(p)
+	}
+	return g
+}
+
+func (g *PrerequisiteGraph) add(p Prerequisite) {
+	g.courses[p.Course.ID] = p.Course
+	g.courses[p.Prerequisite.ID] = p.Prerequisite
+	g.edges[p.Course.ID] = append(g.edges[p.Course.ID], p)
+}
+
+// Path returns the chain of courses leading from one course to another
+// following prerequisite edges, or nil when to is not reachable
+func (g *PrerequisiteGraph) Path(from, to valueobject.ID) []CourseRef {
+	visited := make(map[valueobject.ID]bool)
+
+	var walk func(id valueobject.ID) []CourseRef
+	walk = func(id valueobject.ID) []CourseRef {
+		if id.Equals(to) {
+			return []CourseRef{g.courses[id]}
+		}
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+
+		for _, edge := range g.edges[id] {
+			if path := walk(edge.Prerequisite.ID); path != nil {
+				return append([]CourseRef{g.courses[id]}, path...)
+			}
+		}
+		return nil
+	}
+
+	return walk(from)
+}
+
+// CheckAddition verifies that adding p keeps the graph acyclic. The graph
+// must contain every prerequisite reachable from p.Prerequisite.
+func (g *PrerequisiteGraph) CheckAddition(p Prerequisite) error {
+	if p.Course.ID.Equals(p.Prerequisite.ID) {
+		return ErrSelfPrerequisite
+	}
+
+	path := g.Path(p.Prerequisite.ID, p.Course.ID)
+	if path == nil {
+		return nil
+	}
+
+	codes := []string{p.Course.Code}
+	for _, course := range path {
+		codes = append(codes, course.Code)
+	}
+	return fmt.Errorf("%w: %s", ErrPrerequisiteCycle, strings.Join(codes, " -> "))
+}
+
+// PrerequisiteNode is a course of the prerequisite tree with its own prerequisites
+type PrerequisiteNode struct {
+	CourseRef
+	IsMandatory   bool               `json:"is_mandatory"`
+	MinimumGrade  *float64           `json:"minimum_grade,omitempty"`
+	IsCorequisite bool               `json:"is_corequisite"`
+	Prerequisites []PrerequisiteNode `json:"prerequisites"`
+}
+
+// Tree expands the transitive prerequisites of the root course. Courses
+// shared by several branches are repeated under each of them.
+func (g *PrerequisiteGraph) Tree(root CourseRef) PrerequisiteNode {
+	node := PrerequisiteNode{CourseRef: root, IsMandatory: true}
+	node.Prerequisites = g.children(root.ID, map[valueobject.ID]bool{root.ID: true})
+	return node
+}
+
+func (g *PrerequisiteGraph) children(id valueobject.ID, ancestors map[valueobject.ID]bool) []PrerequisiteNode {
+	edges := g.sortedEdges(id)
+	nodes := make([]PrerequisiteNode, 0, len(edges))
+
+	for _, edge := range edges {
+		child := PrerequisiteNode{
+			CourseRef:     edge.Prerequisite,
+			IsMandatory:   edge.IsMandatory,
+			MinimumGrade:  edge.MinimumGrade,
+			IsCorequisite: edge.IsCorequisite,
+			Prerequisites: []PrerequisiteNode{},
+		}
+		// the graph is acyclic, the guard only protects against corrupted data
+		if !ancestors[edge.Prerequisite.ID] {
+			ancestors[edge.Prerequisite.ID] = true
+			child.Prerequisites = g.children(edge.Prerequisite.ID, ancestors)
+			delete(ancestors, edge.Prerequisite.ID)
+		}
+		nodes = append(nodes, child)
+	}
+
+	return nodes
+}
+
+// DOT renders the graph in the Graphviz DOT language. Optional edges are
+// dashed, corequisites end in a hollow dot and minimum grades are used as
+// edge labels.
+func (g *PrerequisiteGraph) DOT(root CourseRef) string {
+	var b strings.Builder
+
+	b.WriteString("digraph prerequisites {\n")
+	b.WriteString("  rankdir=BT;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	courses := map[valueobject.ID]CourseRef{root.ID: root}
+	for id, course := range g.courses {
+		courses[id] = course
+	}
+
+	ids := make([]valueobject.ID, 0, len(courses))
+	for id := range courses {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return courses[ids[i]].Code < courses[ids[j]].Code })
+
+	for _, id := range ids {
+		course := courses[id]
+		attrs := fmt.Sprintf("label=%s", dotQuote(course.Code+"\n"+course.Name))
+		if id.Equals(root.ID) {
+			attrs += ", style=bold"
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(id.String()), attrs)
+	}
+
+	for _, id := range ids {
+		for _, edge := range g.sortedEdges(id) {
+			var attrs []string
+			if !edge.IsMandatory {
+				attrs = append(attrs, "style=dashed")
+			}
+			if edge.IsCorequisite {
+				attrs = append(attrs, "arrowhead=odot")
+			}
+			if edge.MinimumGrade != nil {
+				attrs = append(attrs, fmt.Sprintf("label=%s", dotQuote(fmt.Sprintf(">= %.2f", *edge.MinimumGrade))))
+			}
+			line := fmt.Sprintf("  %s -> %s", dotQuote(edge.Prerequisite.ID.String()), dotQuote(id.String()))
+			if len(attrs) > 0 {
+				line += " [" + strings.Join(attrs, ", ") + "]"
+			}
+			b.WriteString(line + ";\n")
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func (g *PrerequisiteGraph) sortedEdges(id valueobject.ID) []Prerequisite {
+	edges := append([]Prerequisite{}, g.edges[id]...)
+	sort.Slice(edges, func(i, j int) bool { return edges[i].Prerequisite.Code < edges[j].Prerequisite.Code })
+	return edges
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
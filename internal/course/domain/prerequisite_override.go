@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrOverrideNotFound    = errors.New("prerequisite override does not exist")
+	ErrOverrideExists      = errors.New("student already has a prerequisite override for this course")
+	ErrEmptyOverrideReason = errors.New("the reason of the override cannot be empty")
+)
+
+type PrerequisiteOverrideRepository interface {
+	Grant(ctx context.Context, o *PrerequisiteOverride) (err error)
+	Revoke(ctx context.Context, courseID, studentID valueobject.ID) (err error)
+	ListByCourse(ctx context.Context, courseID valueobject.ID) ([]*PrerequisiteOverride, error)
+	// IsDepartmentHead checks if the user heads the department offering the course
+	IsDepartmentHead(ctx context.Context, courseID, userID valueobject.ID) (bool, error)
+}
+
+// PrerequisiteOverride lets a student enroll in a course without meeting
+// its prerequisites and corequisites
+type PrerequisiteOverride struct {
+	ID        valueobject.ID `json:"id"`
+	CourseID  valueobject.ID `json:"course_id"`
+	StudentID valueobject.ID `json:"student_id"`
+	// GrantedBy is the admin or department head that allowed the exception
+	GrantedBy valueobject.ID `json:"granted_by"`
+	Reason    string         `json:"reason"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// NewPrerequisiteOverride creates a PrerequisiteOverride with validation
+func NewPrerequisiteOverride(courseID, studentID, grantedBy valueobject.ID, reason string, now time.Time) (*PrerequisiteOverride, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrEmptyOverrideReason
+	}
+
+	return &PrerequisiteOverride{
+		ID:        valueobject.NewID(),
+		CourseID:  courseID,
+		StudentID: studentID,
+		GrantedBy: grantedBy,
+		Reason:    reason,
+		CreatedAt: now,
+	}, nil
+}
+
+// PrerequisiteOverrideFromPersistence creates a PrerequisiteOverride instance from database records
+func PrerequisiteOverrideFromPersistence(
+	id valueobject.ID,
+	courseID valueobject.ID,
+	studentID valueobject.ID,
+	grantedBy valueobject.ID,
+	reason string,
+	createdAt time.Time,
+) *PrerequisiteOverride {
+	return &PrerequisiteOverride{
+		ID:        id,
+		CourseID:  courseID,
+		StudentID: studentID,
+		GrantedBy: grantedBy,
+		Reason:    reason,
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func course(code string) domain.CourseRef {
+	return domain.CourseRef{ID: valueobject.NewID(), Code: code, Name: "Course " + code}
+}
+
+func requires(c, prerequisite domain.CourseRef) domain.Prerequisite {
+	return domain.Prerequisite{Course: c, Prerequisite: prerequisite, IsMandatory: true}
+}
+
+func TestPrerequisiteGraphCheckAddition(t *testing.T) {
+	calc1, calc2, calc3, physics, algebra := course("MAT101"), course("MAT102"), course("MAT201"), course("PHY101"), course("MAT100")
+
+	// MAT201 -> MAT102 -> MAT101 -> MAT100, PHY101 -> MAT101
+	graph := domain.NewPrerequisiteGraph([]domain.Prerequisite{
+		requires(calc3, calc2),
+		requires(calc2, calc1),
+		requires(calc1, algebra),
+		requires(physics, calc1),
+	})
+
+	tests := []struct {
+		name      string
+		addition  domain.Prerequisite
+		wantErr   error
+		wantCycle string
+	}{
+		{name: "accepts a new leaf", addition: requires(algebra, course("MAT050"))},
+		{name: "accepts a shortcut", addition: requires(calc3, calc1)},
+		{name: "accepts joining two branches", addition: requires(calc3, physics)},
+		{name: "refuses the course itself", addition: requires(calc1, calc1), wantErr: domain.ErrSelfPrerequisite},
+		{name: "refuses a direct cycle", addition: requires(calc1, calc2), wantErr: domain.ErrPrerequisiteCycle, wantCycle: "MAT101 -> MAT102 -> MAT101"},
+		{name: "refuses a transitive cycle", addition: requires(algebra, calc3), wantErr: domain.ErrPrerequisiteCycle, wantCycle: "MAT100 -> MAT201 -> MAT102 -> MAT101 -> MAT100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := graph.CheckAddition(tt.addition)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantCycle != "" && !strings.HasSuffix(err.Error(), tt.wantCycle) {
+				t.Errorf("got %q, want the cycle %q", err.Error(), tt.wantCycle)
+			}
+		})
+	}
+}
+
+func TestPrerequisiteGraphDOT(t *testing.T) {
+	calc1, calc2, physics := course("MAT101"), course("MAT102"), course("PHY101")
+	grade := 70.0
+
+	optional := requires(calc2, physics)
+	optional.IsMandatory = false
+	corequisite := requires(physics, calc1)
+	corequisite.IsCorequisite = true
+	graded := requires(calc2, calc1)
+	graded.MinimumGrade = &grade
+
+	graph := domain.NewPrerequisiteGraph([]domain.Prerequisite{optional, corequisite, graded})
+	dot := graph.DOT(calc2)
+
+	edge := func(from, to domain.CourseRef) string {
+		return fmt.Sprintf("%q -> %q", from.ID.String(), to.ID.String())
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "opens the graph", want: "digraph prerequisites {\n  rankdir=BT;\n  node [shape=box];\n"},
+		{name: "labels courses with code and name", want: fmt.Sprintf(`%q [label="MAT101\nCourse MAT101"];`, calc1.ID.String())},
+		{name: "highlights the root", want: fmt.Sprintf(`%q [label="MAT102\nCourse MAT102", style=bold];`, calc2.ID.String())},
+		{name: "dashes optional edges", want: edge(physics, calc2) + " [style=dashed];"},
+		{name: "marks corequisites", want: edge(calc1, physics) + " [arrowhead=odot];"},
+		{name: "labels minimum grades", want: edge(calc1, calc2) + ` [label=">= 70.00"];`},
+		{name: "closes the graph", want: "}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(dot, tt.want) {
+				t.Errorf("%q not found in\n%s", tt.want, dot)
+			}
+		})
+	}
+
+	// nodes are sorted by code so the output is stable
+	if a, b := strings.Index(dot, calc1.ID.String()+`" [`), strings.Index(dot, physics.ID.String()+`" [`); a > b {
+		t.Error("nodes are not sorted by course code")
+	}
+}
+
+func TestPrerequisiteGraphDOTEscapes(t *testing.T) {
+	root := domain.CourseRef{ID: valueobject.NewID(), Code: "ART101", Name: `Drawing "the \ line"`}
+
+	dot := domain.NewPrerequisiteGraph(nil).DOT(root)
+
+	want := `label="ART101\nDrawing \"the \\ line\""`
+	if !strings.Contains(dot, want) {
+		t.Errorf("%s not found in\n%s", want, dot)
+	}
+}
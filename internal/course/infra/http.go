@@ -0,0 +1,172 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type courseHandler struct {
+	interactor application.CourseInteractor
+}
+
+func NewCourseHandler(uc application.CourseInteractor) *courseHandler {
+	return &courseHandler{uc}
+}
+
+func (h courseHandler) CreateCourse(c fiber.Ctx) error {
+	var req application.CreateCourseInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Create(c.Context(), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h courseHandler) UpdateCourse(c fiber.Ctx) error {
+	var req application.UpdateCourseInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Update(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h courseHandler) GetCourse(c fiber.Ctx) error {
+	data, err := h.interactor.Get(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h courseHandler) ListCourses(c fiber.Ctx) error {
+	var req application.ListCoursesInput
+
+	if err := c.Bind().Query(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.List(c.Context(), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+type prerequisiteHandler struct {
+	interactor application.PrerequisiteInteractor
+}
+
+func NewPrerequisiteHandler(uc application.PrerequisiteInteractor) *prerequisiteHandler {
+	return &prerequisiteHandler{uc}
+}
+
+func (h prerequisiteHandler) AddPrerequisite(c fiber.Ctx) error {
+	var req application.AddPrerequisiteInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Add(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h prerequisiteHandler) RemovePrerequisite(c fiber.Ctx) error {
+	if err := h.interactor.Remove(c.Context(), c.Params("id"), c.Params("prerequisiteId")); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h prerequisiteHandler) ListPrerequisites(c fiber.Ctx) error {
+	data, err := h.interactor.ListDirect(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+// GetTree returns the transitive prerequisites as nested JSON, or as a
+// Graphviz document when called with ?format=dot
+func (h prerequisiteHandler) GetTree(c fiber.Ctx) error {
+	if c.Query("format") == "dot" {
+		data, err := h.interactor.DOT(c.Context(), c.Params("id"))
+		if err != nil {
+			return httpx.ErrorResponse(c, err)
+		}
+
+		c.Set(fiber.HeaderContentType, "text/vnd.graphviz; charset=utf-8")
+		return c.Status(http.StatusOK).SendString(data)
+	}
+
+	data, err := h.interactor.Tree(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+type prerequisiteOverrideHandler struct {
+	interactor application.PrerequisiteOverrideInteractor
+}
+
+func NewPrerequisiteOverrideHandler(uc application.PrerequisiteOverrideInteractor) *prerequisiteOverrideHandler {
+	return &prerequisiteOverrideHandler{uc}
+}
+
+func (h prerequisiteOverrideHandler) GrantOverride(c fiber.Ctx) error {
+	var req application.GrantOverrideInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Grant(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h prerequisiteOverrideHandler) RevokeOverride(c fiber.Ctx) error {
+	if err := h.interactor.Revoke(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId")); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h prerequisiteOverrideHandler) ListOverrides(c fiber.Ctx) error {
+	data, err := h.interactor.List(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
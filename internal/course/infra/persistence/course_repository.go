@@ -0,0 +1,222 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const courseColumns = `
+	id,
+	department_id,
+	code,
+	name,
+	COALESCE(description, ''),
+	credits,
+	COALESCE(theoretical_hours, 0),
+	COALESCE(practical_hours, 0),
+	COALESCE(level, 0),
+	COALESCE(is_active, true),
+	COALESCE(created_at, NOW()),
+	COALESCE(updated_at, NOW())
+`
+
+type postgresCourseRepository struct {
+	pool *sql.DB
+}
+
+func NewCourseRepository(db *sql.DB) *postgresCourseRepository {
+	return &postgresCourseRepository{db}
+}
+
+func (r postgresCourseRepository) Create(ctx context.Context, c *domain.Course) error {
+	query := `
+		INSERT INTO courses (
+			id,
+			department_id,
+			code,
+			name,
+			description,
+			credits,
+			theoretical_hours,
+			practical_hours,
+			level,
+			is_active,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		c.ID.String(),
+		c.DepartmentID.String(),
+		c.Code,
+		c.Name,
+		c.Description,
+		c.Credits,
+		c.TheoreticalHours,
+		c.PracticalHours,
+		c.Level,
+		c.IsActive,
+		c.CreatedAt,
+		c.UpdatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r postgresCourseRepository) Update(ctx context.Context, c *domain.Course) error {
+	query := `
+		UPDATE courses SET
+			code = $2,
+			name = $3,
+			description = $4,
+			credits = $5,
+			theoretical_hours = $6,
+			practical_hours = $7,
+			level = $8,
+			is_active = $9,
+			updated_at = $10
+		WHERE id = $1
+	`
+	result, err := r.pool.ExecContext(ctx, query,
+		c.ID.String(),
+		c.Code,
+		c.Name,
+		c.Description,
+		c.Credits,
+		c.TheoreticalHours,
+		c.PracticalHours,
+		c.Level,
+		c.IsActive,
+		c.UpdatedAt,
+	)
+	if err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrCourseNotFound
+	}
+	return nil
+}
+
+func (r postgresCourseRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.Course, error) {
+	query := `SELECT ` + courseColumns + ` FROM courses WHERE id = $1`
+
+	course, err := scanCourse(r.pool.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrCourseNotFound
+	}
+	return course, err
+}
+
+func (r postgresCourseRepository) List(ctx context.Context, filter domain.CourseFilter) ([]*domain.Course, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	if !filter.DepartmentID.IsEmpty() {
+		args = append(args, filter.DepartmentID.String())
+		conditions = append(conditions, fmt.Sprintf("department_id = $%d", len(args)))
+	}
+
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		args = append(args, "%"+search+"%")
+		conditions = append(conditions, fmt.Sprintf("(code ILIKE $%d OR name ILIKE $%d)", len(args), len(args)))
+	}
+
+	if filter.OnlyActive {
+		conditions = append(conditions, "COALESCE(is_active, true)")
+	}
+
+	query := `SELECT ` + courseColumns + ` FROM courses`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit, filter.Offset)
+	query += fmt.Sprintf(` ORDER BY code LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	rows, err := r.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var courses []*domain.Course
+	for rows.Next() {
+		course, err := scanCourse(rows)
+		if err != nil {
+			return nil, err
+		}
+		courses = append(courses, course)
+	}
+
+	return courses, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCourse(row scanner) (*domain.Course, error) {
+	var (
+		id, departmentID          valueobject.ID
+		code, name, description   string
+		credits, theory, practice int
+		level                     int
+		isActive                  bool
+		createdAt, updatedAt      time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&departmentID,
+		&code,
+		&name,
+		&description,
+		&credits,
+		&theory,
+		&practice,
+		&level,
+		&isActive,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return domain.CourseFromPersistence(
+		id,
+		departmentID,
+		code,
+		name,
+		description,
+		credits,
+		theory,
+		practice,
+		level,
+		isActive,
+		createdAt,
+		updatedAt,
+	), nil
+}
@@ -0,0 +1,65 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresLegacyPrerequisiteRepository struct {
+	pool *sql.DB
+}
+
+func NewLegacyPrerequisiteRepository(db *sql.DB) *postgresLegacyPrerequisiteRepository {
+	return &postgresLegacyPrerequisiteRepository{db}
+}
+
+func (r postgresLegacyPrerequisiteRepository) ListLegacy(ctx context.Context) ([]domain.LegacyCourse, error) {
+	query := `
+		SELECT id, code, name, department_id, prerequisites
+		FROM courses
+		WHERE NULLIF(TRIM(prerequisites), '') IS NOT NULL
+		ORDER BY code
+	`
+	rows, err := r.pool.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var courses []domain.LegacyCourse
+	for rows.Next() {
+		var course domain.LegacyCourse
+		if err := rows.Scan(&course.Course.ID, &course.Course.Code, &course.Course.Name, &course.DepartmentID, &course.Text); err != nil {
+			return nil, err
+		}
+
+		courses = append(courses, course)
+	}
+
+	return courses, rows.Err()
+}
+
+func (r postgresLegacyPrerequisiteRepository) ListDepartmentCourses(ctx context.Context, departmentID valueobject.ID) ([]domain.CourseRef, error) {
+	query := `SELECT id, code, name FROM courses WHERE department_id = $1`
+
+	rows, err := r.pool.QueryContext(ctx, query, departmentID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var courses []domain.CourseRef
+	for rows.Next() {
+		var course domain.CourseRef
+		if err := rows.Scan(&course.ID, &course.Code, &course.Name); err != nil {
+			return nil, err
+		}
+
+		courses = append(courses, course)
+	}
+
+	return courses, rows.Err()
+}
@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresPrerequisiteOverrideRepository struct {
+	pool *sql.DB
+}
+
+func NewPrerequisiteOverrideRepository(db *sql.DB) *postgresPrerequisiteOverrideRepository {
+	return &postgresPrerequisiteOverrideRepository{db}
+}
+
+func (r postgresPrerequisiteOverrideRepository) Grant(ctx context.Context, o *domain.PrerequisiteOverride) error {
+	query := `
+		INSERT INTO prerequisite_overrides (id, course_id, student_id, granted_by, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		o.ID.String(),
+		o.CourseID.String(),
+		o.StudentID.String(),
+		o.GrantedBy.String(),
+		o.Reason,
+		o.CreatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r postgresPrerequisiteOverrideRepository) Revoke(ctx context.Context, courseID, studentID valueobject.ID) error {
+	query := `DELETE FROM prerequisite_overrides WHERE course_id = $1 AND student_id = $2`
+
+	result, err := r.pool.ExecContext(ctx, query, courseID.String(), studentID.String())
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrOverrideNotFound
+	}
+	return nil
+}
+
+func (r postgresPrerequisiteOverrideRepository) ListByCourse(ctx context.Context, courseID valueobject.ID) ([]*domain.PrerequisiteOverride, error) {
+	query := `
+		SELECT id, course_id, student_id, granted_by, reason, created_at
+		FROM prerequisite_overrides
+		WHERE course_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.pool.QueryContext(ctx, query, courseID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var overrides []*domain.PrerequisiteOverride
+	for rows.Next() {
+		var (
+			id, course, student, grantedBy valueobject.ID
+			reason                         string
+			createdAt                      time.Time
+		)
+		if err := rows.Scan(&id, &course, &student, &grantedBy, &reason, &createdAt); err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, domain.PrerequisiteOverrideFromPersistence(
+			id,
+			course,
+			student,
+			grantedBy,
+			reason,
+			createdAt,
+		))
+	}
+
+	return overrides, rows.Err()
+}
+
+func (r postgresPrerequisiteOverrideRepository) IsDepartmentHead(ctx context.Context, courseID, userID valueobject.ID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM courses c
+			JOIN departments d ON d.id = c.department_id
+			WHERE c.id = $1 AND d.head_id = $2
+		)
+	`
+	var head bool
+	err := r.pool.QueryRowContext(ctx, query, courseID.String(), userID.String()).Scan(&head)
+	return head, err
+}
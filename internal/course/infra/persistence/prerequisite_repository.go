@@ -0,0 +1,171 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/course/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const prerequisiteColumns = `
+	e.course_id,
+	c.code,
+	c.name,
+	e.prerequisite_id,
+	p.code,
+	p.name,
+	COALESCE(e.is_mandatory, true),
+	e.minimum_grade,
+	COALESCE(e.is_corequisite, false)
+`
+
+type postgresPrerequisiteRepository struct {
+	pool *sql.DB
+}
+
+func NewPrerequisiteRepository(db *sql.DB) *postgresPrerequisiteRepository {
+	return &postgresPrerequisiteRepository{db}
+}
+
+func (r postgresPrerequisiteRepository) Add(ctx context.Context, p domain.Prerequisite) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// serialize graph changes so two concurrent additions can't close a
+	// cycle that neither of them sees on its own
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('course_prerequisites'))`); err != nil {
+		return err
+	}
+
+	cycle := `
+		WITH RECURSIVE reachable(id) AS (
+			SELECT prerequisite_id FROM course_prerequisites WHERE course_id = $1
+			UNION
+			SELECT cp.prerequisite_id
+			FROM course_prerequisites cp
+			JOIN reachable r ON cp.course_id = r.id
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $2)
+	`
+	var closesCycle bool
+	if err := tx.QueryRowContext(ctx, cycle, p.Prerequisite.ID.String(), p.Course.ID.String()).Scan(&closesCycle); err != nil {
+		return err
+	}
+
+	if closesCycle {
+		return fmt.Errorf("%w: %s -> %s", domain.ErrPrerequisiteCycle, p.Course.Code, p.Prerequisite.Code)
+	}
+
+	insert := `
+		INSERT INTO course_prerequisites (course_id, prerequisite_id, is_mandatory, minimum_grade, is_corequisite)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := tx.ExecContext(ctx, insert,
+		p.Course.ID.String(),
+		p.Prerequisite.ID.String(),
+		p.IsMandatory,
+		p.MinimumGrade,
+		p.IsCorequisite,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresPrerequisiteRepository) Remove(ctx context.Context, courseID, prerequisiteID valueobject.ID) error {
+	query := `DELETE FROM course_prerequisites WHERE course_id = $1 AND prerequisite_id = $2`
+
+	result, err := r.pool.ExecContext(ctx, query, courseID.String(), prerequisiteID.String())
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrPrerequisiteNotFound
+	}
+	return nil
+}
+
+func (r postgresPrerequisiteRepository) ListDirect(ctx context.Context, courseID valueobject.ID) ([]domain.Prerequisite, error) {
+	query := `
+		SELECT ` + prerequisiteColumns + `
+		FROM course_prerequisites e
+		JOIN courses c ON c.id = e.course_id
+		JOIN courses p ON p.id = e.prerequisite_id
+		WHERE e.course_id = $1
+		ORDER BY p.code
+	`
+	return r.query(ctx, query, courseID.String())
+}
+
+func (r postgresPrerequisiteRepository) Subgraph(ctx context.Context, courseID valueobject.ID) (*domain.PrerequisiteGraph, error) {
+	// UNION discards repeated edges, so the recursion ends even if the
+	// table was corrupted with a cycle outside this module
+	query := `
+		WITH RECURSIVE edges(course_id, prerequisite_id, is_mandatory, minimum_grade, is_corequisite) AS (
+			SELECT course_id, prerequisite_id, is_mandatory, minimum_grade, is_corequisite
+			FROM course_prerequisites
+			WHERE course_id = $1
+			UNION
+			SELECT cp.course_id, cp.prerequisite_id, cp.is_mandatory, cp.minimum_grade, cp.is_corequisite
+			FROM course_prerequisites cp
+			JOIN edges e ON cp.course_id = e.prerequisite_id
+		)
+		SELECT ` + prerequisiteColumns + `
+		FROM edges e
+		JOIN courses c ON c.id = e.course_id
+		JOIN courses p ON p.id = e.prerequisite_id
+	`
+	prerequisites, err := r.query(ctx, query, courseID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPrerequisiteGraph(prerequisites), nil
+}
+
+func (r postgresPrerequisiteRepository) query(ctx context.Context, query string, args ...any) ([]domain.Prerequisite, error) {
+	rows, err := r.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prerequisites []domain.Prerequisite
+	for rows.Next() {
+		var (
+			p            domain.Prerequisite
+			minimumGrade sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&p.Course.ID,
+			&p.Course.Code,
+			&p.Course.Name,
+			&p.Prerequisite.ID,
+			&p.Prerequisite.Code,
+			&p.Prerequisite.Name,
+			&p.IsMandatory,
+			&minimumGrade,
+			&p.IsCorequisite,
+		); err != nil {
+			return nil, err
+		}
+
+		if minimumGrade.Valid {
+			p.MinimumGrade = &minimumGrade.Float64
+		}
+		prerequisites = append(prerequisites, p)
+	}
+
+	return prerequisites, rows.Err()
+}
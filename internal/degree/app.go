@@ -0,0 +1,45 @@
+package degree
+
+import (
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/infra/persistence"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Module struct {
+	name   string
+	engine *fiber.App
+	db     *sql.DB
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB) Module {
+	return Module{name: name, engine: engine, db: db}
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.engine.Group(mod.name, httpx.Authenticate())
+
+	degrees := persistence.NewDegreeRepository(mod.db)
+	h := infra.NewCurriculumHandler(application.NewCurriculumInteractor(
+		degrees,
+		persistence.NewCurriculumRepository(mod.db),
+		degrees,
+	))
+
+	admin := httpx.RequireRoles(shared.RoleAdmin)
+
+	group.Get("", h.ListDegrees)
+	group.Get("/:id", h.GetDegree)
+	group.Put("/:id/credit-limits", admin, h.UpdateCreditLimits)
+	group.Get("/:id/curriculum", h.GetCurrentCurriculum)
+	group.Get("/:id/curricula", h.ListCurricula)
+	group.Post("/:id/curricula", admin, h.CreateCurriculum)
+	group.Get("/:id/curricula/:version", h.GetCurriculum)
+	group.Put("/:id/curricula/:version", admin, h.UpdatePlan)
+	group.Post("/:id/curricula/:version/publish", admin, h.Publish)
+}
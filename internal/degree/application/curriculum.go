@@ -0,0 +1,373 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	CreateCurriculumInput struct {
+		ElectiveCredits int    `json:"elective_credits" validate:"min=0"`
+		Notes           string `json:"notes" validate:"max=2000"`
+		// CopyPrevious starts the draft from the latest published plan
+		CopyPrevious bool `json:"copy_previous"`
+	}
+
+	PlanCourseInput struct {
+		CourseID   string `json:"course_id" validate:"required,uuid"`
+		Semester   int    `json:"semester" validate:"required,min=1,max=12"`
+		IsRequired *bool  `json:"is_required"`
+	}
+
+	PlanElectiveInput struct {
+		CourseID       string `json:"course_id" validate:"required,uuid"`
+		ElectiveGroup  string `json:"elective_group" validate:"required,max=100"`
+		CreditsAwarded *int   `json:"credits_awarded" validate:"omitempty,min=0"`
+	}
+
+	UpdatePlanInput struct {
+		ElectiveCredits int                 `json:"elective_credits" validate:"min=0"`
+		Courses         []PlanCourseInput   `json:"courses" validate:"dive"`
+		Electives       []PlanElectiveInput `json:"electives" validate:"dive"`
+	}
+
+	UpdateCreditLimitsInput struct {
+		Minimum             int     `json:"minimum" validate:"min=0"`
+		Maximum             int     `json:"maximum" validate:"required,min=1"`
+		GoodStandingMaximum int     `json:"good_standing_maximum" validate:"required,min=1"`
+		GoodStandingAverage float64 `json:"good_standing_average" validate:"min=0,max=100"`
+	}
+
+	CurriculumOutput struct {
+		ID              valueobject.ID          `json:"id"`
+		DegreeID        valueobject.ID          `json:"degree_id"`
+		Version         int                     `json:"version"`
+		Status          domain.CurriculumStatus `json:"status"`
+		Notes           string                  `json:"notes,omitempty"`
+		TotalCredits    int                     `json:"total_credits"`
+		RequiredCredits int                     `json:"required_credits"`
+		ElectiveCredits int                     `json:"elective_credits"`
+		// CreditsIssue explains why the plan can't be published yet
+		CreditsIssue   string                 `json:"credits_issue,omitempty"`
+		Semesters      []domain.SemesterPlan  `json:"semesters"`
+		ElectiveGroups []domain.ElectiveGroup `json:"elective_groups"`
+	}
+)
+
+type CurriculumInteractor interface {
+	ListDegrees(ctx context.Context, onlyActive bool) ([]*domain.Degree, error)
+	GetDegree(ctx context.Context, degreeID string) (*domain.Degree, error)
+	// UpdateCreditLimits changes the credits per period allowed to the students of the degree
+	UpdateCreditLimits(ctx context.Context, degreeID string, in UpdateCreditLimitsInput) (*domain.Degree, error)
+	CreateCurriculum(ctx context.Context, degreeID string, in CreateCurriculumInput) (CurriculumOutput, error)
+	UpdatePlan(ctx context.Context, degreeID string, version int, in UpdatePlanInput) (CurriculumOutput, error)
+	Publish(ctx context.Context, degreeID string, version int) (CurriculumOutput, error)
+	// GetCurriculum returns the given version, or the latest published one when version is zero
+	GetCurriculum(ctx context.Context, degreeID string, version int) (CurriculumOutput, error)
+	ListCurricula(ctx context.Context, degreeID string) ([]CurriculumOutput, error)
+}
+
+type curriculumInteractor struct {
+	degrees   domain.DegreeRepository
+	curricula domain.CurriculumRepository
+	catalog   domain.CourseCatalog
+}
+
+func NewCurriculumInteractor(d domain.DegreeRepository, c domain.CurriculumRepository, cc domain.CourseCatalog) *curriculumInteractor {
+	return &curriculumInteractor{d, c, cc}
+}
+
+func (interactor curriculumInteractor) ListDegrees(ctx context.Context, onlyActive bool) ([]*domain.Degree, error) {
+	degrees, err := interactor.degrees.List(ctx, onlyActive)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if degrees == nil {
+		degrees = []*domain.Degree{}
+	}
+	return degrees, nil
+}
+
+func (interactor curriculumInteractor) GetDegree(ctx context.Context, degreeID string) (*domain.Degree, error) {
+	id, err := valueobject.IDFromString(degreeID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid degree id")
+	}
+
+	degree, err := interactor.degrees.FindByID(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return degree, nil
+}
+
+func (interactor curriculumInteractor) UpdateCreditLimits(ctx context.Context, degreeID string, in UpdateCreditLimitsInput) (*domain.Degree, error) {
+	degree, err := interactor.GetDegree(ctx, degreeID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := domain.NewCreditLimits(in.Minimum, in.Maximum, in.GoodStandingMaximum, in.GoodStandingAverage)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.degrees.UpdateCreditLimits(ctx, degree.ID, limits); err != nil {
+		return nil, mapError(err)
+	}
+
+	degree.CreditLimits = limits
+	return degree, nil
+}
+
+func (interactor curriculumInteractor) CreateCurriculum(ctx context.Context, degreeID string, in CreateCurriculumInput) (CurriculumOutput, error) {
+	degree, err := interactor.GetDegree(ctx, degreeID)
+	if err != nil {
+		return CurriculumOutput{}, err
+	}
+
+	versions, err := interactor.curricula.ListVersions(ctx, degree.ID)
+	if err != nil {
+		return CurriculumOutput{}, mapError(err)
+	}
+
+	next := 1
+	var latestPublished *domain.Curriculum
+	for _, version := range versions {
+		if !version.IsPublished() {
+			return CurriculumOutput{}, shared.ErrConflictWith(domain.ErrDraftAlreadyExists,
+				fmt.Sprintf("%s: version %d", domain.ErrDraftAlreadyExists, version.Version))
+		}
+		if version.Version >= next {
+			next = version.Version + 1
+			latestPublished = version
+		}
+	}
+
+	curriculum, err := domain.NewCurriculum(degree.ID, next, in.ElectiveCredits, in.Notes)
+	if err != nil {
+		return CurriculumOutput{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if in.CopyPrevious && latestPublished != nil {
+		previous, err := interactor.curricula.FindByVersion(ctx, degree.ID, latestPublished.Version)
+		if err != nil {
+			return CurriculumOutput{}, mapError(err)
+		}
+		courses := append([]domain.PlanCourse{}, previous.Courses...)
+		electives := append([]domain.PlanElective{}, previous.Electives...)
+		if err := curriculum.ReplacePlan(degree, previous.ElectiveCredits, courses, electives); err != nil {
+			return CurriculumOutput{}, shared.ErrInvalidInputWith(err, err.Error())
+		}
+	}
+
+	if err := interactor.curricula.Create(ctx, curriculum); err != nil {
+		return CurriculumOutput{}, mapError(err)
+	}
+
+	return newCurriculumOutput(degree, curriculum), nil
+}
+
+func (interactor curriculumInteractor) UpdatePlan(ctx context.Context, degreeID string, version int, in UpdatePlanInput) (CurriculumOutput, error) {
+	degree, curriculum, err := interactor.find(ctx, degreeID, version)
+	if err != nil {
+		return CurriculumOutput{}, err
+	}
+
+	ids := make([]valueobject.ID, 0, len(in.Courses)+len(in.Electives))
+	for _, course := range in.Courses {
+		id, err := valueobject.IDFromString(course.CourseID)
+		if err != nil {
+			return CurriculumOutput{}, shared.ErrInvalidInputWith(err, "invalid course id")
+		}
+		ids = append(ids, id)
+	}
+	for _, elective := range in.Electives {
+		id, err := valueobject.IDFromString(elective.CourseID)
+		if err != nil {
+			return CurriculumOutput{}, shared.ErrInvalidInputWith(err, "invalid course id")
+		}
+		ids = append(ids, id)
+	}
+
+	catalog, err := interactor.catalog.FindCourses(ctx, ids)
+	if err != nil {
+		return CurriculumOutput{}, mapError(err)
+	}
+
+	lookup := func(raw string) (domain.Course, error) {
+		id, _ := valueobject.IDFromString(raw)
+		course, ok := catalog[id]
+		if !ok {
+			return domain.Course{}, shared.ErrInvalidInputWith(domain.ErrCourseNotFound,
+				fmt.Sprintf("%s: %s", domain.ErrCourseNotFound, raw))
+		}
+		if !course.IsActive {
+			return domain.Course{}, shared.ErrInvalidInputWith(domain.ErrInactiveCourse,
+				fmt.Sprintf("%s: %s", domain.ErrInactiveCourse, course.Code))
+		}
+		return course, nil
+	}
+
+	courses := make([]domain.PlanCourse, 0, len(in.Courses))
+	for _, item := range in.Courses {
+		course, err := lookup(item.CourseID)
+		if err != nil {
+			return CurriculumOutput{}, err
+		}
+		required := true
+		if item.IsRequired != nil {
+			required = *item.IsRequired
+		}
+		courses = append(courses, domain.PlanCourse{
+			CourseID:   course.ID,
+			Code:       course.Code,
+			Name:       course.Name,
+			Credits:    course.Credits,
+			Semester:   item.Semester,
+			IsRequired: required,
+		})
+	}
+
+	electives := make([]domain.PlanElective, 0, len(in.Electives))
+	for _, item := range in.Electives {
+		course, err := lookup(item.CourseID)
+		if err != nil {
+			return CurriculumOutput{}, err
+		}
+		credits := course.Credits
+		if item.CreditsAwarded != nil {
+			credits = *item.CreditsAwarded
+		}
+		electives = append(electives, domain.PlanElective{
+			CourseID:       course.ID,
+			Code:           course.Code,
+			Name:           course.Name,
+			ElectiveGroup:  item.ElectiveGroup,
+			CreditsAwarded: credits,
+		})
+	}
+
+	if err := curriculum.ReplacePlan(degree, in.ElectiveCredits, courses, electives); err != nil {
+		if errors.Is(err, domain.ErrCurriculumPublished) {
+			return CurriculumOutput{}, shared.ErrConflictWith(err, err.Error())
+		}
+		return CurriculumOutput{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.curricula.Save(ctx, curriculum); err != nil {
+		return CurriculumOutput{}, mapError(err)
+	}
+
+	return newCurriculumOutput(degree, curriculum), nil
+}
+
+func (interactor curriculumInteractor) Publish(ctx context.Context, degreeID string, version int) (CurriculumOutput, error) {
+	degree, curriculum, err := interactor.find(ctx, degreeID, version)
+	if err != nil {
+		return CurriculumOutput{}, err
+	}
+
+	if err := curriculum.Publish(degree); err != nil {
+		if errors.Is(err, domain.ErrCurriculumPublished) {
+			return CurriculumOutput{}, shared.ErrConflictWith(err, err.Error())
+		}
+		return CurriculumOutput{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.curricula.Save(ctx, curriculum); err != nil {
+		return CurriculumOutput{}, mapError(err)
+	}
+
+	return newCurriculumOutput(degree, curriculum), nil
+}
+
+func (interactor curriculumInteractor) GetCurriculum(ctx context.Context, degreeID string, version int) (CurriculumOutput, error) {
+	degree, curriculum, err := interactor.find(ctx, degreeID, version)
+	if err != nil {
+		return CurriculumOutput{}, err
+	}
+	return newCurriculumOutput(degree, curriculum), nil
+}
+
+func (interactor curriculumInteractor) ListCurricula(ctx context.Context, degreeID string) ([]CurriculumOutput, error) {
+	degree, err := interactor.GetDegree(ctx, degreeID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := interactor.curricula.ListVersions(ctx, degree.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]CurriculumOutput, 0, len(versions))
+	for _, version := range versions {
+		out = append(out, newCurriculumOutput(degree, version))
+	}
+	return out, nil
+}
+
+func (interactor curriculumInteractor) find(ctx context.Context, degreeID string, version int) (*domain.Degree, *domain.Curriculum, error) {
+	degree, err := interactor.GetDegree(ctx, degreeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var curriculum *domain.Curriculum
+	if version == 0 {
+		curriculum, err = interactor.curricula.FindLatestPublished(ctx, degree.ID)
+	} else {
+		curriculum, err = interactor.curricula.FindByVersion(ctx, degree.ID, version)
+	}
+	if err != nil {
+		return nil, nil, mapError(err)
+	}
+
+	return degree, curriculum, nil
+}
+
+func newCurriculumOutput(degree *domain.Degree, c *domain.Curriculum) CurriculumOutput {
+	out := CurriculumOutput{
+		ID:              c.ID,
+		DegreeID:        c.DegreeID,
+		Version:         c.Version,
+		Status:          c.Status,
+		Notes:           c.Notes,
+		TotalCredits:    degree.TotalCredits,
+		RequiredCredits: c.RequiredCredits(),
+		ElectiveCredits: c.ElectiveCredits,
+		Semesters:       c.BySemester(),
+		ElectiveGroups:  c.ElectiveGroups(),
+	}
+
+	if err := c.ValidateCredits(degree); err != nil {
+		out.CreditsIssue = err.Error()
+	}
+
+	return out
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var appErr *shared.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, domain.ErrDegreeNotFound),
+		errors.Is(err, domain.ErrCurriculumNotFound),
+		errors.Is(err, domain.ErrNoPublishedCurricula):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrCurriculumPublished):
+		return shared.ErrConflictWith(err, err.Error())
+	case errors.Is(err, shared.ErrConflict):
+		return shared.ErrConflictWith(err, "curriculum version already exists")
+	default:
+		return shared.ErrInternalWith(err, "cannot process curriculum")
+	}
+}
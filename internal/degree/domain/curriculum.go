@@ -0,0 +1,308 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// semesters allowed by the degree_courses check constraint
+const (
+	minSemester = 1
+	maxSemester = 12
+)
+
+var (
+	ErrCurriculumNotFound   = errors.New("curriculum does not exist")
+	ErrCurriculumPublished  = errors.New("published curricula cannot be modified")
+	ErrCurriculumEmpty      = errors.New("curriculum has no courses")
+	ErrCreditsMismatch      = errors.New("curriculum credits do not match the degree total credits")
+	ErrInvalidSemester      = errors.New("invalid semester")
+	ErrDuplicatedCourse     = errors.New("course is listed more than once")
+	ErrEmptyElectiveGroup   = errors.New("elective group cannot be empty")
+	ErrInactiveCourse       = errors.New("inactive courses cannot be added to a curriculum")
+	ErrNegativeCredits      = errors.New("credits cannot be negative")
+	ErrDraftAlreadyExists   = errors.New("the degree already has a draft curriculum")
+	ErrNoPublishedCurricula = errors.New("the degree has no published curriculum")
+)
+
+type CurriculumRepository interface {
+	// Create stores a new draft, the version must be unique per degree
+	Create(ctx context.Context, c *Curriculum) (err error)
+	// Save replaces the plan of the curriculum and its status, once published
+	// the students of the degree without a curriculum are put on the latest one
+	Save(ctx context.Context, c *Curriculum) (err error)
+	FindByVersion(ctx context.Context, degreeID valueobject.ID, version int) (*Curriculum, error)
+	FindLatestPublished(ctx context.Context, degreeID valueobject.ID) (*Curriculum, error)
+	ListVersions(ctx context.Context, degreeID valueobject.ID) ([]*Curriculum, error)
+}
+
+// CurriculumStatus represents the lifecycle of a curriculum version
+type CurriculumStatus string
+
+const (
+	CurriculumDraft     CurriculumStatus = "draft"
+	CurriculumPublished CurriculumStatus = "published"
+)
+
+// PlanCourse is a course placed in a semester of the curriculum
+type PlanCourse struct {
+	CourseID   valueobject.ID `json:"course_id"`
+	Code       string         `json:"code"`
+	Name       string         `json:"name"`
+	Credits    int            `json:"credits"`
+	Semester   int            `json:"semester"`
+	IsRequired bool           `json:"is_required"`
+}
+
+// PlanElective is a course students can pick to fulfill an elective group
+type PlanElective struct {
+	CourseID       valueobject.ID `json:"course_id"`
+	Code           string         `json:"code"`
+	Name           string         `json:"name"`
+	ElectiveGroup  string         `json:"elective_group"`
+	CreditsAwarded int            `json:"credits_awarded"`
+}
+
+// Curriculum is a versioned study plan of a degree
+type Curriculum struct {
+	ID              valueobject.ID   `json:"id"`
+	DegreeID        valueobject.ID   `json:"degree_id"`
+	Version         int              `json:"version"`
+	Status          CurriculumStatus `json:"status"`
+	ElectiveCredits int              `json:"elective_credits"`
+	Notes           string           `json:"notes,omitempty"`
+	Courses         []PlanCourse     `json:"courses"`
+	Electives       []PlanElective   `json:"electives"`
+	PublishedAt     *time.Time       `json:"published_at,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+// NewCurriculum creates an empty draft for the given version of the degree
+func NewCurriculum(degreeID valueobject.ID, version int, electiveCredits int, notes string) (*Curriculum, error) {
+	if err := degreeID.Validate(); err != nil {
+		return nil, err
+	}
+
+	if version < 1 {
+		return nil, fmt.Errorf("invalid curriculum version %d", version)
+	}
+
+	if electiveCredits < 0 {
+		return nil, ErrNegativeCredits
+	}
+
+	now := time.Now()
+
+	curriculum := &Curriculum{
+		ID:              valueobject.NewID(),
+		DegreeID:        degreeID,
+		Version:         version,
+		Status:          CurriculumDraft,
+		ElectiveCredits: electiveCredits,
+		Notes:           strings.TrimSpace(notes),
+		Courses:         []PlanCourse{},
+		Electives:       []PlanElective{},
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	return curriculum, nil
+}
+
+// CurriculumFromPersistence creates a Curriculum instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func CurriculumFromPersistence(
+	id valueobject.ID,
+	degreeID valueobject.ID,
+	version int,
+	status CurriculumStatus,
+	electiveCredits int,
+	notes string,
+	courses []PlanCourse,
+	electives []PlanElective,
+	publishedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Curriculum {
+	return &Curriculum{
+		ID:              id,
+		DegreeID:        degreeID,
+		Version:         version,
+		Status:          status,
+		ElectiveCredits: electiveCredits,
+		Notes:           notes,
+		Courses:         courses,
+		Electives:       electives,
+		PublishedAt:     publishedAt,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	}
+}
+
+// Business Logic Methods
+
+// IsPublished checks if the curriculum is already in use
+func (c *Curriculum) IsPublished() bool {
+	return c.Status == CurriculumPublished
+}
+
+// ReplacePlan sets the courses and electives of a draft curriculum
+func (c *Curriculum) ReplacePlan(degree *Degree, electiveCredits int, courses []PlanCourse, electives []PlanElective) error {
+	if c.IsPublished() {
+		return ErrCurriculumPublished
+	}
+
+	if electiveCredits < 0 {
+		return ErrNegativeCredits
+	}
+
+	seen := make(map[valueobject.ID]bool, len(courses))
+	for _, course := range courses {
+		if course.Semester < minSemester || course.Semester > degree.MaxSemester() {
+			return fmt.Errorf("%w: %s is planned in semester %d, allowed 1-%d",
+				ErrInvalidSemester, course.Code, course.Semester, degree.MaxSemester())
+		}
+		if seen[course.CourseID] {
+			return fmt.Errorf("%w: %s", ErrDuplicatedCourse, course.Code)
+		}
+		seen[course.CourseID] = true
+	}
+
+	groups := make(map[string]map[valueobject.ID]bool)
+	for i, elective := range electives {
+		group := strings.TrimSpace(elective.ElectiveGroup)
+		if group == "" {
+			return ErrEmptyElectiveGroup
+		}
+		if elective.CreditsAwarded < 0 {
+			return ErrNegativeCredits
+		}
+		if groups[group] == nil {
+			groups[group] = make(map[valueobject.ID]bool)
+		}
+		if groups[group][elective.CourseID] {
+			return fmt.Errorf("%w: %s in group %s", ErrDuplicatedCourse, elective.Code, group)
+		}
+		groups[group][elective.CourseID] = true
+		electives[i].ElectiveGroup = group
+	}
+
+	c.ElectiveCredits = electiveCredits
+	c.Courses = courses
+	c.Electives = electives
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// RequiredCredits sums the credits of the mandatory courses
+func (c *Curriculum) RequiredCredits() int {
+	total := 0
+	for _, course := range c.Courses {
+		if course.IsRequired {
+			total += course.Credits
+		}
+	}
+	return total
+}
+
+// ValidateCredits checks that required and elective credits add up to the
+// total credits of the degree
+func (c *Curriculum) ValidateCredits(degree *Degree) error {
+	if len(c.Courses) == 0 {
+		return ErrCurriculumEmpty
+	}
+
+	if total := c.RequiredCredits() + c.ElectiveCredits; total != degree.TotalCredits {
+		return fmt.Errorf("%w: required %d + elective %d = %d, degree requires %d",
+			ErrCreditsMismatch, c.RequiredCredits(), c.ElectiveCredits, total, degree.TotalCredits)
+	}
+
+	return nil
+}
+
+// Publish freezes the curriculum so new students are assigned to it
+func (c *Curriculum) Publish(degree *Degree) error {
+	if c.IsPublished() {
+		return ErrCurriculumPublished
+	}
+
+	if err := c.ValidateCredits(degree); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.Status = CurriculumPublished
+	c.PublishedAt = &now
+	c.UpdatedAt = now
+
+	return nil
+}
+
+// SemesterPlan groups the courses of a single semester
+type SemesterPlan struct {
+	Semester        int          `json:"semester"`
+	Courses         []PlanCourse `json:"courses"`
+	Credits         int          `json:"credits"`
+	RequiredCredits int          `json:"required_credits"`
+}
+
+// ElectiveGroup groups the courses of an elective group
+type ElectiveGroup struct {
+	Name    string         `json:"name"`
+	Courses []PlanElective `json:"courses"`
+}
+
+// BySemester returns the courses grouped and ordered by semester
+func (c *Curriculum) BySemester() []SemesterPlan {
+	index := make(map[int]*SemesterPlan)
+	for _, course := range c.Courses {
+		plan, ok := index[course.Semester]
+		if !ok {
+			plan = &SemesterPlan{Semester: course.Semester}
+			index[course.Semester] = plan
+		}
+		plan.Courses = append(plan.Courses, course)
+		plan.Credits += course.Credits
+		if course.IsRequired {
+			plan.RequiredCredits += course.Credits
+		}
+	}
+
+	semesters := make([]SemesterPlan, 0, len(index))
+	for _, plan := range index {
+		sort.Slice(plan.Courses, func(i, j int) bool { return plan.Courses[i].Code < plan.Courses[j].Code })
+		semesters = append(semesters, *plan)
+	}
+	sort.Slice(semesters, func(i, j int) bool { return semesters[i].Semester < semesters[j].Semester })
+
+	return semesters
+}
+
+// ElectiveGroups returns the electives grouped by elective group name
+func (c *Curriculum) ElectiveGroups() []ElectiveGroup {
+	index := make(map[string]*ElectiveGroup)
+	for _, elective := range c.Electives {
+		group, ok := index[elective.ElectiveGroup]
+		if !ok {
+			group = &ElectiveGroup{Name: elective.ElectiveGroup}
+			index[elective.ElectiveGroup] = group
+		}
+		group.Courses = append(group.Courses, elective)
+	}
+
+	groups := make([]ElectiveGroup, 0, len(index))
+	for _, group := range index {
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+
+	return groups
+}
@@ -0,0 +1,176 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func planCourse(code string, credits, semester int, required bool) domain.PlanCourse {
+	return domain.PlanCourse{CourseID: valueobject.NewID(), Code: code, Credits: credits, Semester: semester, IsRequired: required}
+}
+
+func draft(t *testing.T, degree *domain.Degree) *domain.Curriculum {
+	t.Helper()
+	c, err := domain.NewCurriculum(degree.ID, 1, 0, "")
+	if err != nil {
+		t.Fatalf("cannot create the draft: %v", err)
+	}
+	return c
+}
+
+func TestNewCurriculum(t *testing.T) {
+	tests := []struct {
+		name     string
+		degreeID valueobject.ID
+		version  int
+		credits  int
+		wantErr  bool
+	}{
+		{name: "first version", degreeID: valueobject.NewID(), version: 1},
+		{name: "later version", degreeID: valueobject.NewID(), version: 7, credits: 12},
+		{name: "no degree", version: 1, wantErr: true},
+		{name: "version zero", degreeID: valueobject.NewID(), wantErr: true},
+		{name: "negative elective credits", degreeID: valueobject.NewID(), version: 1, credits: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := domain.NewCurriculum(tt.degreeID, tt.version, tt.credits, " notes ")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want an error: %v", err, tt.wantErr)
+			}
+			if err == nil && (c.Status != domain.CurriculumDraft || c.Version != tt.version || c.Notes != "notes") {
+				t.Errorf("got %+v, want a draft of version %d", c, tt.version)
+			}
+		})
+	}
+}
+
+func TestCurriculumReplacePlan(t *testing.T) {
+	degree := &domain.Degree{ID: valueobject.NewID(), TotalCredits: 12, DurationSemesters: 8}
+	repeated := planCourse("MAT101", 4, 1, true)
+	elective := domain.PlanElective{CourseID: valueobject.NewID(), Code: "ART101", ElectiveGroup: " Humanities ", CreditsAwarded: 3}
+
+	tests := []struct {
+		name      string
+		credits   int
+		courses   []domain.PlanCourse
+		electives []domain.PlanElective
+		wantErr   error
+	}{
+		{name: "valid plan", credits: 4, courses: []domain.PlanCourse{planCourse("MAT101", 4, 1, true), planCourse("PHY101", 4, 8, true)}, electives: []domain.PlanElective{elective}},
+		{name: "negative elective credits", credits: -3, wantErr: domain.ErrNegativeCredits},
+		{name: "semester zero", courses: []domain.PlanCourse{planCourse("MAT101", 4, 0, true)}, wantErr: domain.ErrInvalidSemester},
+		{name: "semester after the degree ends", courses: []domain.PlanCourse{planCourse("MAT101", 4, 9, true)}, wantErr: domain.ErrInvalidSemester},
+		{name: "course planned twice", courses: []domain.PlanCourse{repeated, repeated}, wantErr: domain.ErrDuplicatedCourse},
+		{name: "elective without group", electives: []domain.PlanElective{{CourseID: valueobject.NewID(), ElectiveGroup: " "}}, wantErr: domain.ErrEmptyElectiveGroup},
+		{name: "elective with negative credits", electives: []domain.PlanElective{{CourseID: elective.CourseID, ElectiveGroup: "Arts", CreditsAwarded: -1}}, wantErr: domain.ErrNegativeCredits},
+		{name: "elective twice in a group", electives: []domain.PlanElective{elective, elective}, wantErr: domain.ErrDuplicatedCourse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := draft(t, degree)
+
+			err := c.ReplacePlan(degree, tt.credits, tt.courses, append([]domain.PlanElective{}, tt.electives...))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(c.Courses) != 0 || len(c.Electives) != 0 || c.ElectiveCredits != 0 {
+					t.Errorf("the plan changed on error: %+v", c)
+				}
+				return
+			}
+
+			if len(c.Courses) != len(tt.courses) || c.ElectiveCredits != tt.credits || c.Electives[0].ElectiveGroup != "Humanities" {
+				t.Errorf("the plan was not replaced: %+v", c)
+			}
+		})
+	}
+
+	t.Run("published curricula are frozen", func(t *testing.T) {
+		c := draft(t, degree)
+		if err := c.ReplacePlan(degree, 4, []domain.PlanCourse{planCourse("MAT101", 8, 1, true)}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := c.Publish(degree); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		err := c.ReplacePlan(degree, 0, []domain.PlanCourse{planCourse("PHY101", 12, 1, true)}, nil)
+		if !errors.Is(err, domain.ErrCurriculumPublished) {
+			t.Fatalf("got error %v, want %v", err, domain.ErrCurriculumPublished)
+		}
+		if c.Courses[0].Code != "MAT101" || c.ElectiveCredits != 4 {
+			t.Errorf("the published plan changed: %+v", c)
+		}
+	})
+}
+
+func TestCurriculumValidateCredits(t *testing.T) {
+	degree := &domain.Degree{ID: valueobject.NewID(), TotalCredits: 20}
+
+	tests := []struct {
+		name    string
+		credits int
+		courses []domain.PlanCourse
+		wantErr error
+	}{
+		{name: "required courses only", courses: []domain.PlanCourse{planCourse("MAT101", 12, 1, true), planCourse("PHY101", 8, 2, true)}},
+		{name: "required and elective credits", credits: 8, courses: []domain.PlanCourse{planCourse("MAT101", 12, 1, true)}},
+		{name: "optional courses do not count", credits: 8, courses: []domain.PlanCourse{planCourse("MAT101", 12, 1, true), planCourse("ART101", 4, 2, false)}},
+		{name: "one credit short", credits: 7, courses: []domain.PlanCourse{planCourse("MAT101", 12, 1, true)}, wantErr: domain.ErrCreditsMismatch},
+		{name: "one credit over", credits: 9, courses: []domain.PlanCourse{planCourse("MAT101", 12, 1, true)}, wantErr: domain.ErrCreditsMismatch},
+		{name: "no courses", credits: 20, wantErr: domain.ErrCurriculumEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := draft(t, degree)
+			if err := c.ReplacePlan(degree, tt.credits, tt.courses, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err := c.ValidateCredits(degree); !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCurriculumPublish(t *testing.T) {
+	degree := &domain.Degree{ID: valueobject.NewID(), TotalCredits: 10}
+
+	c := draft(t, degree)
+	if err := c.Publish(degree); !errors.Is(err, domain.ErrCurriculumEmpty) {
+		t.Fatalf("got error %v, want %v", err, domain.ErrCurriculumEmpty)
+	}
+
+	if err := c.ReplacePlan(degree, 0, []domain.PlanCourse{planCourse("MAT101", 8, 1, true)}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Publish(degree); !errors.Is(err, domain.ErrCreditsMismatch) {
+		t.Fatalf("got error %v, want %v", err, domain.ErrCreditsMismatch)
+	}
+	if c.IsPublished() || c.PublishedAt != nil {
+		t.Fatal("a curriculum that does not add up was published")
+	}
+
+	if err := c.ReplacePlan(degree, 2, c.Courses, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Publish(degree); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !c.IsPublished() || c.PublishedAt == nil {
+		t.Errorf("got status %s published at %v", c.Status, c.PublishedAt)
+	}
+
+	if err := c.Publish(degree); !errors.Is(err, domain.ErrCurriculumPublished) {
+		t.Errorf("got error %v publishing twice, want %v", err, domain.ErrCurriculumPublished)
+	}
+}
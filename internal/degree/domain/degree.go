@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrDegreeNotFound      = errors.New("degree does not exist")
+	ErrCourseNotFound      = errors.New("course does not exist")
+	ErrInvalidCreditLimits = errors.New("credit limits must satisfy 0 <= minimum <= maximum <= good standing maximum and a good standing average between 0 and 100")
+)
+
+type DegreeRepository interface {
+	FindByID(ctx context.Context, id valueobject.ID) (*Degree, error)
+	List(ctx context.Context, onlyActive bool) ([]*Degree, error)
+	UpdateCreditLimits(ctx context.Context, id valueobject.ID, limits CreditLimits) (err error)
+}
+
+// CourseCatalog gives access to the courses a curriculum can include
+type CourseCatalog interface {
+	FindCourses(ctx context.Context, ids []valueobject.ID) (map[valueobject.ID]Course, error)
+}
+
+// DegreeType represents the academic level of a degree
+type DegreeType string
+
+const (
+	DegreeTypeBachelor  DegreeType = "bachelor"
+	DegreeTypeMaster    DegreeType = "master"
+	DegreeTypePhD       DegreeType = "phd"
+	DegreeTypeAssociate DegreeType = "associate"
+)
+
+// Degree is an academic program offered by a department
+type Degree struct {
+	ID                valueobject.ID `json:"id"`
+	DepartmentID      valueobject.ID `json:"department_id"`
+	DepartmentCode    string         `json:"department_code"`
+	Code              string         `json:"code"`
+	Name              string         `json:"name"`
+	DegreeType        DegreeType     `json:"degree_type"`
+	TotalCredits      int            `json:"total_credits"`
+	DurationSemesters int            `json:"duration_semesters,omitempty"`
+	CreditLimits      CreditLimits   `json:"credit_limits"`
+	IsActive          bool           `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+// Course is the catalog information of a course needed to build a plan
+type Course struct {
+	ID           valueobject.ID `json:"id"`
+	DepartmentID valueobject.ID `json:"department_id"`
+	Code         string         `json:"code"`
+	Name         string         `json:"name"`
+	Credits      int            `json:"credits"`
+	IsActive     bool           `json:"is_active"`
+}
+
+// CreditLimits bound the credits a student of the degree takes per academic period
+type CreditLimits struct {
+	Minimum int `json:"minimum"`
+	Maximum int `json:"maximum"`
+	// GoodStandingMaximum replaces Maximum for students whose average final
+	// grade is at least GoodStandingAverage
+	GoodStandingMaximum int     `json:"good_standing_maximum"`
+	GoodStandingAverage float64 `json:"good_standing_average"`
+}
+
+// NewCreditLimits creates CreditLimits with validation
+func NewCreditLimits(minimum, maximum, goodStandingMaximum int, goodStandingAverage float64) (CreditLimits, error) {
+	if minimum < 0 || maximum <= 0 || minimum > maximum || maximum > goodStandingMaximum {
+		return CreditLimits{}, ErrInvalidCreditLimits
+	}
+
+	if goodStandingAverage < 0 || goodStandingAverage > 100 {
+		return CreditLimits{}, ErrInvalidCreditLimits
+	}
+
+	return CreditLimits{
+		Minimum:             minimum,
+		Maximum:             maximum,
+		GoodStandingMaximum: goodStandingMaximum,
+		GoodStandingAverage: goodStandingAverage,
+	}, nil
+}
+
+// MaxSemester returns the last semester a course can be planned in
+func (d *Degree) MaxSemester() int {
+	if d.DurationSemesters > 0 {
+		return d.DurationSemesters
+	}
+	return maxSemester
+}
@@ -0,0 +1,141 @@
+package infra
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type curriculumHandler struct {
+	interactor application.CurriculumInteractor
+}
+
+func NewCurriculumHandler(uc application.CurriculumInteractor) *curriculumHandler {
+	return &curriculumHandler{uc}
+}
+
+func (h curriculumHandler) ListDegrees(c fiber.Ctx) error {
+	data, err := h.interactor.ListDegrees(c.Context(), c.Query("active") != "false")
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h curriculumHandler) GetDegree(c fiber.Ctx) error {
+	data, err := h.interactor.GetDegree(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h curriculumHandler) UpdateCreditLimits(c fiber.Ctx) error {
+	var req application.UpdateCreditLimitsInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.UpdateCreditLimits(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h curriculumHandler) CreateCurriculum(c fiber.Ctx) error {
+	var req application.CreateCurriculumInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.CreateCurriculum(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h curriculumHandler) ListCurricula(c fiber.Ctx) error {
+	data, err := h.interactor.ListCurricula(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+// GetCurrentCurriculum returns the latest published plan of the degree
+func (h curriculumHandler) GetCurrentCurriculum(c fiber.Ctx) error {
+	data, err := h.interactor.GetCurriculum(c.Context(), c.Params("id"), 0)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h curriculumHandler) GetCurriculum(c fiber.Ctx) error {
+	version, err := versionParam(c)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.GetCurriculum(c.Context(), c.Params("id"), version)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h curriculumHandler) UpdatePlan(c fiber.Ctx) error {
+	version, err := versionParam(c)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req application.UpdatePlanInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.UpdatePlan(c.Context(), c.Params("id"), version, req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h curriculumHandler) Publish(c fiber.Ctx) error {
+	version, err := versionParam(c)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Publish(c.Context(), c.Params("id"), version)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func versionParam(c fiber.Ctx) (int, error) {
+	version, err := strconv.Atoi(c.Params("version"))
+	if err != nil || version < 1 {
+		return 0, fiber.NewError(http.StatusBadRequest, "invalid curriculum version")
+	}
+	return version, nil
+}
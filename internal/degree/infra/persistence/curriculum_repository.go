@@ -0,0 +1,329 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const curriculumColumns = `
+	id,
+	degree_id,
+	version,
+	status,
+	elective_credits,
+	COALESCE(notes, ''),
+	published_at,
+	created_at,
+	updated_at
+`
+
+type postgresCurriculumRepository struct {
+	pool *sql.DB
+}
+
+func NewCurriculumRepository(db *sql.DB) *postgresCurriculumRepository {
+	return &postgresCurriculumRepository{db}
+}
+
+func (r postgresCurriculumRepository) Create(ctx context.Context, c *domain.Curriculum) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO curricula (
+			id,
+			degree_id,
+			version,
+			status,
+			elective_credits,
+			notes,
+			published_at,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	if _, err := tx.ExecContext(ctx, query,
+		c.ID.String(),
+		c.DegreeID.String(),
+		c.Version,
+		string(c.Status),
+		c.ElectiveCredits,
+		c.Notes,
+		c.PublishedAt,
+		c.CreatedAt,
+		c.UpdatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if err := insertPlan(ctx, tx, c); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresCurriculumRepository) Save(ctx context.Context, c *domain.Curriculum) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// published rows are never touched again, the condition protects
+	// against a publish racing with a plan update
+	query := `
+		UPDATE curricula SET
+			status = $2,
+			elective_credits = $3,
+			notes = $4,
+			published_at = $5,
+			updated_at = $6
+		WHERE id = $1 AND status = 'draft'
+	`
+	result, err := tx.ExecContext(ctx, query,
+		c.ID.String(),
+		string(c.Status),
+		c.ElectiveCredits,
+		c.Notes,
+		c.PublishedAt,
+		c.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrCurriculumPublished
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM degree_courses WHERE curriculum_id = $1`, c.ID.String()); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM degree_electives WHERE curriculum_id = $1`, c.ID.String()); err != nil {
+		return err
+	}
+
+	if err := insertPlan(ctx, tx, c); err != nil {
+		return err
+	}
+
+	// students admitted before the degree had a published curriculum have
+	// none, they take the latest one
+	if c.IsPublished() {
+		backfill := `
+			UPDATE students SET
+				curriculum_id = (
+					SELECT cu.id FROM curricula cu
+					WHERE cu.degree_id = $1 AND cu.status = 'published'
+					ORDER BY cu.version DESC
+					LIMIT 1
+				),
+				updated_at = $2
+			WHERE degree_id = $1 AND curriculum_id IS NULL
+		`
+		if _, err := tx.ExecContext(ctx, backfill, c.DegreeID.String(), c.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresCurriculumRepository) FindByVersion(ctx context.Context, degreeID valueobject.ID, version int) (*domain.Curriculum, error) {
+	query := `SELECT ` + curriculumColumns + ` FROM curricula WHERE degree_id = $1 AND version = $2`
+
+	curriculum, err := scanCurriculum(r.pool.QueryRowContext(ctx, query, degreeID.String(), version))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrCurriculumNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return curriculum, r.loadPlan(ctx, curriculum)
+}
+
+func (r postgresCurriculumRepository) FindLatestPublished(ctx context.Context, degreeID valueobject.ID) (*domain.Curriculum, error) {
+	query := `
+		SELECT ` + curriculumColumns + `
+		FROM curricula
+		WHERE degree_id = $1 AND status = 'published'
+		ORDER BY version DESC
+		LIMIT 1
+	`
+	curriculum, err := scanCurriculum(r.pool.QueryRowContext(ctx, query, degreeID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNoPublishedCurricula
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return curriculum, r.loadPlan(ctx, curriculum)
+}
+
+func (r postgresCurriculumRepository) ListVersions(ctx context.Context, degreeID valueobject.ID) ([]*domain.Curriculum, error) {
+	query := `SELECT ` + curriculumColumns + ` FROM curricula WHERE degree_id = $1 ORDER BY version`
+
+	rows, err := r.pool.QueryContext(ctx, query, degreeID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var curricula []*domain.Curriculum
+	for rows.Next() {
+		curriculum, err := scanCurriculum(rows)
+		if err != nil {
+			return nil, err
+		}
+		curricula = append(curricula, curriculum)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, curriculum := range curricula {
+		if err := r.loadPlan(ctx, curriculum); err != nil {
+			return nil, err
+		}
+	}
+
+	return curricula, nil
+}
+
+func (r postgresCurriculumRepository) loadPlan(ctx context.Context, c *domain.Curriculum) error {
+	courses := `
+		SELECT c.id, c.code, c.name, COALESCE(dc.credits_required, c.credits), COALESCE(dc.semester, 1), COALESCE(dc.is_required, true)
+		FROM degree_courses dc
+		JOIN courses c ON c.id = dc.course_id
+		WHERE dc.curriculum_id = $1
+		ORDER BY dc.semester, c.code
+	`
+	rows, err := r.pool.QueryContext(ctx, courses, c.ID.String())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	c.Courses = []domain.PlanCourse{}
+	for rows.Next() {
+		var course domain.PlanCourse
+		if err := rows.Scan(&course.CourseID, &course.Code, &course.Name, &course.Credits, &course.Semester, &course.IsRequired); err != nil {
+			return err
+		}
+		c.Courses = append(c.Courses, course)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	electives := `
+		SELECT c.id, c.code, c.name, de.elective_group, COALESCE(de.credits_awarded, c.credits)
+		FROM degree_electives de
+		JOIN courses c ON c.id = de.course_id
+		WHERE de.curriculum_id = $1
+		ORDER BY de.elective_group, c.code
+	`
+	erows, err := r.pool.QueryContext(ctx, electives, c.ID.String())
+	if err != nil {
+		return err
+	}
+	defer erows.Close()
+
+	c.Electives = []domain.PlanElective{}
+	for erows.Next() {
+		var elective domain.PlanElective
+		if err := erows.Scan(&elective.CourseID, &elective.Code, &elective.Name, &elective.ElectiveGroup, &elective.CreditsAwarded); err != nil {
+			return err
+		}
+		c.Electives = append(c.Electives, elective)
+	}
+
+	return erows.Err()
+}
+
+func insertPlan(ctx context.Context, tx *sql.Tx, c *domain.Curriculum) error {
+	courseQuery := `
+		INSERT INTO degree_courses (curriculum_id, degree_id, course_id, semester, is_required, credits_required)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	for _, course := range c.Courses {
+		if _, err := tx.ExecContext(ctx, courseQuery,
+			c.ID.String(),
+			c.DegreeID.String(),
+			course.CourseID.String(),
+			course.Semester,
+			course.IsRequired,
+			course.Credits,
+		); err != nil {
+			return err
+		}
+	}
+
+	electiveQuery := `
+		INSERT INTO degree_electives (curriculum_id, degree_id, elective_group, course_id, credits_awarded)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	for _, elective := range c.Electives {
+		if _, err := tx.ExecContext(ctx, electiveQuery,
+			c.ID.String(),
+			c.DegreeID.String(),
+			elective.ElectiveGroup,
+			elective.CourseID.String(),
+			elective.CreditsAwarded,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scanCurriculum(row scanner) (*domain.Curriculum, error) {
+	var (
+		id, degreeID         valueobject.ID
+		status               string
+		version, credits     int
+		notes                string
+		publishedAt          sql.NullTime
+		createdAt, updatedAt time.Time
+	)
+
+	if err := row.Scan(&id, &degreeID, &version, &status, &credits, &notes, &publishedAt, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	var published *time.Time
+	if publishedAt.Valid {
+		published = &publishedAt.Time
+	}
+
+	return domain.CurriculumFromPersistence(
+		id,
+		degreeID,
+		version,
+		domain.CurriculumStatus(status),
+		credits,
+		notes,
+		nil,
+		nil,
+		published,
+		createdAt,
+		updatedAt,
+	), nil
+}
@@ -0,0 +1,172 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/degree/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/lib/pq"
+)
+
+const degreeColumns = `
+	d.id,
+	d.department_id,
+	dep.code,
+	d.code,
+	d.name,
+	COALESCE(d.degree_type, ''),
+	d.total_credits,
+	COALESCE(d.duration_semesters, d.duration_years * 2, 0),
+	d.min_term_credits,
+	d.max_term_credits,
+	d.good_standing_max_credits,
+	d.good_standing_average,
+	COALESCE(d.is_active, true),
+	COALESCE(d.created_at, NOW()),
+	COALESCE(d.updated_at, NOW())
+`
+
+type postgresDegreeRepository struct {
+	pool *sql.DB
+}
+
+func NewDegreeRepository(db *sql.DB) *postgresDegreeRepository {
+	return &postgresDegreeRepository{db}
+}
+
+func (r postgresDegreeRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.Degree, error) {
+	query := `
+		SELECT ` + degreeColumns + `
+		FROM degrees d
+		JOIN departments dep ON dep.id = d.department_id
+		WHERE d.id = $1
+	`
+	degree, err := scanDegree(r.pool.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDegreeNotFound
+	}
+	return degree, err
+}
+
+func (r postgresDegreeRepository) List(ctx context.Context, onlyActive bool) ([]*domain.Degree, error) {
+	query := `
+		SELECT ` + degreeColumns + `
+		FROM degrees d
+		JOIN departments dep ON dep.id = d.department_id
+		WHERE NOT $1 OR COALESCE(d.is_active, true)
+		ORDER BY dep.code, d.code
+	`
+	rows, err := r.pool.QueryContext(ctx, query, onlyActive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var degrees []*domain.Degree
+	for rows.Next() {
+		degree, err := scanDegree(rows)
+		if err != nil {
+			return nil, err
+		}
+		degrees = append(degrees, degree)
+	}
+
+	return degrees, rows.Err()
+}
+
+func (r postgresDegreeRepository) UpdateCreditLimits(ctx context.Context, id valueobject.ID, limits domain.CreditLimits) error {
+	query := `
+		UPDATE degrees SET
+			min_term_credits = $2,
+			max_term_credits = $3,
+			good_standing_max_credits = $4,
+			good_standing_average = $5,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+	result, err := r.pool.ExecContext(ctx, query,
+		id.String(),
+		limits.Minimum,
+		limits.Maximum,
+		limits.GoodStandingMaximum,
+		limits.GoodStandingAverage,
+	)
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrDegreeNotFound
+	}
+	return nil
+}
+
+func (r postgresDegreeRepository) FindCourses(ctx context.Context, ids []valueobject.ID) (map[valueobject.ID]domain.Course, error) {
+	courses := make(map[valueobject.ID]domain.Course, len(ids))
+	if len(ids) == 0 {
+		return courses, nil
+	}
+
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+
+	query := `
+		SELECT id, department_id, code, name, credits, COALESCE(is_active, true)
+		FROM courses
+		WHERE id = ANY($1::uuid[])
+	`
+	rows, err := r.pool.QueryContext(ctx, query, pq.Array(values))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var course domain.Course
+		if err := rows.Scan(&course.ID, &course.DepartmentID, &course.Code, &course.Name, &course.Credits, &course.IsActive); err != nil {
+			return nil, err
+		}
+		courses[course.ID] = course
+	}
+
+	return courses, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDegree(row scanner) (*domain.Degree, error) {
+	var (
+		degree     domain.Degree
+		degreeType string
+	)
+
+	if err := row.Scan(
+		&degree.ID,
+		&degree.DepartmentID,
+		&degree.DepartmentCode,
+		&degree.Code,
+		&degree.Name,
+		&degreeType,
+		&degree.TotalCredits,
+		&degree.DurationSemesters,
+		&degree.CreditLimits.Minimum,
+		&degree.CreditLimits.Maximum,
+		&degree.CreditLimits.GoodStandingMaximum,
+		&degree.CreditLimits.GoodStandingAverage,
+		&degree.IsActive,
+		&degree.CreatedAt,
+		&degree.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	degree.DegreeType = domain.DegreeType(degreeType)
+
+	return &degree, nil
+}
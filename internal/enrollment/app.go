@@ -0,0 +1,102 @@
+package enrollment
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/infra/persistence"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/scheduler"
+	"github.com/gofiber/fiber/v3"
+)
+
+// Config holds the enrollment module settings
+type Config struct {
+	// OfferWindow is how long a waitlisted student has to accept a seat
+	// offered to them, it defaults to 24 hours
+	OfferWindow time.Duration
+	// TicketCohortSize is how many students share a registration time
+	// ticket, it defaults to 200
+	TicketCohortSize int
+	// TicketInterval separates the start of consecutive cohorts, it
+	// defaults to 30 minutes
+	TicketInterval time.Duration
+}
+
+// Module serves the enrollments nested under the sections routes
+type Module struct {
+	name     string
+	engine   *fiber.App
+	db       *sql.DB
+	config   Config
+	notifier shared.Notifier
+	// students is the group of the student routes, it is authenticated
+	students fiber.Router
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB, config Config, notifier shared.Notifier, students fiber.Router) Module {
+	return Module{name: name, engine: engine, db: db, config: config, notifier: notifier, students: students}
+}
+
+func (mod Module) policy() application.WaitlistPolicy {
+	return application.WaitlistPolicy{OfferWindow: mod.config.OfferWindow}
+}
+
+// WaitlistJob expires the seat offers not accepted in time and offers the
+// free seats to the next students in line
+func (mod Module) WaitlistJob() scheduler.Job {
+	interactor := application.NewWaitlistInteractor(persistence.NewWaitlistRepository(mod.db), mod.notifier, mod.policy())
+
+	return func(ctx context.Context, now time.Time) error {
+		return interactor.ProcessOffers(ctx, now)
+	}
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.engine.Group(mod.name, httpx.Authenticate())
+
+	waitlist := persistence.NewWaitlistRepository(mod.db)
+
+	h := infra.NewEnrollmentHandler(application.NewEnrollmentInteractor(
+		persistence.NewEnrollmentRepository(mod.db),
+		waitlist,
+		mod.notifier,
+		mod.policy(),
+	))
+	w := infra.NewWaitlistHandler(application.NewWaitlistInteractor(waitlist, mod.notifier, mod.policy()))
+	t := infra.NewTicketHandler(application.NewTicketInteractor(
+		persistence.NewTicketRepository(mod.db),
+		mod.notifier,
+		domain.TicketPolicy{CohortSize: mod.config.TicketCohortSize, Interval: mod.config.TicketInterval},
+	))
+
+	admin := httpx.RequireRoles(shared.RoleAdmin)
+	staff := httpx.RequireRoles(shared.RoleAdmin, shared.RoleProfessor)
+	enrollees := httpx.RequireRoles(shared.RoleAdmin, shared.RoleStudent)
+
+	group.Post("/:id/enrollments", enrollees, h.Enroll)
+	group.Get("/:id/enrollments", staff, h.ListBySection)
+	group.Delete("/:id/enrollments/:studentId", enrollees, h.Drop)
+
+	group.Get("/:id/waitlist", staff, w.List)
+	group.Post("/:id/waitlist", enrollees, w.Join)
+	group.Delete("/:id/waitlist", enrollees, w.Leave)
+	group.Get("/:id/waitlist/position", enrollees, w.Position)
+	group.Post("/:id/waitlist/accept", enrollees, w.Accept)
+
+	// timetables are read from the student side
+	mod.students.Get("/:id/schedule", h.Schedule)
+	mod.students.Put("/:id/priority-groups", admin, t.SetPriorityGroups)
+
+	// time tickets are computed per academic period
+	periods := mod.engine.Group("/periods", httpx.Authenticate())
+	periods.Get("/:id/time-tickets", admin, t.List)
+	periods.Post("/:id/time-tickets", admin, t.Generate)
+	periods.Post("/:id/time-tickets/publish", admin, t.Publish)
+	periods.Get("/:id/time-tickets/:studentId", enrollees, t.Get)
+}
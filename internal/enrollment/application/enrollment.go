@@ -0,0 +1,219 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	EnrollInput struct {
+		// StudentID is only used by admins, students always enroll themselves
+		StudentID string `json:"student_id" validate:"omitempty,uuid"`
+		// IgnoreScheduleClashes lets admins enroll a student in a section
+		// that meets at the same time as another of their enrollments
+		IgnoreScheduleClashes bool `json:"ignore_schedule_clashes"`
+	}
+)
+
+type EnrollmentInteractor interface {
+	Enroll(ctx context.Context, actor shared.Actor, sectionID string, in EnrollInput) (*domain.Enrollment, error)
+	// Drop frees the seat of the student and offers it to the waitlist, past
+	// the drop deadline the enrollment is withdrawn with a W instead. Only
+	// admins can override the withdrawal deadline.
+	Drop(ctx context.Context, actor shared.Actor, sectionID, studentID string, override bool) (*domain.Enrollment, error)
+	ListBySection(ctx context.Context, sectionID string) ([]*domain.Enrollment, error)
+	// Schedule returns the weekly timetable of the student in the period,
+	// the active period is used when none is given
+	Schedule(ctx context.Context, actor shared.Actor, studentID, periodID string) (domain.Timetable, error)
+}
+
+type enrollmentInteractor struct {
+	repository domain.EnrollmentRepository
+	promoter   promoter
+	now        func() time.Time
+}
+
+func NewEnrollmentInteractor(r domain.EnrollmentRepository, w domain.WaitlistRepository, n shared.Notifier, p WaitlistPolicy) *enrollmentInteractor {
+	return &enrollmentInteractor{r, newPromoter(w, n, p), time.Now}
+}
+
+func (interactor enrollmentInteractor) Enroll(ctx context.Context, actor shared.Actor, sectionID string, in EnrollInput) (*domain.Enrollment, error) {
+	section, student, err := sectionAndStudent(actor, sectionID, in.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment := domain.NewEnrollment(student, section, interactor.now())
+
+	if in.IgnoreScheduleClashes {
+		if !actor.IsAdmin() {
+			return nil, shared.ErrForbiddenWith(shared.ErrForbidden, "only admins can override schedule clashes")
+		}
+		enrollment.OverrideClashes(actor.ID)
+	}
+
+	if err := interactor.repository.Enroll(ctx, enrollment); err != nil {
+		return nil, mapError(err)
+	}
+
+	return enrollment, nil
+}
+
+func (interactor enrollmentInteractor) Drop(ctx context.Context, actor shared.Actor, sectionID, studentID string, override bool) (*domain.Enrollment, error) {
+	section, student, err := sectionAndStudent(actor, sectionID, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := domain.DropRequest{SectionID: section, StudentID: student, Now: interactor.now()}
+	if override {
+		if !actor.IsAdmin() {
+			return nil, shared.ErrForbiddenWith(shared.ErrForbidden, "only admins can override the withdrawal deadline")
+		}
+		req.OverrideBy = &actor.ID
+	}
+
+	now := req.Now
+	enrollment, err := interactor.repository.Drop(ctx, req)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	interactor.promoter.promote(ctx, section, now)
+
+	return enrollment, nil
+}
+
+func (interactor enrollmentInteractor) ListBySection(ctx context.Context, sectionID string) ([]*domain.Enrollment, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	enrollments, err := interactor.repository.ListBySection(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if enrollments == nil {
+		enrollments = []*domain.Enrollment{}
+	}
+
+	return enrollments, nil
+}
+
+func (interactor enrollmentInteractor) Schedule(ctx context.Context, actor shared.Actor, studentID, periodID string) (domain.Timetable, error) {
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return domain.Timetable{}, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	if actor.IsStudent() && !actor.ID.Equals(student) {
+		return domain.Timetable{}, shared.ErrForbiddenWith(shared.ErrForbidden, "students can only see their own schedule")
+	}
+
+	var period valueobject.ID
+	if periodID == "" {
+		if period, err = interactor.repository.CurrentPeriod(ctx); err != nil {
+			return domain.Timetable{}, mapError(err)
+		}
+	} else if period, err = valueobject.IDFromString(periodID); err != nil {
+		return domain.Timetable{}, shared.ErrInvalidInputWith(err, "invalid period id")
+	}
+
+	sections, err := interactor.repository.ListSchedule(ctx, student, period)
+	if err != nil {
+		return domain.Timetable{}, mapError(err)
+	}
+
+	return domain.NewTimetable(student, period, sections), nil
+}
+
+// studentFor resolves the student the request is about, admins act on
+// behalf of any student
+func studentFor(actor shared.Actor, requested string) (valueobject.ID, error) {
+	if actor.IsStudent() {
+		if requested != "" && requested != actor.ID.String() {
+			return valueobject.ID{}, shared.ErrForbiddenWith(shared.ErrForbidden, "students can only act on their own enrollments")
+		}
+		return actor.ID, nil
+	}
+
+	if !actor.IsAdmin() {
+		return valueobject.ID{}, shared.ErrForbiddenWith(shared.ErrForbidden, "only students and admins can manage enrollments")
+	}
+
+	student, err := valueobject.IDFromString(requested)
+	if err != nil {
+		return valueobject.ID{}, shared.ErrInvalidInputWith(err, "student_id is required")
+	}
+	return student, nil
+}
+
+// sectionAndStudent parses the section and resolves the student of the request
+func sectionAndStudent(actor shared.Actor, sectionID, studentID string) (valueobject.ID, valueobject.ID, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return valueobject.ID{}, valueobject.ID{}, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	student, err := studentFor(actor, studentID)
+	if err != nil {
+		return valueobject.ID{}, valueobject.ID{}, err
+	}
+
+	return section, student, nil
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var (
+		appErr  *shared.AppError
+		missing *domain.MissingRequirementsError
+		clash   *domain.ScheduleClashError
+		load    *domain.CreditLoadError
+		holds   *domain.RegistrationHoldError
+		ticket  *domain.TicketError
+	)
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.As(err, &missing):
+		return shared.ErrConflictWith(err, domain.ErrRequirementsNotMet.Error()).WithDetails(missing.Missing)
+	case errors.As(err, &holds):
+		return shared.ErrConflictWith(err, domain.ErrRegistrationHold.Error()).WithDetails(holds.Holds)
+	case errors.As(err, &ticket):
+		return shared.ErrConflictWith(err, err.Error()).WithDetails(ticket)
+	case errors.As(err, &load):
+		return shared.ErrConflictWith(err, load.Unwrap().Error()).WithDetails(load)
+	case errors.As(err, &clash):
+		return shared.ErrConflictWith(err, domain.ErrScheduleClash.Error()).WithDetails(clash.Clashes)
+	case errors.Is(err, domain.ErrEnrollmentNotFound),
+		errors.Is(err, domain.ErrSectionNotFound),
+		errors.Is(err, domain.ErrStudentNotFound),
+		errors.Is(err, domain.ErrNoActivePeriod),
+		errors.Is(err, domain.ErrNotEnrolled),
+		errors.Is(err, domain.ErrNotWaitlisted):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrAlreadyEnrolled),
+		errors.Is(err, domain.ErrSectionFull),
+		errors.Is(err, domain.ErrSectionInactive),
+		errors.Is(err, domain.ErrRegistrationClosed),
+		errors.Is(err, domain.ErrWithdrawalClosed),
+		errors.Is(err, domain.ErrStudentNotActive),
+		errors.Is(err, domain.ErrAlreadyWaitlisted),
+		errors.Is(err, domain.ErrSeatsAvailable),
+		errors.Is(err, domain.ErrNoOffer),
+		errors.Is(err, domain.ErrOfferExpired):
+		return shared.ErrConflictWith(err, err.Error())
+	case errors.Is(err, domain.ErrInvalidSchedule):
+		return shared.ErrConflictWith(err, domain.ErrInvalidSchedule.Error())
+	default:
+		return shared.ErrInternalWith(err, "cannot process enrollment")
+	}
+}
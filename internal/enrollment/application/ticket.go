@@ -0,0 +1,234 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	defaultCohortSize     = 200
+	defaultTicketInterval = 30 * time.Minute
+)
+
+type (
+	GenerateTicketsInput struct {
+		// Publish makes the tickets binding right away
+		Publish bool `json:"publish"`
+	}
+
+	PriorityGroupsInput struct {
+		Groups []string `json:"groups" validate:"max=10,dive,required,max=30"`
+	}
+
+	// TicketBatch summarizes the tickets of a period after a batch run
+	TicketBatch struct {
+		PeriodID  valueobject.ID `json:"academic_period_id"`
+		Tickets   int            `json:"tickets"`
+		Published int            `json:"published"`
+		// FirstStart and LastStart are nil when no active student got a ticket
+		FirstStart *time.Time `json:"first_start,omitempty"`
+		LastStart  *time.Time `json:"last_start,omitempty"`
+	}
+)
+
+type TicketInteractor interface {
+	// Generate ranks the active students and assigns the time tickets of
+	// the period, replacing the previous ones
+	Generate(ctx context.Context, periodID string, in GenerateTicketsInput) (TicketBatch, error)
+	// Publish makes the tickets of the period binding and lets the students know
+	Publish(ctx context.Context, periodID string) (TicketBatch, error)
+	List(ctx context.Context, periodID string) ([]*domain.TimeTicket, error)
+	Get(ctx context.Context, actor shared.Actor, periodID, studentID string) (*domain.TimeTicket, error)
+	SetPriorityGroups(ctx context.Context, studentID string, in PriorityGroupsInput) ([]string, error)
+}
+
+type ticketInteractor struct {
+	repository domain.TicketRepository
+	notifier   shared.Notifier
+	policy     domain.TicketPolicy
+	now        func() time.Time
+}
+
+func NewTicketInteractor(r domain.TicketRepository, n shared.Notifier, p domain.TicketPolicy) *ticketInteractor {
+	if p.CohortSize <= 0 {
+		p.CohortSize = defaultCohortSize
+	}
+	if p.Interval <= 0 {
+		p.Interval = defaultTicketInterval
+	}
+	return &ticketInteractor{r, n, p, time.Now}
+}
+
+func (interactor ticketInteractor) Generate(ctx context.Context, periodID string, in GenerateTicketsInput) (TicketBatch, error) {
+	id, err := valueobject.IDFromString(periodID)
+	if err != nil {
+		return TicketBatch{}, shared.ErrInvalidInputWith(err, "invalid period id")
+	}
+
+	period, err := interactor.repository.TicketPeriod(ctx, id)
+	if err != nil {
+		return TicketBatch{}, ticketError(err)
+	}
+
+	now := interactor.now()
+	if err := period.CanAssignTickets(now); err != nil {
+		return TicketBatch{}, ticketError(err)
+	}
+
+	candidates, err := interactor.repository.Candidates(ctx)
+	if err != nil {
+		return TicketBatch{}, ticketError(err)
+	}
+
+	tickets := domain.AssignTickets(period, candidates, interactor.policy, now)
+	if err := interactor.repository.Replace(ctx, period.ID, tickets); err != nil {
+		return TicketBatch{}, ticketError(err)
+	}
+
+	batch := newTicketBatch(period.ID, tickets)
+	if in.Publish && len(tickets) > 0 {
+		published, err := interactor.publish(ctx, period.ID, now)
+		if err != nil {
+			return batch, err
+		}
+		batch.Published = published
+	}
+
+	return batch, nil
+}
+
+func (interactor ticketInteractor) Publish(ctx context.Context, periodID string) (TicketBatch, error) {
+	id, err := valueobject.IDFromString(periodID)
+	if err != nil {
+		return TicketBatch{}, shared.ErrInvalidInputWith(err, "invalid period id")
+	}
+
+	published, err := interactor.publish(ctx, id, interactor.now())
+	if err != nil {
+		return TicketBatch{}, err
+	}
+
+	tickets, err := interactor.repository.ListByPeriod(ctx, id)
+	if err != nil {
+		return TicketBatch{}, ticketError(err)
+	}
+
+	batch := newTicketBatch(id, tickets)
+	batch.Published = published
+	return batch, nil
+}
+
+// publish returns how many tickets were published by this call
+func (interactor ticketInteractor) publish(ctx context.Context, periodID valueobject.ID, now time.Time) (int, error) {
+	published, err := interactor.repository.Publish(ctx, periodID, now)
+	if err != nil {
+		return 0, ticketError(err)
+	}
+
+	for _, t := range published {
+		interactor.notify(ctx, t)
+	}
+
+	return len(published), nil
+}
+
+func (interactor ticketInteractor) List(ctx context.Context, periodID string) ([]*domain.TimeTicket, error) {
+	id, err := valueobject.IDFromString(periodID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid period id")
+	}
+
+	tickets, err := interactor.repository.ListByPeriod(ctx, id)
+	if err != nil {
+		return nil, ticketError(err)
+	}
+
+	if tickets == nil {
+		tickets = []*domain.TimeTicket{}
+	}
+
+	return tickets, nil
+}
+
+func (interactor ticketInteractor) Get(ctx context.Context, actor shared.Actor, periodID, studentID string) (*domain.TimeTicket, error) {
+	period, err := valueobject.IDFromString(periodID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid period id")
+	}
+
+	student, err := studentFor(actor, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := interactor.repository.FindPublished(ctx, period, student)
+	if err != nil {
+		return nil, ticketError(err)
+	}
+
+	return ticket, nil
+}
+
+func (interactor ticketInteractor) SetPriorityGroups(ctx context.Context, studentID string, in PriorityGroupsInput) ([]string, error) {
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	groups, err := domain.NormalizePriorityGroups(in.Groups)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.SetPriorityGroups(ctx, student, groups); err != nil {
+		return nil, ticketError(err)
+	}
+
+	return groups, nil
+}
+
+func (interactor ticketInteractor) notify(ctx context.Context, t *domain.TimeTicket) {
+	if interactor.notifier == nil {
+		return
+	}
+	// the ticket is binding even if the student wasn't reached, they can
+	// still look it up
+	_ = interactor.notifier.Notify(ctx, shared.Notification{
+		UserID:  t.StudentID,
+		Subject: "Your registration time ticket",
+		Body:    fmt.Sprintf("You can start enrolling in the sections of the next period on %s.", t.StartsAt.Format(time.RFC1123)),
+	})
+}
+
+func newTicketBatch(periodID valueobject.ID, tickets []*domain.TimeTicket) TicketBatch {
+	batch := TicketBatch{PeriodID: periodID, Tickets: len(tickets)}
+	for _, t := range tickets {
+		startsAt := t.StartsAt
+		if batch.FirstStart == nil || startsAt.Before(*batch.FirstStart) {
+			batch.FirstStart = &startsAt
+		}
+		if batch.LastStart == nil || startsAt.After(*batch.LastStart) {
+			batch.LastStart = &startsAt
+		}
+	}
+	return batch
+}
+
+func ticketError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPeriodNotFound),
+		errors.Is(err, domain.ErrTicketNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrRegistrationStarted),
+		errors.Is(err, domain.ErrNoTickets):
+		return shared.ErrConflictWith(err, err.Error())
+	default:
+		return mapError(err)
+	}
+}
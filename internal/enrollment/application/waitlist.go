@@ -0,0 +1,197 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const defaultOfferWindow = 24 * time.Hour
+
+// WaitlistPolicy holds the configurable rules of the waitlists
+type WaitlistPolicy struct {
+	// OfferWindow is how long a promoted student has to accept the seat
+	OfferWindow time.Duration
+}
+
+type WaitlistInteractor interface {
+	Join(ctx context.Context, actor shared.Actor, sectionID string, in EnrollInput) (*domain.WaitlistEntry, error)
+	Leave(ctx context.Context, actor shared.Actor, sectionID, studentID string) error
+	Accept(ctx context.Context, actor shared.Actor, sectionID string, in EnrollInput) (*domain.Enrollment, error)
+	Position(ctx context.Context, actor shared.Actor, sectionID, studentID string) (*domain.WaitlistEntry, error)
+	List(ctx context.Context, sectionID string) ([]*domain.WaitlistEntry, error)
+	// ProcessOffers expires the offers not accepted in time and offers the
+	// free seats to the next students in line
+	ProcessOffers(ctx context.Context, now time.Time) error
+}
+
+type waitlistInteractor struct {
+	repository domain.WaitlistRepository
+	promoter   promoter
+	now        func() time.Time
+}
+
+func NewWaitlistInteractor(r domain.WaitlistRepository, n shared.Notifier, p WaitlistPolicy) *waitlistInteractor {
+	return &waitlistInteractor{r, newPromoter(r, n, p), time.Now}
+}
+
+func (interactor waitlistInteractor) Join(ctx context.Context, actor shared.Actor, sectionID string, in EnrollInput) (*domain.WaitlistEntry, error) {
+	section, student, err := sectionAndStudent(actor, sectionID, in.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := domain.NewWaitlistEntry(student, section, interactor.now())
+	if err := interactor.repository.Join(ctx, entry); err != nil {
+		return nil, mapError(err)
+	}
+
+	// the entry is read back to report its position
+	joined, err := interactor.repository.FindActive(ctx, section, student)
+	if err != nil {
+		return entry, nil
+	}
+	return joined, nil
+}
+
+func (interactor waitlistInteractor) Leave(ctx context.Context, actor shared.Actor, sectionID, studentID string) error {
+	section, student, err := sectionAndStudent(actor, sectionID, studentID)
+	if err != nil {
+		return err
+	}
+
+	now := interactor.now()
+	entry, err := interactor.repository.Leave(ctx, section, student, now)
+	if err != nil {
+		return mapError(err)
+	}
+
+	// a declined offer frees the seat for the next student in line
+	if entry.Status == domain.WaitlistDeclined {
+		interactor.promoter.promote(ctx, section, now)
+	}
+
+	return nil
+}
+
+func (interactor waitlistInteractor) Accept(ctx context.Context, actor shared.Actor, sectionID string, in EnrollInput) (*domain.Enrollment, error) {
+	section, student, err := sectionAndStudent(actor, sectionID, in.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := interactor.repository.Accept(ctx, section, student, interactor.now())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return enrollment, nil
+}
+
+func (interactor waitlistInteractor) Position(ctx context.Context, actor shared.Actor, sectionID, studentID string) (*domain.WaitlistEntry, error) {
+	section, student, err := sectionAndStudent(actor, sectionID, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := interactor.repository.FindActive(ctx, section, student)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return entry, nil
+}
+
+func (interactor waitlistInteractor) List(ctx context.Context, sectionID string) ([]*domain.WaitlistEntry, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	entries, err := interactor.repository.ListBySection(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if entries == nil {
+		entries = []*domain.WaitlistEntry{}
+	}
+
+	return entries, nil
+}
+
+func (interactor waitlistInteractor) ProcessOffers(ctx context.Context, now time.Time) error {
+	expired, err := interactor.repository.ExpireOffers(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range expired {
+		// offers still in time were withdrawn because registration closed
+		if entry.OfferExpiresAt != nil && entry.OfferExpiresAt.After(now) {
+			interactor.promoter.notify(ctx, entry.StudentID,
+				"Your waitlist offer was withdrawn",
+				fmt.Sprintf("Registration for section %s closed before the seat offered to you was accepted.", entry.SectionID))
+			continue
+		}
+
+		interactor.promoter.notify(ctx, entry.StudentID,
+			"Your waitlist offer expired",
+			fmt.Sprintf("The seat offered to you in section %s was not accepted in time and went to the next student.", entry.SectionID))
+	}
+
+	sections, err := interactor.repository.SectionsWithWaitlist(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, section := range sections {
+		interactor.promoter.promote(ctx, section, now)
+	}
+
+	return nil
+}
+
+// promoter offers free seats to waitlisted students and lets them know
+type promoter struct {
+	repository domain.WaitlistRepository
+	notifier   shared.Notifier
+	window     time.Duration
+}
+
+func newPromoter(r domain.WaitlistRepository, n shared.Notifier, p WaitlistPolicy) promoter {
+	window := p.OfferWindow
+	if window <= 0 {
+		window = defaultOfferWindow
+	}
+	return promoter{r, n, window}
+}
+
+// promote is best effort, seats that can't be offered now are picked up by
+// the next ProcessOffers run
+func (p promoter) promote(ctx context.Context, sectionID valueobject.ID, now time.Time) {
+	offers, err := p.repository.OfferSeats(ctx, sectionID, now, p.window)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range offers {
+		p.notify(ctx, entry.StudentID,
+			"A seat is available for you",
+			fmt.Sprintf("A seat in section %s is reserved for you until %s, accept it before then to enroll.",
+				entry.SectionID, entry.OfferExpiresAt.Format(time.RFC1123)))
+	}
+}
+
+func (p promoter) notify(ctx context.Context, studentID valueobject.ID, subject, body string) {
+	if p.notifier == nil {
+		return
+	}
+	// a failed notification doesn't undo the offer, the student can still
+	// see it when querying the waitlist
+	_ = p.notifier.Notify(ctx, shared.Notification{UserID: studentID, Subject: subject, Body: body})
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrCreditLimitExceeded = errors.New("enrollment exceeds the maximum credit load of the period")
+	ErrBelowMinimumLoad    = errors.New("drop leaves the student below the minimum credit load of the period")
+)
+
+// CreditLimits bound the credits a student takes per academic period, they
+// come from the degree of the student
+type CreditLimits struct {
+	Minimum             int
+	Maximum             int
+	GoodStandingMaximum int
+	GoodStandingAverage float64
+}
+
+// CreditLoad is the credits a student holds in an academic period and what
+// is left before reaching the limit
+type CreditLoad struct {
+	PeriodID     valueobject.ID `json:"academic_period_id"`
+	Credits      int            `json:"credits"`
+	Minimum      int            `json:"minimum"`
+	Maximum      int            `json:"maximum"`
+	Remaining    int            `json:"remaining"`
+	GoodStanding bool           `json:"good_standing"`
+	BelowMinimum bool           `json:"below_minimum"`
+}
+
+// NewCreditLoad applies the limits to the credits of the student, average
+// is the credit weighted final grade and nil when nothing was graded yet
+func NewCreditLoad(periodID valueobject.ID, credits int, limits CreditLimits, average *float64) CreditLoad {
+	load := CreditLoad{
+		PeriodID: periodID,
+		Credits:  credits,
+		Minimum:  limits.Minimum,
+		Maximum:  limits.Maximum,
+	}
+
+	if average != nil && *average >= limits.GoodStandingAverage {
+		load.GoodStanding = true
+		load.Maximum = max(limits.GoodStandingMaximum, limits.Maximum)
+	}
+
+	return load.with(0)
+}
+
+// with returns the load after adding credits, negative values remove them
+func (l CreditLoad) with(credits int) CreditLoad {
+	l.Credits = max(l.Credits+credits, 0)
+	l.Remaining = max(l.Maximum-l.Credits, 0)
+	l.BelowMinimum = l.Credits < l.Minimum
+	return l
+}
+
+// CreditLoadError carries the load that blocks an enrollment or a drop
+type CreditLoadError struct {
+	err     error
+	Load    CreditLoad `json:"credit_load"`
+	Credits int        `json:"section_credits"`
+}
+
+func (e *CreditLoadError) Error() string {
+	return fmt.Sprintf("%s: holds %d of %d credits, the section has %d", e.err, e.Load.Credits, e.Load.Maximum, e.Credits)
+}
+
+func (e *CreditLoadError) Unwrap() error {
+	return e.err
+}
+
+// CheckCreditLoad verifies that the section credits fit in the allowance of the student
+func (a Admission) CheckCreditLoad() error {
+	if a.Load.Credits+a.SectionCredits > a.Load.Maximum {
+		return &CreditLoadError{err: ErrCreditLimitExceeded, Load: a.Load, Credits: a.SectionCredits}
+	}
+	return nil
+}
+
+// CheckDrop verifies that dropping the section doesn't take a student that
+// meets the minimum load below it. Dropping every course of the period is
+// always allowed.
+func (a Admission) CheckDrop() error {
+	after := a.Load.with(-a.SectionCredits)
+	if !a.Load.BelowMinimum && after.BelowMinimum && after.Credits > 0 {
+		return &CreditLoadError{err: ErrBelowMinimumLoad, Load: a.Load, Credits: a.SectionCredits}
+	}
+	return nil
+}
+
+// LoadAfterEnroll returns the load of the student once the section is taken
+func (a Admission) LoadAfterEnroll() CreditLoad {
+	return a.Load.with(a.SectionCredits)
+}
+
+// LoadAfterDrop returns the load of the student once the section is dropped
+func (a Admission) LoadAfterDrop() CreditLoad {
+	return a.Load.with(-a.SectionCredits)
+}
@@ -0,0 +1,117 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// limits allow 12 to 18 credits, 21 with an average of 85 or more
+var limits = domain.CreditLimits{Minimum: 12, Maximum: 18, GoodStandingMaximum: 21, GoodStandingAverage: 85}
+
+func average(v float64) *float64 {
+	return &v
+}
+
+func TestNewCreditLoad(t *testing.T) {
+	tests := []struct {
+		name          string
+		credits       int
+		limits        domain.CreditLimits
+		average       *float64
+		wantMaximum   int
+		wantRemaining int
+		wantGood      bool
+		wantBelow     bool
+	}{
+		{name: "nothing graded yet", credits: 12, limits: limits, wantMaximum: 18, wantRemaining: 6},
+		{name: "average under good standing", credits: 12, limits: limits, average: average(84.99), wantMaximum: 18, wantRemaining: 6},
+		{name: "average at good standing", credits: 12, limits: limits, average: average(85), wantMaximum: 21, wantRemaining: 9, wantGood: true},
+		{name: "good standing never lowers the maximum", credits: 12, limits: domain.CreditLimits{Minimum: 12, Maximum: 18, GoodStandingMaximum: 15, GoodStandingAverage: 85}, average: average(90), wantMaximum: 18, wantRemaining: 6, wantGood: true},
+		{name: "one credit under the minimum", credits: 11, limits: limits, wantMaximum: 18, wantRemaining: 7, wantBelow: true},
+		{name: "at the maximum", credits: 18, limits: limits, wantMaximum: 18},
+		{name: "over the maximum", credits: 19, limits: limits, wantMaximum: 18},
+		{name: "no credits", limits: limits, wantMaximum: 18, wantRemaining: 18, wantBelow: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			load := domain.NewCreditLoad(valueobject.NewID(), tt.credits, tt.limits, tt.average)
+
+			if load.Credits != tt.credits || load.Minimum != tt.limits.Minimum {
+				t.Errorf("got %d credits with minimum %d, want %d with minimum %d", load.Credits, load.Minimum, tt.credits, tt.limits.Minimum)
+			}
+			if load.Maximum != tt.wantMaximum || load.Remaining != tt.wantRemaining {
+				t.Errorf("got maximum %d with %d remaining, want %d with %d", load.Maximum, load.Remaining, tt.wantMaximum, tt.wantRemaining)
+			}
+			if load.GoodStanding != tt.wantGood || load.BelowMinimum != tt.wantBelow {
+				t.Errorf("got good standing %v and below minimum %v, want %v and %v", load.GoodStanding, load.BelowMinimum, tt.wantGood, tt.wantBelow)
+			}
+		})
+	}
+}
+
+func TestAdmissionCheckCreditLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		credits int
+		average *float64
+		want    error
+	}{
+		{name: "well under the maximum", credits: 12},
+		{name: "reaches the maximum", credits: 15},
+		{name: "one credit over the maximum", credits: 16, want: domain.ErrCreditLimitExceeded},
+		{name: "good standing raises the maximum", credits: 16, average: average(85)},
+		{name: "reaches the good standing maximum", credits: 18, average: average(85)},
+		{name: "one credit over the good standing maximum", credits: 19, average: average(85), want: domain.ErrCreditLimitExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := domain.Admission{SectionCredits: 3, Load: domain.NewCreditLoad(valueobject.NewID(), tt.credits, limits, tt.average)}
+
+			err := a.CheckCreditLoad()
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+
+			var loadErr *domain.CreditLoadError
+			if err != nil && (!errors.As(err, &loadErr) || loadErr.Credits != 3 || loadErr.Load != a.Load) {
+				t.Errorf("got %+v, want the load and section credits", loadErr)
+			}
+			if err == nil && a.LoadAfterEnroll().Credits != tt.credits+3 {
+				t.Errorf("got %d credits after enrolling, want %d", a.LoadAfterEnroll().Credits, tt.credits+3)
+			}
+		})
+	}
+}
+
+func TestAdmissionCheckDrop(t *testing.T) {
+	tests := []struct {
+		name          string
+		credits       int
+		want          error
+		wantRemaining int
+	}{
+		{name: "stays over the minimum", credits: 16, wantRemaining: 5},
+		{name: "lands on the minimum", credits: 15, wantRemaining: 6},
+		{name: "one credit under the minimum", credits: 14, want: domain.ErrBelowMinimumLoad},
+		{name: "already under the minimum", credits: 9, wantRemaining: 12},
+		{name: "drops the last course", credits: 3, wantRemaining: 18},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := domain.Admission{SectionCredits: 3, Load: domain.NewCreditLoad(valueobject.NewID(), tt.credits, limits, nil)}
+
+			if err := a.CheckDrop(); !errors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && a.LoadAfterDrop().Remaining != tt.wantRemaining {
+				t.Errorf("got %d credits remaining after the drop, want %d", a.LoadAfterDrop().Remaining, tt.wantRemaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	section "github.com/Jose-Salazar-27/go-university-server/internal/section/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrEnrollmentNotFound = errors.New("enrollment does not exist")
+	ErrSectionNotFound    = errors.New("course section does not exist")
+	ErrStudentNotFound    = errors.New("student does not exist")
+	ErrAlreadyEnrolled    = errors.New("student is already enrolled in this section")
+	ErrSectionFull        = errors.New("section has no seats left")
+	ErrSectionInactive    = errors.New("section is not open for enrollment")
+	ErrRegistrationClosed = errors.New("registration is closed for the section period")
+	ErrStudentNotActive   = errors.New("only active students can enroll")
+	ErrNotEnrolled        = errors.New("student is not enrolled in this section")
+	ErrWithdrawalClosed   = errors.New("the withdrawal deadline of the period has passed")
+)
+
+type EnrollmentRepository interface {
+	// Enroll reads the Admission of the student and section under a lock,
+	// checks it and stores the enrollment, so concurrent requests can't
+	// take more seats than the section capacity
+	Enroll(ctx context.Context, e *Enrollment) (err error)
+	// Drop drops or withdraws the student from the section depending on
+	// the deadlines of the period
+	Drop(ctx context.Context, req DropRequest) (*Enrollment, error)
+	FindByID(ctx context.Context, id valueobject.ID) (*Enrollment, error)
+	ListBySection(ctx context.Context, sectionID valueobject.ID) ([]*Enrollment, error)
+	// ListSchedule returns the sections the student is enrolled in during the period
+	ListSchedule(ctx context.Context, studentID, periodID valueobject.ID) ([]ScheduledSection, error)
+	// CurrentPeriod returns the active academic period, or ErrNoActivePeriod
+	CurrentPeriod(ctx context.Context) (valueobject.ID, error)
+}
+
+// Status is the state of a student in a section
+type Status string
+
+const (
+	StatusEnrolled Status = "enrolled"
+	// StatusDropped enrollments were left before the drop deadline and are
+	// kept out of transcripts
+	StatusDropped Status = "dropped"
+	// StatusWithdrawn enrollments were left after the drop deadline and
+	// show a W on transcripts
+	StatusWithdrawn Status = "withdrawn"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+// WithdrawalMark is the letter grade of withdrawn enrollments
+const WithdrawalMark = "W"
+
+// IsValid checks if the status is valid
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusEnrolled, StatusDropped, StatusWithdrawn, StatusCompleted, StatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// TakesSeat checks if an enrollment in this status counts against the capacity
+func (s Status) TakesSeat() bool {
+	return s == StatusEnrolled
+}
+
+// Enrollment is the registration of a student in a course section
+type Enrollment struct {
+	ID             valueobject.ID `json:"id"`
+	StudentID      valueobject.ID `json:"student_id"`
+	SectionID      valueobject.ID `json:"course_section_id"`
+	EnrollmentDate time.Time      `json:"enrollment_date"`
+	Status         Status         `json:"status"`
+	FinalGrade     *float64       `json:"final_grade,omitempty"`
+	LetterGrade    string         `json:"letter_grade,omitempty"`
+	CreditsEarned  *int           `json:"credits_earned,omitempty"`
+	// ClashOverrideBy is the admin that allowed a schedule clash
+	ClashOverrideBy *valueobject.ID `json:"clash_override_by,omitempty"`
+	// DropOverrideBy is the admin that allowed a withdrawal after the deadline
+	DropOverrideBy *valueobject.ID `json:"drop_override_by,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	// CreditLoad is the load of the student in the period right after the
+	// enrollment changed, it is not stored
+	CreditLoad *CreditLoad `json:"credit_load,omitempty"`
+}
+
+// NewEnrollment creates an enrollment of the student in the section on the given day
+func NewEnrollment(studentID, sectionID valueobject.ID, now time.Time) *Enrollment {
+	return &Enrollment{
+		ID:             valueobject.NewID(),
+		StudentID:      studentID,
+		SectionID:      sectionID,
+		EnrollmentDate: truncateToDate(now),
+		Status:         StatusEnrolled,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// EnrollmentFromPersistence creates an Enrollment instance from database records
+func EnrollmentFromPersistence(
+	id valueobject.ID,
+	studentID valueobject.ID,
+	sectionID valueobject.ID,
+	enrollmentDate time.Time,
+	status Status,
+	finalGrade *float64,
+	letterGrade string,
+	creditsEarned *int,
+	clashOverrideBy *valueobject.ID,
+	dropOverrideBy *valueobject.ID,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Enrollment {
+	return &Enrollment{
+		ID:              id,
+		StudentID:       studentID,
+		SectionID:       sectionID,
+		EnrollmentDate:  enrollmentDate,
+		Status:          status,
+		FinalGrade:      finalGrade,
+		LetterGrade:     letterGrade,
+		CreditsEarned:   creditsEarned,
+		ClashOverrideBy: clashOverrideBy,
+		DropOverrideBy:  dropOverrideBy,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	}
+}
+
+// OverrideClashes records the admin that allows the enrollment to clash
+// with the schedule of other enrollments
+func (e *Enrollment) OverrideClashes(adminID valueobject.ID) {
+	e.ClashOverrideBy = &adminID
+}
+
+// DropRequest asks to free the seat of a student, OverrideBy is the admin
+// allowing it after the withdrawal deadline
+type DropRequest struct {
+	SectionID  valueobject.ID
+	StudentID  valueobject.ID
+	Now        time.Time
+	OverrideBy *valueobject.ID
+}
+
+// Drop frees the seat of the student. Until the drop deadline the enrollment
+// is dropped, until the withdrawal deadline it is withdrawn with a W, and
+// later only an admin override withdraws it.
+func (e *Enrollment) Drop(now time.Time, w RegistrationWindow, overrideBy *valueobject.ID) error {
+	if e.Status != StatusEnrolled {
+		return ErrNotEnrolled
+	}
+
+	today := truncateToDate(now)
+	switch {
+	case !today.After(truncateToDate(w.DropDeadline)):
+		e.Status = StatusDropped
+	case !today.After(truncateToDate(w.WithdrawalDeadline)):
+		e.Status = StatusWithdrawn
+		e.LetterGrade = WithdrawalMark
+	case overrideBy != nil:
+		e.Status = StatusWithdrawn
+		e.LetterGrade = WithdrawalMark
+		e.DropOverrideBy = overrideBy
+	default:
+		return ErrWithdrawalClosed
+	}
+
+	e.UpdatedAt = now
+	return nil
+}
+
+// PeriodRegistrationOpen is the academic period status that accepts enrollments
+const PeriodRegistrationOpen = "registration_open"
+
+// PeriodInProgress is the academic period status during classes, late adds
+// are accepted until the add deadline
+const PeriodInProgress = "in_progress"
+
+// RegistrationWindow is the enrollment calendar of the section period
+type RegistrationWindow struct {
+	PeriodID     valueobject.ID
+	PeriodStatus string
+	Start        time.Time
+	// End is the last day enrollments are accepted, the add deadline of the period
+	End                time.Time
+	DropDeadline       time.Time
+	WithdrawalDeadline time.Time
+}
+
+// IsOpen checks if enrollments are accepted on the given day
+func (w RegistrationWindow) IsOpen(now time.Time) bool {
+	today := truncateToDate(now)
+	return (w.PeriodStatus == PeriodRegistrationOpen || w.PeriodStatus == PeriodInProgress) &&
+		!today.Before(truncateToDate(w.Start)) &&
+		!today.After(truncateToDate(w.End))
+}
+
+// Admission is the state of a section and a student read under lock right
+// before the student takes a seat
+type Admission struct {
+	StudentActive bool
+	// Holds are the active blocking registration holds of the student
+	Holds         []Hold
+	SectionActive bool
+	Capacity      int
+	// Enrolled counts the enrollments that take a seat in the section
+	Enrolled int
+	// Held counts the seats offered to waitlisted students and not expired
+	Held int
+	// Waiting counts the students in the waitlist without an offer that
+	// can still get one, i.e. active and not enrolled another way
+	Waiting int
+	Window  RegistrationWindow
+	// TicketAt is the published time ticket of the student, nil when the
+	// period has none
+	TicketAt *time.Time
+	// Requirements are the mandatory prerequisites and corequisites of the course
+	Requirements []Requirement
+	// Overridden is set when the student was exempted from the requirements
+	Overridden bool
+	// Schedule is the meeting time of the section, nil when it has none
+	Schedule *section.Schedule
+	// Taken are the other sections of the period the student is enrolled in
+	Taken []ScheduledSection
+	// AllowClashes is set when an admin overrides the schedule clash check
+	AllowClashes bool
+	// SectionCredits are the credits of the section course
+	SectionCredits int
+	// Load is the credit load of the student in the period without the section
+	Load CreditLoad
+}
+
+// FreeSeats returns the seats nobody has taken nor been offered
+func (a Admission) FreeSeats() int {
+	return max(a.Capacity-a.Enrolled-a.Held, 0)
+}
+
+// Check verifies that the student can take a seat on the given day
+func (a Admission) Check(now time.Time) error {
+	if !a.StudentActive {
+		return ErrStudentNotActive
+	}
+
+	if err := a.CheckHolds(); err != nil {
+		return err
+	}
+
+	if !a.SectionActive {
+		return ErrSectionInactive
+	}
+
+	if !a.Window.IsOpen(now) {
+		return ErrRegistrationClosed
+	}
+
+	if err := a.CheckTicket(now); err != nil {
+		return err
+	}
+
+	if err := a.CheckRequirements(); err != nil {
+		return err
+	}
+
+	if err := a.CheckClashes(); err != nil {
+		return err
+	}
+
+	if err := a.CheckCreditLoad(); err != nil {
+		return err
+	}
+
+	// students in the waitlist come first, a seat freed by a drop is
+	// theirs even before the promotion offers it
+	if a.FreeSeats() == 0 || a.Waiting > 0 {
+		return ErrSectionFull
+	}
+
+	return nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,78 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func TestEnrollmentDrop(t *testing.T) {
+	// drops end on September 12 and withdrawals on November 14
+	window := admission().Window
+	admin := valueobject.NewID()
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2025, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		from       domain.Status
+		now        time.Time
+		overrideBy *valueobject.ID
+		want       domain.Status
+		// wantOverride is set when the admin is recorded as allowing the withdrawal
+		wantOverride bool
+		wantErr      error
+	}{
+		{name: "before the drop deadline", from: domain.StatusEnrolled, now: at(time.September, 1, 9), want: domain.StatusDropped},
+		{name: "late on the drop deadline", from: domain.StatusEnrolled, now: at(time.September, 12, 23), want: domain.StatusDropped},
+		{name: "the day after the drop deadline", from: domain.StatusEnrolled, now: at(time.September, 13, 0), want: domain.StatusWithdrawn},
+		{name: "late on the withdrawal deadline", from: domain.StatusEnrolled, now: at(time.November, 14, 23), want: domain.StatusWithdrawn},
+		{name: "after the withdrawal deadline", from: domain.StatusEnrolled, now: at(time.November, 15, 0), wantErr: domain.ErrWithdrawalClosed},
+		{name: "after the withdrawal deadline with an override", from: domain.StatusEnrolled, now: at(time.November, 15, 0), overrideBy: &admin, want: domain.StatusWithdrawn, wantOverride: true},
+		{name: "an override before the deadline is not recorded", from: domain.StatusEnrolled, now: at(time.October, 1, 9), overrideBy: &admin, want: domain.StatusWithdrawn},
+		{name: "already dropped", from: domain.StatusDropped, now: at(time.September, 1, 9), wantErr: domain.ErrNotEnrolled},
+		{name: "already withdrawn", from: domain.StatusWithdrawn, now: at(time.October, 1, 9), wantErr: domain.ErrNotEnrolled},
+		{name: "completed", from: domain.StatusCompleted, now: at(time.November, 15, 0), overrideBy: &admin, wantErr: domain.ErrNotEnrolled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := domain.NewEnrollment(valueobject.NewID(), valueobject.NewID(), at(time.August, 20, 10))
+			e.Status = tt.from
+
+			err := e.Drop(tt.now, window, tt.overrideBy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if e.Status != tt.from || e.LetterGrade != "" || e.DropOverrideBy != nil {
+					t.Errorf("the enrollment changed on error: %+v", e)
+				}
+				return
+			}
+
+			if e.Status != tt.want {
+				t.Errorf("got status %s, want %s", e.Status, tt.want)
+			}
+
+			wantMark := ""
+			if tt.want == domain.StatusWithdrawn {
+				wantMark = domain.WithdrawalMark
+			}
+			if e.LetterGrade != wantMark {
+				t.Errorf("got letter grade %q, want %q", e.LetterGrade, wantMark)
+			}
+
+			if (e.DropOverrideBy != nil) != tt.wantOverride {
+				t.Errorf("got drop override by %v, want it recorded: %v", e.DropOverrideBy, tt.wantOverride)
+			}
+			if !e.UpdatedAt.Equal(tt.now) {
+				t.Errorf("got updated at %s, want %s", e.UpdatedAt, tt.now)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var ErrRegistrationHold = errors.New("student has active registration holds")
+
+// Hold is an active registration hold that blocks the enrollments of the student
+type Hold struct {
+	ID     valueobject.ID `json:"id"`
+	Type   string         `json:"hold_type"`
+	Reason string         `json:"reason"`
+	// ExpiresAt is nil for holds that stay until released
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// RegistrationHoldError carries the holds that block an enrollment
+type RegistrationHoldError struct {
+	Holds []Hold
+}
+
+func (e *RegistrationHoldError) Error() string {
+	types := make([]string, 0, len(e.Holds))
+	for _, h := range e.Holds {
+		types = append(types, h.Type)
+	}
+	return fmt.Sprintf("%s: %s", ErrRegistrationHold, strings.Join(types, ", "))
+}
+
+func (e *RegistrationHoldError) Is(target error) bool {
+	return target == ErrRegistrationHold
+}
+
+// CheckHolds verifies that no blocking hold is active on the student
+func (a Admission) CheckHolds() error {
+	if len(a.Holds) > 0 {
+		return &RegistrationHoldError{Holds: a.Holds}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var ErrRequirementsNotMet = errors.New("student does not meet the course requirements")
+
+// Requirement is a mandatory prerequisite or corequisite of the section
+// course, as met by the enrolling student
+type Requirement struct {
+	CourseID   valueobject.ID `json:"course_id"`
+	CourseCode string         `json:"course_code"`
+	CourseName string         `json:"course_name"`
+	// MinimumGrade is the lowest final grade accepted, nil means passing is enough
+	MinimumGrade  *float64 `json:"minimum_grade,omitempty"`
+	IsCorequisite bool     `json:"is_corequisite"`
+	// Satisfied is set when the student passed the course with the minimum
+	// grade, or for corequisites, is taking it in the same period
+	Satisfied bool `json:"-"`
+}
+
+// MissingRequirementsError carries the requirements that block an enrollment
+type MissingRequirementsError struct {
+	Missing []Requirement
+}
+
+func (e *MissingRequirementsError) Error() string {
+	codes := make([]string, 0, len(e.Missing))
+	for _, r := range e.Missing {
+		codes = append(codes, r.CourseCode)
+	}
+	return fmt.Sprintf("%s: %s", ErrRequirementsNotMet, strings.Join(codes, ", "))
+}
+
+func (e *MissingRequirementsError) Is(target error) bool {
+	return target == ErrRequirementsNotMet
+}
+
+// CheckRequirements verifies that the student meets every mandatory
+// requirement of the course unless an override was granted
+func (a Admission) CheckRequirements() error {
+	if a.Overridden {
+		return nil
+	}
+
+	var missing []Requirement
+	for _, r := range a.Requirements {
+		if !r.Satisfied {
+			missing = append(missing, r)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &MissingRequirementsError{Missing: missing}
+	}
+	return nil
+}
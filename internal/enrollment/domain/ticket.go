@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrBeforeTimeTicket      = errors.New("the registration time ticket of the student has not started")
+	ErrTicketNotFound        = errors.New("student has no published registration time ticket for the period")
+	ErrNoTickets             = errors.New("the period has no registration time tickets")
+	ErrPeriodNotFound        = errors.New("academic period does not exist")
+	ErrRegistrationStarted   = errors.New("registration of the period already started, time tickets can no longer change")
+	ErrInvalidPriorityGroups = errors.New("priority groups must be non empty codes of up to 30 characters")
+)
+
+type TicketRepository interface {
+	// TicketPeriod reads the registration start of the period
+	TicketPeriod(ctx context.Context, periodID valueobject.ID) (TicketPeriod, error)
+	// Candidates returns the active students that get a ticket with the
+	// data the priority rules rank them by
+	Candidates(ctx context.Context) ([]TicketCandidate, error)
+	// Replace stores the tickets of the period in place of the previous
+	// ones, they are left unpublished
+	Replace(ctx context.Context, periodID valueobject.ID, tickets []*TimeTicket) error
+	// Publish makes the tickets of the period visible and binding
+	Publish(ctx context.Context, periodID valueobject.ID, now time.Time) ([]*TimeTicket, error)
+	ListByPeriod(ctx context.Context, periodID valueobject.ID) ([]*TimeTicket, error)
+	// FindPublished returns the published ticket of the student in the period
+	FindPublished(ctx context.Context, periodID, studentID valueobject.ID) (*TimeTicket, error)
+	SetPriorityGroups(ctx context.Context, studentID valueobject.ID, groups []string) error
+}
+
+// TicketPeriod is the academic period tickets are computed for
+type TicketPeriod struct {
+	ID                valueobject.ID
+	RegistrationStart time.Time
+}
+
+// TicketPolicy splits the ranked students in cohorts that start registering
+// one after the other
+type TicketPolicy struct {
+	CohortSize int
+	Interval   time.Duration
+}
+
+// TicketCandidate is a student that gets a time ticket
+type TicketCandidate struct {
+	StudentID      valueobject.ID
+	CreditsEarned  int
+	DegreeType     string
+	EnrollmentDate time.Time
+	// PriorityGroups are the special groups of the student, like athletes
+	// or students with accommodations, any of them registers first
+	PriorityGroups []string
+}
+
+// TimeTicket is the moment a student may start enrolling in the period
+type TimeTicket struct {
+	ID             valueobject.ID `json:"id"`
+	PeriodID       valueobject.ID `json:"academic_period_id"`
+	StudentID      valueobject.ID `json:"student_id"`
+	StartsAt       time.Time      `json:"starts_at"`
+	Rank           int            `json:"rank"`
+	CreditsEarned  int            `json:"credits_earned"`
+	DegreeType     string         `json:"degree_type,omitempty"`
+	PriorityGroups []string       `json:"priority_groups"`
+	PublishedAt    *time.Time     `json:"published_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// TimeTicketFromPersistence creates a TimeTicket instance from database records
+func TimeTicketFromPersistence(
+	id valueobject.ID,
+	periodID valueobject.ID,
+	studentID valueobject.ID,
+	startsAt time.Time,
+	rank int,
+	creditsEarned int,
+	degreeType string,
+	priorityGroups []string,
+	publishedAt *time.Time,
+	createdAt time.Time,
+) *TimeTicket {
+	return &TimeTicket{
+		ID:             id,
+		PeriodID:       periodID,
+		StudentID:      studentID,
+		StartsAt:       startsAt,
+		Rank:           rank,
+		CreditsEarned:  creditsEarned,
+		DegreeType:     degreeType,
+		PriorityGroups: priorityGroups,
+		PublishedAt:    publishedAt,
+		CreatedAt:      createdAt,
+	}
+}
+
+// degreeLevels ranks the degree types, graduate students register first
+var degreeLevels = map[string]int{
+	"phd":       4,
+	"master":    3,
+	"bachelor":  2,
+	"associate": 1,
+}
+
+// AssignTickets ranks the candidates by the priority rules and gives each
+// cohort a start time after the previous one: students in a special group
+// first, then by degree level, credits earned and seniority
+func AssignTickets(period TicketPeriod, candidates []TicketCandidate, p TicketPolicy, now time.Time) []*TimeTicket {
+	ranked := make([]TicketCandidate, len(candidates))
+	copy(ranked, candidates)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if ga, gb := len(a.PriorityGroups) > 0, len(b.PriorityGroups) > 0; ga != gb {
+			return ga
+		}
+		if la, lb := degreeLevels[a.DegreeType], degreeLevels[b.DegreeType]; la != lb {
+			return la > lb
+		}
+		if a.CreditsEarned != b.CreditsEarned {
+			return a.CreditsEarned > b.CreditsEarned
+		}
+		if !a.EnrollmentDate.Equal(b.EnrollmentDate) {
+			return a.EnrollmentDate.Before(b.EnrollmentDate)
+		}
+		return a.StudentID.String() < b.StudentID.String()
+	})
+
+	tickets := make([]*TimeTicket, 0, len(ranked))
+	for i, c := range ranked {
+		cohort := i / max(p.CohortSize, 1)
+		tickets = append(tickets, &TimeTicket{
+			ID:             valueobject.NewID(),
+			PeriodID:       period.ID,
+			StudentID:      c.StudentID,
+			StartsAt:       period.RegistrationStart.Add(time.Duration(cohort) * p.Interval),
+			Rank:           i + 1,
+			CreditsEarned:  c.CreditsEarned,
+			DegreeType:     c.DegreeType,
+			PriorityGroups: c.PriorityGroups,
+			CreatedAt:      now,
+		})
+	}
+
+	return tickets
+}
+
+// CanAssignTickets checks that registration of the period has not started,
+// moving tickets afterwards would let students in or out mid registration
+func (p TicketPeriod) CanAssignTickets(now time.Time) error {
+	if !now.Before(p.RegistrationStart) {
+		return ErrRegistrationStarted
+	}
+	return nil
+}
+
+// NormalizePriorityGroups trims, lowercases and deduplicates group codes
+func NormalizePriorityGroups(groups []string) ([]string, error) {
+	seen := make(map[string]bool, len(groups))
+	out := make([]string, 0, len(groups))
+	for _, g := range groups {
+		g = strings.ToLower(strings.TrimSpace(g))
+		if g == "" || len(g) > 30 {
+			return nil, ErrInvalidPriorityGroups
+		}
+		if !seen[g] {
+			seen[g] = true
+			out = append(out, g)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// TicketError carries the moment the student may start enrolling
+type TicketError struct {
+	StartsAt time.Time `json:"starts_at"`
+}
+
+func (e *TicketError) Error() string {
+	return fmt.Sprintf("%s: enrollment opens at %s", ErrBeforeTimeTicket, e.StartsAt.Format(time.RFC1123))
+}
+
+func (e *TicketError) Is(target error) bool {
+	return target == ErrBeforeTimeTicket
+}
+
+// CheckTicket verifies that the time ticket of the student already started
+func (a Admission) CheckTicket(now time.Time) error {
+	if a.TicketAt != nil && now.Before(*a.TicketAt) {
+		return &TicketError{StartsAt: *a.TicketAt}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package domain_test
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func fixedID(t *testing.T, s string) valueobject.ID {
+	t.Helper()
+	id, err := valueobject.IDFromString(s)
+	if err != nil {
+		t.Fatalf("invalid id %q: %v", s, err)
+	}
+	return id
+}
+
+func TestAssignTickets(t *testing.T) {
+	start := time.Date(2025, time.August, 1, 8, 0, 0, 0, time.UTC)
+	senior := time.Date(2021, time.September, 1, 0, 0, 0, 0, time.UTC)
+	junior := time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	candidate := func(id, degree string, credits int, enrolled time.Time, groups ...string) domain.TicketCandidate {
+		return domain.TicketCandidate{StudentID: fixedID(t, id), DegreeType: degree, CreditsEarned: credits, EnrollmentDate: enrolled, PriorityGroups: groups}
+	}
+
+	// listed in the order they must be ranked, each one wins over the next
+	// by the rule in its comment
+	ranked := []domain.TicketCandidate{
+		// a special group beats every other rule
+		candidate("00000000-0000-4000-8000-000000000009", "associate", 0, junior, "athletes"),
+		// a higher degree level beats credits
+		candidate("00000000-0000-4000-8000-000000000008", "phd", 10, junior),
+		candidate("00000000-0000-4000-8000-000000000007", "master", 10, junior),
+		// more credits beat seniority
+		candidate("00000000-0000-4000-8000-000000000006", "bachelor", 90, junior),
+		// seniority beats the id
+		candidate("00000000-0000-4000-8000-000000000005", "bachelor", 60, senior),
+		// a complete tie falls back to the lowest id
+		candidate("00000000-0000-4000-8000-000000000002", "bachelor", 60, junior),
+		candidate("00000000-0000-4000-8000-000000000003", "bachelor", 60, junior),
+		// unknown degree types rank last
+		candidate("00000000-0000-4000-8000-000000000001", "", 120, senior),
+	}
+
+	want := make([]valueobject.ID, len(ranked))
+	for i, c := range ranked {
+		want[i] = c.StudentID
+	}
+
+	inputs := map[string][]domain.TicketCandidate{
+		"ranked":   ranked,
+		"reversed": slices.Clone(ranked),
+		"shuffled": {ranked[5], ranked[0], ranked[7], ranked[3], ranked[6], ranked[1], ranked[4], ranked[2]},
+	}
+	slices.Reverse(inputs["reversed"])
+
+	for name, candidates := range inputs {
+		t.Run(name, func(t *testing.T) {
+			period := domain.TicketPeriod{ID: valueobject.NewID(), RegistrationStart: start}
+			before := slices.Clone(candidates)
+
+			tickets := domain.AssignTickets(period, candidates, domain.TicketPolicy{CohortSize: 3, Interval: 2 * time.Hour}, start.AddDate(0, 0, -7))
+
+			if !reflect.DeepEqual(candidates, before) {
+				t.Error("the candidates were reordered in place")
+			}
+
+			got := make([]valueobject.ID, len(tickets))
+			for i, ticket := range tickets {
+				got[i] = ticket.StudentID
+				if ticket.Rank != i+1 || !ticket.PeriodID.Equals(period.ID) || ticket.PublishedAt != nil {
+					t.Errorf("got ticket %+v at %d, want an unpublished ticket ranked %d", ticket, i, i+1)
+				}
+
+				// cohorts of 3 start two hours apart
+				wantStart := start.Add(time.Duration(i/3) * 2 * time.Hour)
+				if !ticket.StartsAt.Equal(wantStart) {
+					t.Errorf("rank %d: got start %s, want %s", ticket.Rank, ticket.StartsAt, wantStart)
+				}
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got ranking %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAssignTicketsCohortSize(t *testing.T) {
+	start := time.Date(2025, time.August, 1, 8, 0, 0, 0, time.UTC)
+	candidates := []domain.TicketCandidate{
+		{StudentID: fixedID(t, "00000000-0000-4000-8000-000000000001")},
+		{StudentID: fixedID(t, "00000000-0000-4000-8000-000000000002")},
+	}
+
+	tests := []struct {
+		name   string
+		policy domain.TicketPolicy
+		want   []time.Time
+	}{
+		{name: "everyone in one cohort", policy: domain.TicketPolicy{CohortSize: 5, Interval: time.Hour}, want: []time.Time{start, start}},
+		{name: "one student per cohort", policy: domain.TicketPolicy{CohortSize: 1, Interval: time.Hour}, want: []time.Time{start, start.Add(time.Hour)}},
+		{name: "no cohort size counts as one", policy: domain.TicketPolicy{Interval: 30 * time.Minute}, want: []time.Time{start, start.Add(30 * time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tickets := domain.AssignTickets(domain.TicketPeriod{RegistrationStart: start}, candidates, tt.policy, start)
+			for i, ticket := range tickets {
+				if !ticket.StartsAt.Equal(tt.want[i]) {
+					t.Errorf("rank %d: got start %s, want %s", ticket.Rank, ticket.StartsAt, tt.want[i])
+				}
+			}
+		})
+	}
+
+	if tickets := domain.AssignTickets(domain.TicketPeriod{RegistrationStart: start}, nil, domain.TicketPolicy{CohortSize: 1}, start); len(tickets) != 0 {
+		t.Errorf("got %d tickets without candidates", len(tickets))
+	}
+}
+
+func TestTicketPeriodCanAssignTickets(t *testing.T) {
+	period := domain.TicketPeriod{RegistrationStart: time.Date(2025, time.August, 1, 8, 0, 0, 0, time.UTC)}
+
+	if err := period.CanAssignTickets(period.RegistrationStart.Add(-time.Second)); err != nil {
+		t.Errorf("unexpected error before registration: %v", err)
+	}
+	if err := period.CanAssignTickets(period.RegistrationStart); !errors.Is(err, domain.ErrRegistrationStarted) {
+		t.Errorf("got error %v once registration started, want %v", err, domain.ErrRegistrationStarted)
+	}
+}
+
+func TestAdmissionCheckTicket(t *testing.T) {
+	startsAt := time.Date(2025, time.August, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		ticket  *time.Time
+		now     time.Time
+		wantErr error
+	}{
+		{name: "no ticket", now: startsAt.Add(-time.Hour)},
+		{name: "a second before the ticket", ticket: &startsAt, now: startsAt.Add(-time.Second), wantErr: domain.ErrBeforeTimeTicket},
+		{name: "when the ticket starts", ticket: &startsAt, now: startsAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.Admission{TicketAt: tt.ticket}.CheckTicket(tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			var ticket *domain.TicketError
+			if err != nil && (!errors.As(err, &ticket) || !ticket.StartsAt.Equal(startsAt)) {
+				t.Errorf("got %+v, want the moment the ticket starts", ticket)
+			}
+		})
+	}
+}
+
+func TestNormalizePriorityGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		groups  []string
+		want    []string
+		wantErr error
+	}{
+		{name: "trims, lowercases, sorts and dedupes", groups: []string{" Veterans", "athletes", "ATHLETES "}, want: []string{"athletes", "veterans"}},
+		{name: "no groups", want: []string{}},
+		{name: "blank group", groups: []string{"athletes", " "}, wantErr: domain.ErrInvalidPriorityGroups},
+		{name: "too long", groups: []string{"a-group-code-with-more-than-30-chars"}, wantErr: domain.ErrInvalidPriorityGroups},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NormalizePriorityGroups(tt.groups)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	section "github.com/Jose-Salazar-27/go-university-server/internal/section/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrScheduleClash   = errors.New("section schedule clashes with another enrollment of the student")
+	ErrNoActivePeriod  = errors.New("there is no active academic period")
+	ErrInvalidSchedule = errors.New("section has an invalid schedule, it must be fixed before enrolling")
+)
+
+// ScheduledSection is a section the student is enrolled in with its meeting time
+type ScheduledSection struct {
+	SectionID   valueobject.ID `json:"course_section_id"`
+	CourseCode  string         `json:"course_code"`
+	CourseName  string         `json:"course_name"`
+	SectionCode string         `json:"section_code"`
+	// Schedule is nil for sections without a meeting time
+	Schedule *section.Schedule `json:"schedule,omitempty"`
+}
+
+// ScheduleClashError carries the enrollments that meet at the same time as the section
+type ScheduleClashError struct {
+	Clashes []ScheduledSection
+}
+
+func (e *ScheduleClashError) Error() string {
+	codes := make([]string, 0, len(e.Clashes))
+	for _, c := range e.Clashes {
+		codes = append(codes, c.CourseCode+"-"+c.SectionCode)
+	}
+	return fmt.Sprintf("%s: %s", ErrScheduleClash, strings.Join(codes, ", "))
+}
+
+func (e *ScheduleClashError) Is(target error) bool {
+	return target == ErrScheduleClash
+}
+
+// CheckClashes verifies that the section doesn't meet at the same time as
+// the other enrollments of the student, unless an admin allowed it
+func (a Admission) CheckClashes() error {
+	if a.AllowClashes || a.Schedule == nil {
+		return nil
+	}
+
+	var clashes []ScheduledSection
+	for _, taken := range a.Taken {
+		if taken.Schedule != nil && a.Schedule.Overlaps(*taken.Schedule) {
+			clashes = append(clashes, taken)
+		}
+	}
+
+	if len(clashes) > 0 {
+		return &ScheduleClashError{Clashes: clashes}
+	}
+	return nil
+}
+
+// Meeting is a class of the weekly timetable
+type Meeting struct {
+	SectionID   valueobject.ID    `json:"course_section_id"`
+	CourseCode  string            `json:"course_code"`
+	CourseName  string            `json:"course_name"`
+	SectionCode string            `json:"section_code"`
+	StartTime   section.ClockTime `json:"start_time"`
+	EndTime     section.ClockTime `json:"end_time"`
+	Room        string            `json:"room,omitempty"`
+}
+
+// TimetableDay holds the meetings of a weekday sorted by start time
+type TimetableDay struct {
+	Day      section.Weekday `json:"day"`
+	Meetings []Meeting       `json:"meetings"`
+}
+
+// Timetable is the weekly schedule of a student in an academic period
+type Timetable struct {
+	StudentID valueobject.ID `json:"student_id"`
+	PeriodID  valueobject.ID `json:"academic_period_id"`
+	Days      []TimetableDay `json:"days"`
+	// Unscheduled lists the sections without a meeting time
+	Unscheduled []ScheduledSection `json:"unscheduled"`
+}
+
+// NewTimetable lays out the sections by weekday, days without meetings are left out
+func NewTimetable(studentID, periodID valueobject.ID, sections []ScheduledSection) Timetable {
+	timetable := Timetable{
+		StudentID:   studentID,
+		PeriodID:    periodID,
+		Days:        []TimetableDay{},
+		Unscheduled: []ScheduledSection{},
+	}
+
+	byDay := make(map[section.Weekday][]Meeting)
+	for _, s := range sections {
+		if s.Schedule == nil {
+			timetable.Unscheduled = append(timetable.Unscheduled, s)
+			continue
+		}
+
+		for _, day := range s.Schedule.Days {
+			byDay[day] = append(byDay[day], Meeting{
+				SectionID:   s.SectionID,
+				CourseCode:  s.CourseCode,
+				CourseName:  s.CourseName,
+				SectionCode: s.SectionCode,
+				StartTime:   s.Schedule.StartTime,
+				EndTime:     s.Schedule.EndTime,
+				Room:        s.Schedule.Room,
+			})
+		}
+	}
+
+	for _, day := range section.Weekdays {
+		meetings, ok := byDay[day]
+		if !ok {
+			continue
+		}
+		sort.Slice(meetings, func(i, j int) bool { return meetings[i].StartTime < meetings[j].StartTime })
+		timetable.Days = append(timetable.Days, TimetableDay{Day: day, Meetings: meetings})
+	}
+
+	return timetable
+}
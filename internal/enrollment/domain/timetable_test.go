@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	section "github.com/Jose-Salazar-27/go-university-server/internal/section/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func schedule(t *testing.T, days []string, start, end, room string) *section.Schedule {
+	t.Helper()
+	s, err := section.NewSchedule(days, start, end, room)
+	if err != nil {
+		t.Fatalf("invalid schedule: %v", err)
+	}
+	return &s
+}
+
+func TestAdmissionCheckClashes(t *testing.T) {
+	clashing := domain.ScheduledSection{SectionID: valueobject.NewID(), CourseCode: "MAT101", SectionCode: "01", Schedule: schedule(t, []string{"Mon"}, "08:00", "10:00", "")}
+	free := domain.ScheduledSection{SectionID: valueobject.NewID(), CourseCode: "PHY101", SectionCode: "02", Schedule: schedule(t, []string{"Mon"}, "14:00", "16:00", "")}
+	unscheduled := domain.ScheduledSection{SectionID: valueobject.NewID(), CourseCode: "ART101", SectionCode: "01"}
+
+	meets := schedule(t, []string{"Mon", "Wed"}, "09:00", "11:00", "")
+
+	tests := []struct {
+		name      string
+		admission domain.Admission
+		want      []domain.ScheduledSection
+	}{
+		{name: "clashes", admission: domain.Admission{Schedule: meets, Taken: []domain.ScheduledSection{free, clashing, unscheduled}}, want: []domain.ScheduledSection{clashing}},
+		{name: "no clashes", admission: domain.Admission{Schedule: meets, Taken: []domain.ScheduledSection{free, unscheduled}}},
+		{name: "allowed by an admin", admission: domain.Admission{Schedule: meets, Taken: []domain.ScheduledSection{clashing}, AllowClashes: true}},
+		{name: "section without schedule", admission: domain.Admission{Taken: []domain.ScheduledSection{clashing}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.admission.CheckClashes()
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var clash *domain.ScheduleClashError
+			if !errors.As(err, &clash) || !errors.Is(err, domain.ErrScheduleClash) {
+				t.Fatalf("got error %v, want a schedule clash", err)
+			}
+			if !reflect.DeepEqual(clash.Clashes, tt.want) {
+				t.Errorf("got clashes %+v, want %+v", clash.Clashes, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTimetable(t *testing.T) {
+	calculus := domain.ScheduledSection{SectionID: valueobject.NewID(), CourseCode: "MAT101", SectionCode: "01", Schedule: schedule(t, []string{"Wed", "Mon"}, "10:00", "12:00", "A-101")}
+	physics := domain.ScheduledSection{SectionID: valueobject.NewID(), CourseCode: "PHY101", SectionCode: "02", Schedule: schedule(t, []string{"Mon"}, "8:00", "9:30", "")}
+	seminar := domain.ScheduledSection{SectionID: valueobject.NewID(), CourseCode: "ART101", SectionCode: "01"}
+
+	timetable := domain.NewTimetable(valueobject.NewID(), valueobject.NewID(), []domain.ScheduledSection{calculus, seminar, physics})
+
+	var got []string
+	for _, day := range timetable.Days {
+		for _, m := range day.Meetings {
+			got = append(got, string(day.Day)+" "+m.StartTime.String()+" "+m.CourseCode)
+		}
+	}
+
+	want := []string{"Mon 08:00 PHY101", "Mon 10:00 MAT101", "Wed 10:00 MAT101"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got meetings %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(timetable.Unscheduled, []domain.ScheduledSection{seminar}) {
+		t.Errorf("got unscheduled %+v, want the seminar", timetable.Unscheduled)
+	}
+
+	if empty := domain.NewTimetable(valueobject.NewID(), valueobject.NewID(), nil); empty.Days == nil || empty.Unscheduled == nil {
+		t.Error("an empty timetable must list no days rather than null")
+	}
+}
@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrNotWaitlisted     = errors.New("student is not in the section waitlist")
+	ErrAlreadyWaitlisted = errors.New("student is already in the section waitlist")
+	ErrSeatsAvailable    = errors.New("section has seats available, enroll directly")
+	ErrNoOffer           = errors.New("there is no seat offered to the student")
+	ErrOfferExpired      = errors.New("the seat offer has expired")
+)
+
+type WaitlistRepository interface {
+	// Join adds the student at the end of the waitlist, it fails with
+	// ErrSeatsAvailable when the section is not full
+	Join(ctx context.Context, e *WaitlistEntry) (err error)
+	// Leave removes the student from the waitlist, declining the seat
+	// offered to them if any
+	Leave(ctx context.Context, sectionID, studentID valueobject.ID, now time.Time) (*WaitlistEntry, error)
+	// Accept turns the seat offered to the student into an enrollment
+	Accept(ctx context.Context, sectionID, studentID valueobject.ID, now time.Time) (*Enrollment, error)
+	// FindActive returns the waiting or offered entry of the student with its position
+	FindActive(ctx context.Context, sectionID, studentID valueobject.ID) (*WaitlistEntry, error)
+	ListBySection(ctx context.Context, sectionID valueobject.ID) ([]*WaitlistEntry, error)
+	// ExpireOffers marks as expired the offers not accepted in time and the
+	// ones of sections whose registration closed
+	ExpireOffers(ctx context.Context, now time.Time) ([]*WaitlistEntry, error)
+	// OfferSeats offers every free seat of the section to the first eligible
+	// students of the waitlist
+	OfferSeats(ctx context.Context, sectionID valueobject.ID, now time.Time, window time.Duration) ([]*WaitlistEntry, error)
+	// SectionsWithWaitlist returns the sections that have students waiting
+	SectionsWithWaitlist(ctx context.Context) ([]valueobject.ID, error)
+}
+
+// WaitlistStatus is the state of a student in a section waitlist
+type WaitlistStatus string
+
+const (
+	WaitlistWaiting   WaitlistStatus = "waiting"
+	WaitlistOffered   WaitlistStatus = "offered"
+	WaitlistAccepted  WaitlistStatus = "accepted"
+	WaitlistDeclined  WaitlistStatus = "declined"
+	WaitlistExpired   WaitlistStatus = "expired"
+	WaitlistCancelled WaitlistStatus = "cancelled"
+)
+
+// IsActive checks if the entry still holds a place in the waitlist
+func (s WaitlistStatus) IsActive() bool {
+	return s == WaitlistWaiting || s == WaitlistOffered
+}
+
+// WaitlistEntry is a student waiting for a seat in a full section
+type WaitlistEntry struct {
+	ID        valueobject.ID `json:"id"`
+	SectionID valueobject.ID `json:"course_section_id"`
+	StudentID valueobject.ID `json:"student_id"`
+	Status    WaitlistStatus `json:"status"`
+	// Position starts at 1, it is only set for waiting entries
+	Position       int        `json:"position,omitempty"`
+	OfferedAt      *time.Time `json:"offered_at,omitempty"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// NewWaitlistEntry creates a waiting entry for the student
+func NewWaitlistEntry(studentID, sectionID valueobject.ID, now time.Time) *WaitlistEntry {
+	return &WaitlistEntry{
+		ID:        valueobject.NewID(),
+		SectionID: sectionID,
+		StudentID: studentID,
+		Status:    WaitlistWaiting,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// WaitlistEntryFromPersistence creates a WaitlistEntry instance from database records
+func WaitlistEntryFromPersistence(
+	id valueobject.ID,
+	sectionID valueobject.ID,
+	studentID valueobject.ID,
+	status WaitlistStatus,
+	position int,
+	offeredAt *time.Time,
+	offerExpiresAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *WaitlistEntry {
+	return &WaitlistEntry{
+		ID:             id,
+		SectionID:      sectionID,
+		StudentID:      studentID,
+		Status:         status,
+		Position:       position,
+		OfferedAt:      offeredAt,
+		OfferExpiresAt: offerExpiresAt,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+// Offer reserves a seat for the student until the window elapses
+func (e *WaitlistEntry) Offer(now time.Time, window time.Duration) {
+	expires := now.Add(window)
+	e.Status = WaitlistOffered
+	e.Position = 0
+	e.OfferedAt = &now
+	e.OfferExpiresAt = &expires
+	e.UpdatedAt = now
+}
+
+// HoldsSeat checks if the entry has a seat reserved at the given time
+func (e *WaitlistEntry) HoldsSeat(now time.Time) bool {
+	return e.Status == WaitlistOffered && e.OfferExpiresAt != nil && now.Before(*e.OfferExpiresAt)
+}
+
+// Accept takes the offered seat
+func (e *WaitlistEntry) Accept(now time.Time) error {
+	if e.Status != WaitlistOffered {
+		return ErrNoOffer
+	}
+
+	if !e.HoldsSeat(now) {
+		return ErrOfferExpired
+	}
+
+	e.Status = WaitlistAccepted
+	e.UpdatedAt = now
+	return nil
+}
+
+// Leave removes the student from the waitlist, an offered seat is declined
+func (e *WaitlistEntry) Leave(now time.Time) error {
+	switch e.Status {
+	case WaitlistWaiting:
+		e.Status = WaitlistCancelled
+	case WaitlistOffered:
+		e.Status = WaitlistDeclined
+	default:
+		return ErrNotWaitlisted
+	}
+
+	e.UpdatedAt = now
+	return nil
+}
+
+// Expire closes an offer that wasn't accepted in time
+func (e *WaitlistEntry) Expire(now time.Time) {
+	e.Status = WaitlistExpired
+	e.UpdatedAt = now
+}
+
+// CheckWaitlist verifies that the student can join the waitlist on the
+// given day, only full sections have one
+func (a Admission) CheckWaitlist(now time.Time) error {
+	if !a.StudentActive {
+		return ErrStudentNotActive
+	}
+
+	if err := a.CheckHolds(); err != nil {
+		return err
+	}
+
+	if !a.SectionActive {
+		return ErrSectionInactive
+	}
+
+	if !a.Window.IsOpen(now) {
+		return ErrRegistrationClosed
+	}
+
+	if err := a.CheckTicket(now); err != nil {
+		return err
+	}
+
+	if err := a.CheckRequirements(); err != nil {
+		return err
+	}
+
+	if err := a.CheckCreditLoad(); err != nil {
+		return err
+	}
+
+	if a.FreeSeats() > 0 && a.Waiting == 0 {
+		return ErrSeatsAvailable
+	}
+
+	return nil
+}
+
+// CheckOffer verifies that the student can take the seat reserved for them
+// on the given day, the capacity was already accounted for when the seat was
+// offered
+func (a Admission) CheckOffer(now time.Time) error {
+	if !a.StudentActive {
+		return ErrStudentNotActive
+	}
+
+	if err := a.CheckHolds(); err != nil {
+		return err
+	}
+
+	if !a.SectionActive {
+		return ErrSectionInactive
+	}
+
+	if !a.Window.IsOpen(now) {
+		return ErrRegistrationClosed
+	}
+
+	if err := a.CheckRequirements(); err != nil {
+		return err
+	}
+
+	if err := a.CheckClashes(); err != nil {
+		return err
+	}
+
+	return a.CheckCreditLoad()
+}
@@ -0,0 +1,167 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// registration is a moment within the window of admission
+var registration = time.Date(2025, time.August, 20, 10, 0, 0, 0, time.UTC)
+
+// admission returns a full section the student meets every rule for
+func admission() domain.Admission {
+	return domain.Admission{
+		StudentActive: true,
+		SectionActive: true,
+		Capacity:      2,
+		Enrolled:      2,
+		Window: domain.RegistrationWindow{
+			PeriodStatus:       domain.PeriodRegistrationOpen,
+			Start:              time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+			End:                time.Date(2025, time.August, 29, 0, 0, 0, 0, time.UTC),
+			DropDeadline:       time.Date(2025, time.September, 12, 0, 0, 0, 0, time.UTC),
+			WithdrawalDeadline: time.Date(2025, time.November, 14, 0, 0, 0, 0, time.UTC),
+		},
+		SectionCredits: 3,
+		Load:           domain.CreditLoad{Credits: 12, Minimum: 9, Maximum: 18, Remaining: 6},
+	}
+}
+
+func TestWaitlistEntryTransitions(t *testing.T) {
+	expires := registration.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		from    domain.WaitlistStatus
+		action  func(e *domain.WaitlistEntry) error
+		want    domain.WaitlistStatus
+		wantErr error
+	}{
+		{name: "leaving the queue cancels", from: domain.WaitlistWaiting, action: func(e *domain.WaitlistEntry) error { return e.Leave(registration) }, want: domain.WaitlistCancelled},
+		{name: "leaving with an offer declines it", from: domain.WaitlistOffered, action: func(e *domain.WaitlistEntry) error { return e.Leave(registration) }, want: domain.WaitlistDeclined},
+		{name: "cannot leave once accepted", from: domain.WaitlistAccepted, action: func(e *domain.WaitlistEntry) error { return e.Leave(registration) }, wantErr: domain.ErrNotWaitlisted},
+		{name: "cannot leave an expired offer", from: domain.WaitlistExpired, action: func(e *domain.WaitlistEntry) error { return e.Leave(registration) }, wantErr: domain.ErrNotWaitlisted},
+		{name: "accepts before the offer expires", from: domain.WaitlistOffered, action: func(e *domain.WaitlistEntry) error { return e.Accept(expires.Add(-time.Second)) }, want: domain.WaitlistAccepted},
+		{name: "refuses accepting when it expires", from: domain.WaitlistOffered, action: func(e *domain.WaitlistEntry) error { return e.Accept(expires) }, wantErr: domain.ErrOfferExpired},
+		{name: "refuses accepting without an offer", from: domain.WaitlistWaiting, action: func(e *domain.WaitlistEntry) error { return e.Accept(registration) }, wantErr: domain.ErrNoOffer},
+		{name: "refuses accepting a declined offer", from: domain.WaitlistDeclined, action: func(e *domain.WaitlistEntry) error { return e.Accept(registration) }, wantErr: domain.ErrNoOffer},
+		{name: "expires an offer", from: domain.WaitlistOffered, action: func(e *domain.WaitlistEntry) error { e.Expire(expires); return nil }, want: domain.WaitlistExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := domain.NewWaitlistEntry(valueobject.NewID(), valueobject.NewID(), registration.Add(-time.Hour))
+			if tt.from == domain.WaitlistOffered {
+				e.Offer(registration, 24*time.Hour)
+			}
+			e.Status = tt.from
+
+			err := tt.action(e)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			want := tt.want
+			if tt.wantErr != nil {
+				want = tt.from
+			}
+			if e.Status != want {
+				t.Errorf("got status %s, want %s", e.Status, want)
+			}
+		})
+	}
+}
+
+func TestWaitlistEntryOffer(t *testing.T) {
+	e := domain.NewWaitlistEntry(valueobject.NewID(), valueobject.NewID(), registration.Add(-time.Hour))
+	if e.Status != domain.WaitlistWaiting || !e.Status.IsActive() {
+		t.Fatalf("got status %s, want an active waiting entry", e.Status)
+	}
+	e.Position = 3
+
+	e.Offer(registration, 24*time.Hour)
+	if e.Status != domain.WaitlistOffered || e.Position != 0 || !e.Status.IsActive() {
+		t.Errorf("got status %s at position %d, want an active offer out of the queue", e.Status, e.Position)
+	}
+	if !e.OfferedAt.Equal(registration) || !e.OfferExpiresAt.Equal(registration.Add(24*time.Hour)) {
+		t.Errorf("got an offer from %s to %s", e.OfferedAt, e.OfferExpiresAt)
+	}
+
+	for _, tt := range []struct {
+		at   time.Time
+		want bool
+	}{
+		{at: registration, want: true},
+		{at: registration.Add(24*time.Hour - time.Second), want: true},
+		{at: registration.Add(24 * time.Hour)},
+	} {
+		if got := e.HoldsSeat(tt.at); got != tt.want {
+			t.Errorf("%s: got holds seat %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	for _, s := range []domain.WaitlistStatus{domain.WaitlistAccepted, domain.WaitlistDeclined, domain.WaitlistExpired, domain.WaitlistCancelled} {
+		if s.IsActive() {
+			t.Errorf("%s must not hold a place in the waitlist", s)
+		}
+	}
+}
+
+func TestAdmissionCheckWaitlist(t *testing.T) {
+	ticket := registration.Add(time.Hour)
+	clash := schedule(t, []string{"Mon"}, "08:00", "10:00", "")
+
+	tests := []struct {
+		name      string
+		change    func(a *domain.Admission)
+		now       time.Time
+		wantJoin  error
+		wantOffer error
+		wantCheck error
+	}{
+		{name: "full section", wantCheck: domain.ErrSectionFull},
+		{name: "free seats and nobody waiting", change: func(a *domain.Admission) { a.Enrolled = 1 }, wantJoin: domain.ErrSeatsAvailable},
+		{name: "free seat behind the queue", change: func(a *domain.Admission) { a.Enrolled, a.Waiting = 1, 1 }, wantCheck: domain.ErrSectionFull},
+		{name: "free seat offered to someone", change: func(a *domain.Admission) { a.Enrolled, a.Held = 1, 1 }, wantCheck: domain.ErrSectionFull},
+		{name: "inactive student", change: func(a *domain.Admission) { a.StudentActive = false }, wantJoin: domain.ErrStudentNotActive, wantOffer: domain.ErrStudentNotActive, wantCheck: domain.ErrStudentNotActive},
+		{name: "registration hold", change: func(a *domain.Admission) { a.Holds = []domain.Hold{{Type: "financial"}} }, wantJoin: domain.ErrRegistrationHold, wantOffer: domain.ErrRegistrationHold, wantCheck: domain.ErrRegistrationHold},
+		{name: "inactive section", change: func(a *domain.Admission) { a.SectionActive = false }, wantJoin: domain.ErrSectionInactive, wantOffer: domain.ErrSectionInactive, wantCheck: domain.ErrSectionInactive},
+		{name: "after the add deadline", now: time.Date(2025, time.August, 30, 0, 0, 0, 0, time.UTC), wantJoin: domain.ErrRegistrationClosed, wantOffer: domain.ErrRegistrationClosed, wantCheck: domain.ErrRegistrationClosed},
+		// an offer is only made once the ticket started
+		{name: "before the time ticket", change: func(a *domain.Admission) { a.TicketAt = &ticket }, wantJoin: domain.ErrBeforeTimeTicket, wantCheck: domain.ErrBeforeTimeTicket},
+		{name: "missing requirements", change: func(a *domain.Admission) { a.Requirements = []domain.Requirement{{CourseCode: "MAT101"}} }, wantJoin: domain.ErrRequirementsNotMet, wantOffer: domain.ErrRequirementsNotMet, wantCheck: domain.ErrRequirementsNotMet},
+		// joining does not hold a seat, the clash is checked on the offer
+		{name: "schedule clash", change: func(a *domain.Admission) {
+			a.Schedule = clash
+			a.Taken = []domain.ScheduledSection{{CourseCode: "PHY101", Schedule: clash}}
+		}, wantOffer: domain.ErrScheduleClash, wantCheck: domain.ErrScheduleClash},
+		{name: "over the credit limit", change: func(a *domain.Admission) { a.Load.Credits = 16 }, wantJoin: domain.ErrCreditLimitExceeded, wantOffer: domain.ErrCreditLimitExceeded, wantCheck: domain.ErrCreditLimitExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := admission()
+			if tt.change != nil {
+				tt.change(&a)
+			}
+			now := tt.now
+			if now.IsZero() {
+				now = registration
+			}
+
+			if err := a.CheckWaitlist(now); !errors.Is(err, tt.wantJoin) {
+				t.Errorf("joining: got error %v, want %v", err, tt.wantJoin)
+			}
+			if err := a.CheckOffer(now); !errors.Is(err, tt.wantOffer) {
+				t.Errorf("accepting the offer: got error %v, want %v", err, tt.wantOffer)
+			}
+			if err := a.Check(now); !errors.Is(err, tt.wantCheck) {
+				t.Errorf("enrolling: got error %v, want %v", err, tt.wantCheck)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type enrollmentHandler struct {
+	interactor application.EnrollmentInteractor
+}
+
+func NewEnrollmentHandler(uc application.EnrollmentInteractor) *enrollmentHandler {
+	return &enrollmentHandler{uc}
+}
+
+func (h enrollmentHandler) Enroll(c fiber.Ctx) error {
+	var req application.EnrollInput
+
+	// students enrolling themselves may send no body at all
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	data, err := h.interactor.Enroll(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h enrollmentHandler) Drop(c fiber.Ctx) error {
+	data, err := h.interactor.Drop(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId"), c.Query("override") == "true")
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h enrollmentHandler) ListBySection(c fiber.Ctx) error {
+	data, err := h.interactor.ListBySection(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+// Schedule returns the weekly timetable of the student, ?period selects the
+// academic period and defaults to the active one
+func (h enrollmentHandler) Schedule(c fiber.Ctx) error {
+	data, err := h.interactor.Schedule(c.Context(), httpx.Actor(c), c.Params("id"), c.Query("period"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+type waitlistHandler struct {
+	interactor application.WaitlistInteractor
+}
+
+func NewWaitlistHandler(uc application.WaitlistInteractor) *waitlistHandler {
+	return &waitlistHandler{uc}
+}
+
+func (h waitlistHandler) Join(c fiber.Ctx) error {
+	var req application.EnrollInput
+
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	data, err := h.interactor.Join(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h waitlistHandler) Leave(c fiber.Ctx) error {
+	if err := h.interactor.Leave(c.Context(), httpx.Actor(c), c.Params("id"), c.Query("student_id")); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h waitlistHandler) Accept(c fiber.Ctx) error {
+	var req application.EnrollInput
+
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	data, err := h.interactor.Accept(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h waitlistHandler) Position(c fiber.Ctx) error {
+	data, err := h.interactor.Position(c.Context(), httpx.Actor(c), c.Params("id"), c.Query("student_id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h waitlistHandler) List(c fiber.Ctx) error {
+	data, err := h.interactor.List(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
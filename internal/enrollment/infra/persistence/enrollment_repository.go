@@ -0,0 +1,713 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	sectiondomain "github.com/Jose-Salazar-27/go-university-server/internal/section/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const enrollmentColumns = `
+	id,
+	student_id,
+	course_section_id,
+	COALESCE(enrollment_date, CURRENT_DATE),
+	COALESCE(status, 'enrolled'),
+	final_grade,
+	COALESCE(letter_grade, ''),
+	credits_earned,
+	clash_override_by,
+	drop_override_by,
+	COALESCE(created_at, NOW()),
+	COALESCE(updated_at, NOW())
+`
+
+type postgresEnrollmentRepository struct {
+	pool *sql.DB
+}
+
+func NewEnrollmentRepository(db *sql.DB) *postgresEnrollmentRepository {
+	return &postgresEnrollmentRepository{db}
+}
+
+func (r postgresEnrollmentRepository) Enroll(ctx context.Context, e *domain.Enrollment) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	admission, err := lockAdmission(ctx, tx, e.StudentID, e.SectionID, e.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	admission.AllowClashes = e.ClashOverrideBy != nil
+
+	if err := admission.Check(e.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := insertEnrollment(ctx, tx, e); err != nil {
+		return err
+	}
+
+	load := admission.LoadAfterEnroll()
+	e.CreditLoad = &load
+
+	return tx.Commit()
+}
+
+func (r postgresEnrollmentRepository) Drop(ctx context.Context, req domain.DropRequest) (*domain.Enrollment, error) {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	sectionID, studentID := req.SectionID, req.StudentID
+
+	admission, err := lockSection(ctx, tx, sectionID, req.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := lockStudent(ctx, tx, studentID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + enrollmentColumns + `
+		FROM enrollments
+		WHERE student_id = $1 AND course_section_id = $2
+		FOR UPDATE
+	`
+	enrollment, err := scanEnrollment(tx.QueryRowContext(ctx, query, studentID.String(), sectionID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := enrollment.Drop(req.Now, admission.Window, req.OverrideBy); err != nil {
+		return nil, err
+	}
+
+	if admission.Load, err = creditLoad(ctx, tx, studentID, admission.Window.PeriodID); err != nil {
+		return nil, err
+	}
+
+	// the minimum load only binds while the schedule is being built,
+	// withdrawals and admin overrides may leave the student below it
+	if enrollment.Status == domain.StatusDropped && req.OverrideBy == nil {
+		if err := admission.CheckDrop(); err != nil {
+			return nil, err
+		}
+	}
+
+	update := `
+		UPDATE enrollments SET
+			status = $2,
+			letter_grade = NULLIF($3, ''),
+			drop_override_by = $4,
+			updated_at = $5
+		WHERE id = $1
+	`
+	if _, err := tx.ExecContext(ctx, update,
+		enrollment.ID.String(),
+		string(enrollment.Status),
+		enrollment.LetterGrade,
+		nullableID(enrollment.DropOverrideBy),
+		enrollment.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	load := admission.LoadAfterDrop()
+	enrollment.CreditLoad = &load
+
+	return enrollment, tx.Commit()
+}
+
+// lockAdmission locks the section row first and the student row second and
+// reads the Admission of the student
+func lockAdmission(ctx context.Context, tx *sql.Tx, studentID, sectionID valueobject.ID, now time.Time) (domain.Admission, error) {
+	admission, err := lockSection(ctx, tx, sectionID, now)
+	if err != nil {
+		return domain.Admission{}, err
+	}
+
+	if admission.StudentActive, err = lockStudent(ctx, tx, studentID); err != nil {
+		return domain.Admission{}, err
+	}
+
+	if admission.Holds, err = activeHolds(ctx, tx, studentID, now); err != nil {
+		return domain.Admission{}, err
+	}
+
+	if admission.TicketAt, err = ticketStart(ctx, tx, studentID, admission.Window.PeriodID); err != nil {
+		return domain.Admission{}, err
+	}
+
+	status, found, err := enrollmentStatus(ctx, tx, studentID, sectionID)
+	if err != nil {
+		return domain.Admission{}, err
+	}
+	if found && status != domain.StatusDropped {
+		return domain.Admission{}, domain.ErrAlreadyEnrolled
+	}
+
+	if admission.Requirements, admission.Overridden, err = requirements(ctx, tx, studentID, sectionID); err != nil {
+		return domain.Admission{}, err
+	}
+
+	if admission.Load, err = creditLoad(ctx, tx, studentID, admission.Window.PeriodID); err != nil {
+		return domain.Admission{}, err
+	}
+
+	taken, err := scheduledSections(ctx, tx, studentID, admission.Window.PeriodID)
+	if err != nil {
+		return domain.Admission{}, err
+	}
+	for _, section := range taken {
+		if !section.SectionID.Equals(sectionID) {
+			admission.Taken = append(admission.Taken, section)
+		}
+	}
+
+	return admission, nil
+}
+
+// activeHolds reads the registration holds that block the student at now
+func activeHolds(ctx context.Context, tx *sql.Tx, studentID valueobject.ID, now time.Time) ([]domain.Hold, error) {
+	query := `
+		SELECT id, hold_type, reason, expires_at
+		FROM student_holds
+		WHERE student_id = $1
+		  AND blocks_enrollment
+		  AND released_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY created_at
+	`
+	rows, err := tx.QueryContext(ctx, query, studentID.String(), now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holds []domain.Hold
+	for rows.Next() {
+		var (
+			h         domain.Hold
+			expiresAt sql.NullTime
+		)
+		if err := rows.Scan(&h.ID, &h.Type, &h.Reason, &expiresAt); err != nil {
+			return nil, err
+		}
+		h.ExpiresAt = nullableTime(expiresAt)
+		holds = append(holds, h)
+	}
+
+	return holds, rows.Err()
+}
+
+// ticketStart reads the published time ticket of the student, students left
+// out of the published tickets start with the last cohort
+func ticketStart(ctx context.Context, tx *sql.Tx, studentID, periodID valueobject.ID) (*time.Time, error) {
+	var startsAt sql.NullTime
+	query := `
+		SELECT COALESCE(
+			(SELECT starts_at FROM registration_tickets
+			 WHERE academic_period_id = $1 AND student_id = $2 AND published_at IS NOT NULL),
+			(SELECT MAX(starts_at) FROM registration_tickets
+			 WHERE academic_period_id = $1 AND published_at IS NOT NULL)
+		)
+	`
+	if err := tx.QueryRowContext(ctx, query, periodID.String(), studentID.String()).Scan(&startsAt); err != nil {
+		return nil, err
+	}
+	return nullableTime(startsAt), nil
+}
+
+// requirements reads the mandatory prerequisites and corequisites of the
+// section course and whether the student meets them or has an override
+func requirements(ctx context.Context, tx *sql.Tx, studentID, sectionID valueobject.ID) ([]domain.Requirement, bool, error) {
+	var overridden bool
+	override := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM prerequisite_overrides o
+			JOIN course_sections s ON s.course_id = o.course_id
+			WHERE s.id = $1 AND o.student_id = $2
+		)
+	`
+	if err := tx.QueryRowContext(ctx, override, sectionID.String(), studentID.String()).Scan(&overridden); err != nil {
+		return nil, false, err
+	}
+
+	// a prerequisite is met by a completed enrollment with the minimum
+	// grade, a corequisite also by an enrollment in the same period
+	query := `
+		SELECT
+			c.id,
+			c.code,
+			c.name,
+			cp.minimum_grade,
+			COALESCE(cp.is_corequisite, false),
+			EXISTS (
+				SELECT 1
+				FROM enrollments e
+				JOIN course_sections es ON es.id = e.course_section_id
+				WHERE e.student_id = $2
+				  AND es.course_id = cp.prerequisite_id
+				  AND e.status = 'completed'
+				  AND (cp.minimum_grade IS NULL OR e.final_grade >= cp.minimum_grade)
+			) OR (COALESCE(cp.is_corequisite, false) AND EXISTS (
+				SELECT 1
+				FROM enrollments e
+				JOIN course_sections es ON es.id = e.course_section_id
+				WHERE e.student_id = $2
+				  AND es.course_id = cp.prerequisite_id
+				  AND es.academic_period_id = s.academic_period_id
+				  AND e.status = 'enrolled'
+			))
+		FROM course_sections s
+		JOIN course_prerequisites cp ON cp.course_id = s.course_id
+		JOIN courses c ON c.id = cp.prerequisite_id
+		WHERE s.id = $1 AND COALESCE(cp.is_mandatory, true)
+		ORDER BY c.code
+	`
+	rows, err := tx.QueryContext(ctx, query, sectionID.String(), studentID.String())
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	var list []domain.Requirement
+	for rows.Next() {
+		var (
+			r            domain.Requirement
+			minimumGrade sql.NullFloat64
+		)
+		if err := rows.Scan(&r.CourseID, &r.CourseCode, &r.CourseName, &minimumGrade, &r.IsCorequisite, &r.Satisfied); err != nil {
+			return nil, false, err
+		}
+		if minimumGrade.Valid {
+			r.MinimumGrade = &minimumGrade.Float64
+		}
+		list = append(list, r)
+	}
+
+	return list, overridden, rows.Err()
+}
+
+// lockSection locks the section row and counts its seats, every change to
+// the seats of a section waits here for the previous one to commit
+func lockSection(ctx context.Context, tx *sql.Tx, sectionID valueobject.ID, now time.Time) (domain.Admission, error) {
+	var (
+		admission domain.Admission
+		schedule  []byte
+		err       error
+	)
+
+	section := `
+		SELECT
+			COALESCE(s.is_active, true),
+			COALESCE(s.capacity, 30),
+			s.schedule,
+			c.credits,
+			p.id,
+			p.status,
+			p.registration_start,
+			COALESCE(p.add_deadline, p.registration_end),
+			COALESCE(p.drop_deadline, p.add_deadline, p.registration_end),
+			COALESCE(p.withdrawal_deadline, p.end_date)
+		FROM course_sections s
+		JOIN courses c ON c.id = s.course_id
+		JOIN academic_periods p ON p.id = s.academic_period_id
+		WHERE s.id = $1
+		FOR UPDATE OF s
+	`
+	if err := tx.QueryRowContext(ctx, section, sectionID.String()).Scan(
+		&admission.SectionActive,
+		&admission.Capacity,
+		&schedule,
+		&admission.SectionCredits,
+		&admission.Window.PeriodID,
+		&admission.Window.PeriodStatus,
+		&admission.Window.Start,
+		&admission.Window.End,
+		&admission.Window.DropDeadline,
+		&admission.Window.WithdrawalDeadline,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Admission{}, domain.ErrSectionNotFound
+		}
+		return domain.Admission{}, err
+	}
+	if admission.Schedule, err = decodeSchedule(sectionID, schedule); err != nil {
+		return domain.Admission{}, err
+	}
+
+	// only waiters OfferSeats would offer a seat to hold one back, the
+	// skipped ones would otherwise keep the section full for good
+	seats := `
+		SELECT
+			(SELECT COUNT(*) FROM enrollments WHERE course_section_id = $1 AND status = 'enrolled'),
+			(SELECT COUNT(*) FROM section_waitlist WHERE course_section_id = $1 AND status = 'offered' AND offer_expires_at > $2),
+			(SELECT COUNT(*) FROM section_waitlist w
+			 JOIN students st ON st.id = w.student_id
+			 WHERE w.course_section_id = $1
+			   AND w.status = 'waiting'
+			   AND COALESCE(st.current_status, 'active') = 'active'
+			   AND NOT EXISTS (
+				SELECT 1 FROM enrollments e
+				WHERE e.student_id = w.student_id
+				  AND e.course_section_id = w.course_section_id
+				  AND e.status <> 'dropped'
+			   ))
+	`
+	if err := tx.QueryRowContext(ctx, seats, sectionID.String(), now).Scan(
+		&admission.Enrolled,
+		&admission.Held,
+		&admission.Waiting,
+	); err != nil {
+		return domain.Admission{}, err
+	}
+
+	return admission, nil
+}
+
+// creditLoad reads the credits the student holds in the period, the limits
+// of their degree and their average final grade
+func creditLoad(ctx context.Context, tx *sql.Tx, studentID, periodID valueobject.ID) (domain.CreditLoad, error) {
+	var (
+		credits int
+		limits  domain.CreditLimits
+		average sql.NullFloat64
+	)
+
+	// students without a degree get the defaults of the degrees table
+	query := `
+		SELECT
+			(
+				SELECT COALESCE(SUM(c.credits), 0)
+				FROM enrollments e
+				JOIN course_sections s ON s.id = e.course_section_id
+				JOIN courses c ON c.id = s.course_id
+				WHERE e.student_id = st.id
+				  AND s.academic_period_id = $2
+				  AND e.status = 'enrolled'
+			),
+			COALESCE(d.min_term_credits, 12),
+			COALESCE(d.max_term_credits, 18),
+			COALESCE(d.good_standing_max_credits, 21),
+			COALESCE(d.good_standing_average, 80),
+			(
+				SELECT SUM(e.final_grade * c.credits) / NULLIF(SUM(c.credits), 0)
+				FROM enrollments e
+				JOIN course_sections s ON s.id = e.course_section_id
+				JOIN courses c ON c.id = s.course_id
+				WHERE e.student_id = st.id
+				  AND e.status IN ('completed', 'failed')
+				  AND e.final_grade IS NOT NULL
+			)
+		FROM students st
+		LEFT JOIN degrees d ON d.id = st.degree_id
+		WHERE st.id = $1
+	`
+	if err := tx.QueryRowContext(ctx, query, studentID.String(), periodID.String()).Scan(
+		&credits,
+		&limits.Minimum,
+		&limits.Maximum,
+		&limits.GoodStandingMaximum,
+		&limits.GoodStandingAverage,
+		&average,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.CreditLoad{}, domain.ErrStudentNotFound
+		}
+		return domain.CreditLoad{}, err
+	}
+
+	var avg *float64
+	if average.Valid {
+		avg = &average.Float64
+	}
+
+	return domain.NewCreditLoad(periodID, credits, limits, avg), nil
+}
+
+// lockStudent locks the student row and tells if the student is active
+func lockStudent(ctx context.Context, tx *sql.Tx, studentID valueobject.ID) (bool, error) {
+	var status string
+	query := `SELECT COALESCE(current_status, 'active') FROM students WHERE id = $1 FOR UPDATE`
+	if err := tx.QueryRowContext(ctx, query, studentID.String()).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, domain.ErrStudentNotFound
+		}
+		return false, err
+	}
+	return status == "active", nil
+}
+
+func enrollmentStatus(ctx context.Context, tx *sql.Tx, studentID, sectionID valueobject.ID) (domain.Status, bool, error) {
+	var status string
+	query := `SELECT COALESCE(status, 'enrolled') FROM enrollments WHERE student_id = $1 AND course_section_id = $2`
+	err := tx.QueryRowContext(ctx, query, studentID.String(), sectionID.String()).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	return domain.Status(status), err == nil, err
+}
+
+// insertEnrollment stores the enrollment, a previously dropped enrollment of
+// the same student and section is reused since the pair is unique
+func insertEnrollment(ctx context.Context, tx *sql.Tx, e *domain.Enrollment) error {
+	insert := `
+		INSERT INTO enrollments (
+			id,
+			student_id,
+			course_section_id,
+			enrollment_date,
+			status,
+			clash_override_by,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (student_id, course_section_id) DO UPDATE SET
+			enrollment_date = EXCLUDED.enrollment_date,
+			status = EXCLUDED.status,
+			final_grade = NULL,
+			letter_grade = NULL,
+			credits_earned = NULL,
+			clash_override_by = EXCLUDED.clash_override_by,
+			drop_override_by = NULL,
+			updated_at = EXCLUDED.updated_at
+		WHERE enrollments.status = 'dropped'
+		RETURNING id
+	`
+	var id valueobject.ID
+	if err := tx.QueryRowContext(ctx, insert,
+		e.ID.String(),
+		e.StudentID.String(),
+		e.SectionID.String(),
+		e.EnrollmentDate,
+		string(e.Status),
+		nullableID(e.ClashOverrideBy),
+		e.CreatedAt,
+		e.UpdatedAt,
+	).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrAlreadyEnrolled
+		}
+		return exchangeError(err)
+	}
+
+	e.ID = id
+	return nil
+}
+
+func (r postgresEnrollmentRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.Enrollment, error) {
+	query := `SELECT ` + enrollmentColumns + ` FROM enrollments WHERE id = $1`
+
+	enrollment, err := scanEnrollment(r.pool.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrEnrollmentNotFound
+	}
+	return enrollment, err
+}
+
+func (r postgresEnrollmentRepository) ListBySection(ctx context.Context, sectionID valueobject.ID) ([]*domain.Enrollment, error) {
+	query := `
+		SELECT ` + enrollmentColumns + `
+		FROM enrollments
+		WHERE course_section_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var enrollments []*domain.Enrollment
+	for rows.Next() {
+		enrollment, err := scanEnrollment(rows)
+		if err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, enrollment)
+	}
+
+	return enrollments, rows.Err()
+}
+
+func (r postgresEnrollmentRepository) ListSchedule(ctx context.Context, studentID, periodID valueobject.ID) ([]domain.ScheduledSection, error) {
+	return scheduledSections(ctx, r.pool, studentID, periodID)
+}
+
+func (r postgresEnrollmentRepository) CurrentPeriod(ctx context.Context) (valueobject.ID, error) {
+	var id valueobject.ID
+	err := r.pool.QueryRowContext(ctx, `SELECT id FROM academic_periods WHERE is_active LIMIT 1`).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return valueobject.ID{}, domain.ErrNoActivePeriod
+	}
+	if err != nil {
+		return valueobject.ID{}, err
+	}
+	return id, nil
+}
+
+// scheduledSections reads the sections of the period the student holds a seat in
+func scheduledSections(ctx context.Context, q querier, studentID, periodID valueobject.ID) ([]domain.ScheduledSection, error) {
+	query := `
+		SELECT s.id, c.code, c.name, s.section_code, s.schedule
+		FROM enrollments e
+		JOIN course_sections s ON s.id = e.course_section_id
+		JOIN courses c ON c.id = s.course_id
+		WHERE e.student_id = $1
+		  AND s.academic_period_id = $2
+		  AND e.status = 'enrolled'
+		ORDER BY c.code, s.section_code
+	`
+	rows, err := q.QueryContext(ctx, query, studentID.String(), periodID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sections []domain.ScheduledSection
+	for rows.Next() {
+		var (
+			section  domain.ScheduledSection
+			schedule []byte
+		)
+		if err := rows.Scan(&section.SectionID, &section.CourseCode, &section.CourseName, &section.SectionCode, &schedule); err != nil {
+			return nil, err
+		}
+		if section.Schedule, err = decodeSchedule(section.SectionID, schedule); err != nil {
+			return nil, err
+		}
+		sections = append(sections, section)
+	}
+
+	return sections, rows.Err()
+}
+
+// decodeSchedule reads a stored schedule. A schedule that can't be parsed
+// fails the read, the section would otherwise skip the clash check.
+func decodeSchedule(sectionID valueobject.ID, data []byte) (*sectiondomain.Schedule, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var stored struct {
+		Days      []string `json:"days"`
+		StartTime string   `json:"start_time"`
+		EndTime   string   `json:"end_time"`
+		Room      string   `json:"room"`
+	}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("%w: section %s: %v", domain.ErrInvalidSchedule, sectionID, err)
+	}
+
+	schedule, err := sectiondomain.NewSchedule(stored.Days, stored.StartTime, stored.EndTime, stored.Room)
+	if err != nil {
+		return nil, fmt.Errorf("%w: section %s: %v", domain.ErrInvalidSchedule, sectionID, err)
+	}
+	return &schedule, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEnrollment(row scanner) (*domain.Enrollment, error) {
+	var (
+		id, studentID, sectionID        valueobject.ID
+		clashOverrideBy, dropOverrideBy valueobject.ID
+		enrollmentDate                  time.Time
+		status, letterGrade             string
+		finalGrade                      sql.NullFloat64
+		creditsEarned                   sql.NullInt64
+		createdAt, updatedAt            time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&studentID,
+		&sectionID,
+		&enrollmentDate,
+		&status,
+		&finalGrade,
+		&letterGrade,
+		&creditsEarned,
+		&clashOverrideBy,
+		&dropOverrideBy,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var (
+		grade   *float64
+		credits *int
+	)
+	if finalGrade.Valid {
+		grade = &finalGrade.Float64
+	}
+	if creditsEarned.Valid {
+		c := int(creditsEarned.Int64)
+		credits = &c
+	}
+
+	return domain.EnrollmentFromPersistence(
+		id,
+		studentID,
+		sectionID,
+		enrollmentDate,
+		domain.Status(status),
+		grade,
+		letterGrade,
+		credits,
+		optionalID(clashOverrideBy),
+		optionalID(dropOverrideBy),
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+func exchangeError(err error) error {
+	if ok, pgerr := db.IsPgError(err); ok {
+		if db.IsUniqueConstraintViolation(pgerr) {
+			return domain.ErrAlreadyEnrolled
+		}
+		return db.ExchangePGError(pgerr)
+	}
+	return err
+}
+
+func nullableID(id *valueobject.ID) sql.NullString {
+	if id == nil || id.IsEmpty() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: id.String(), Valid: true}
+}
+
+// optionalID turns the empty ID a NULL column is scanned into back into nil
+func optionalID(id valueobject.ID) *valueobject.ID {
+	if id.IsEmpty() {
+		return nil
+	}
+	return &id
+}
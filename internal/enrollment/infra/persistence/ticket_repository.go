@@ -0,0 +1,302 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/lib/pq"
+)
+
+const ticketColumns = `
+	id,
+	academic_period_id,
+	student_id,
+	starts_at,
+	priority_rank,
+	credits_earned,
+	COALESCE(degree_type, ''),
+	COALESCE(priority_groups, '{}'),
+	published_at,
+	created_at
+`
+
+type postgresTicketRepository struct {
+	pool *sql.DB
+}
+
+func NewTicketRepository(db *sql.DB) *postgresTicketRepository {
+	return &postgresTicketRepository{db}
+}
+
+func (r postgresTicketRepository) TicketPeriod(ctx context.Context, periodID valueobject.ID) (domain.TicketPeriod, error) {
+	period := domain.TicketPeriod{ID: periodID}
+
+	query := `SELECT registration_start FROM academic_periods WHERE id = $1`
+	if err := r.pool.QueryRowContext(ctx, query, periodID.String()).Scan(&period.RegistrationStart); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TicketPeriod{}, domain.ErrPeriodNotFound
+		}
+		return domain.TicketPeriod{}, err
+	}
+
+	return period, nil
+}
+
+func (r postgresTicketRepository) Candidates(ctx context.Context) ([]domain.TicketCandidate, error) {
+	// credits are earned by completed enrollments, older rows without
+	// credits_earned count the credits of the course
+	query := `
+		SELECT
+			st.id,
+			COALESCE((
+				SELECT SUM(COALESCE(e.credits_earned, c.credits))
+				FROM enrollments e
+				JOIN course_sections s ON s.id = e.course_section_id
+				JOIN courses c ON c.id = s.course_id
+				WHERE e.student_id = st.id AND e.status = 'completed'
+			), 0),
+			COALESCE(d.degree_type, ''),
+			st.enrollment_date,
+			COALESCE((
+				SELECT array_agg(g.group_code ORDER BY g.group_code)
+				FROM student_priority_groups g
+				WHERE g.student_id = st.id
+			), '{}')
+		FROM students st
+		LEFT JOIN degrees d ON d.id = st.degree_id
+		WHERE COALESCE(st.current_status, 'active') = 'active'
+	`
+	rows, err := r.pool.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []domain.TicketCandidate
+	for rows.Next() {
+		var c domain.TicketCandidate
+		if err := rows.Scan(&c.StudentID, &c.CreditsEarned, &c.DegreeType, &c.EnrollmentDate, pq.Array(&c.PriorityGroups)); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+
+	return candidates, rows.Err()
+}
+
+func (r postgresTicketRepository) Replace(ctx context.Context, periodID valueobject.ID, tickets []*domain.TimeTicket) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM registration_tickets WHERE academic_period_id = $1`, periodID.String()); err != nil {
+		return err
+	}
+
+	if len(tickets) == 0 {
+		return tx.Commit()
+	}
+
+	// the whole batch goes in a single statement, groups travel comma
+	// separated since unnest flattens nested arrays
+	var (
+		ids, students, starts, degrees, groups []string
+		ranks, credits                         []int64
+	)
+	for _, t := range tickets {
+		ids = append(ids, t.ID.String())
+		students = append(students, t.StudentID.String())
+		starts = append(starts, t.StartsAt.UTC().Format(time.RFC3339Nano))
+		ranks = append(ranks, int64(t.Rank))
+		credits = append(credits, int64(t.CreditsEarned))
+		degrees = append(degrees, t.DegreeType)
+		groups = append(groups, strings.Join(t.PriorityGroups, ","))
+	}
+
+	insert := `
+		INSERT INTO registration_tickets (
+			id,
+			academic_period_id,
+			student_id,
+			starts_at,
+			priority_rank,
+			credits_earned,
+			degree_type,
+			priority_groups,
+			created_at
+		)
+		SELECT
+			u.id,
+			$1,
+			u.student_id,
+			u.starts_at,
+			u.priority_rank,
+			u.credits_earned,
+			NULLIF(u.degree_type, ''),
+			string_to_array(NULLIF(u.priority_groups, ''), ','),
+			$2
+		FROM unnest($3::uuid[], $4::uuid[], $5::timestamptz[], $6::int[], $7::int[], $8::text[], $9::text[])
+			AS u(id, student_id, starts_at, priority_rank, credits_earned, degree_type, priority_groups)
+	`
+	if _, err := tx.ExecContext(ctx, insert,
+		periodID.String(),
+		tickets[0].CreatedAt,
+		pq.Array(ids),
+		pq.Array(students),
+		pq.Array(starts),
+		pq.Array(ranks),
+		pq.Array(credits),
+		pq.Array(degrees),
+		pq.Array(groups),
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresTicketRepository) Publish(ctx context.Context, periodID valueobject.ID, now time.Time) ([]*domain.TimeTicket, error) {
+	var exists bool
+	if err := r.pool.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM registration_tickets WHERE academic_period_id = $1)`,
+		periodID.String(),
+	).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrNoTickets
+	}
+
+	// tickets published before are left as they are
+	query := `
+		UPDATE registration_tickets SET published_at = $2
+		WHERE academic_period_id = $1 AND published_at IS NULL
+		RETURNING ` + ticketColumns
+	return queryTickets(ctx, r.pool, query, periodID.String(), now)
+}
+
+func (r postgresTicketRepository) ListByPeriod(ctx context.Context, periodID valueobject.ID) ([]*domain.TimeTicket, error) {
+	query := `
+		SELECT ` + ticketColumns + `
+		FROM registration_tickets
+		WHERE academic_period_id = $1
+		ORDER BY priority_rank
+	`
+	return queryTickets(ctx, r.pool, query, periodID.String())
+}
+
+func (r postgresTicketRepository) FindPublished(ctx context.Context, periodID, studentID valueobject.ID) (*domain.TimeTicket, error) {
+	query := `
+		SELECT ` + ticketColumns + `
+		FROM registration_tickets
+		WHERE academic_period_id = $1 AND student_id = $2 AND published_at IS NOT NULL
+	`
+	ticket, err := scanTicket(r.pool.QueryRowContext(ctx, query, periodID.String(), studentID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrTicketNotFound
+	}
+	return ticket, err
+}
+
+func (r postgresTicketRepository) SetPriorityGroups(ctx context.Context, studentID valueobject.ID, groups []string) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM students WHERE id = $1)`, studentID.String()).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrStudentNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM student_priority_groups WHERE student_id = $1`, studentID.String()); err != nil {
+		return err
+	}
+
+	insert := `
+		INSERT INTO student_priority_groups (student_id, group_code)
+		SELECT $1, unnest($2::text[])
+	`
+	if _, err := tx.ExecContext(ctx, insert, studentID.String(), pq.Array(groups)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func queryTickets(ctx context.Context, q querier, query string, args ...any) ([]*domain.TimeTicket, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tickets []*domain.TimeTicket
+	for rows.Next() {
+		ticket, err := scanTicket(rows)
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, rows.Err()
+}
+
+func scanTicket(row scanner) (*domain.TimeTicket, error) {
+	var (
+		id, periodID, studentID valueobject.ID
+		degreeType              string
+		rank, credits           int
+		groups                  []string
+		startsAt, createdAt     time.Time
+		publishedAt             sql.NullTime
+	)
+
+	if err := row.Scan(
+		&id,
+		&periodID,
+		&studentID,
+		&startsAt,
+		&rank,
+		&credits,
+		&degreeType,
+		pq.Array(&groups),
+		&publishedAt,
+		&createdAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if groups == nil {
+		groups = []string{}
+	}
+
+	return domain.TimeTicketFromPersistence(
+		id,
+		periodID,
+		studentID,
+		startsAt,
+		rank,
+		credits,
+		degreeType,
+		groups,
+		nullableTime(publishedAt),
+		createdAt,
+	), nil
+}
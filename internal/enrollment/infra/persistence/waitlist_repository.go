@@ -0,0 +1,381 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// waitlistColumns reads the entries of the ranked subquery w, position is
+// only numbered among waiting entries
+const waitlistColumns = `
+	w.id,
+	w.course_section_id,
+	w.student_id,
+	w.status,
+	CASE WHEN w.status = 'waiting' THEN w.position ELSE 0 END,
+	w.offered_at,
+	w.offer_expires_at,
+	w.created_at,
+	w.updated_at
+`
+
+const rankedWaitlist = `
+	SELECT
+		sw.*,
+		ROW_NUMBER() OVER (PARTITION BY sw.course_section_id, sw.status ORDER BY sw.created_at, sw.id) AS position
+	FROM section_waitlist sw
+	WHERE sw.course_section_id = $1 AND sw.status IN ('waiting', 'offered')
+`
+
+type postgresWaitlistRepository struct {
+	pool *sql.DB
+}
+
+func NewWaitlistRepository(db *sql.DB) *postgresWaitlistRepository {
+	return &postgresWaitlistRepository{db}
+}
+
+func (r postgresWaitlistRepository) Join(ctx context.Context, e *domain.WaitlistEntry) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	admission, err := lockAdmission(ctx, tx, e.StudentID, e.SectionID, e.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := admission.CheckWaitlist(e.CreatedAt); err != nil {
+		return err
+	}
+
+	insert := `
+		INSERT INTO section_waitlist (id, course_section_id, student_id, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	if _, err := tx.ExecContext(ctx, insert,
+		e.ID.String(),
+		e.SectionID.String(),
+		e.StudentID.String(),
+		string(e.Status),
+		e.CreatedAt,
+		e.UpdatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsUniqueConstraintViolation(pgerr) {
+				return domain.ErrAlreadyWaitlisted
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresWaitlistRepository) Leave(ctx context.Context, sectionID, studentID valueobject.ID, now time.Time) (*domain.WaitlistEntry, error) {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := lockSection(ctx, tx, sectionID, now); err != nil {
+		return nil, err
+	}
+
+	entry, err := lockEntry(ctx, tx, sectionID, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := entry.Leave(now); err != nil {
+		return nil, err
+	}
+
+	if err := updateEntry(ctx, tx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, tx.Commit()
+}
+
+func (r postgresWaitlistRepository) Accept(ctx context.Context, sectionID, studentID valueobject.ID, now time.Time) (*domain.Enrollment, error) {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	admission, err := lockAdmission(ctx, tx, studentID, sectionID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := lockEntry(ctx, tx, sectionID, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := admission.CheckOffer(now); err != nil {
+		return nil, err
+	}
+
+	if err := entry.Accept(now); err != nil {
+		return nil, err
+	}
+
+	enrollment := domain.NewEnrollment(studentID, sectionID, now)
+	if err := insertEnrollment(ctx, tx, enrollment); err != nil {
+		return nil, err
+	}
+
+	if err := updateEntry(ctx, tx, entry); err != nil {
+		return nil, err
+	}
+
+	load := admission.LoadAfterEnroll()
+	enrollment.CreditLoad = &load
+
+	return enrollment, tx.Commit()
+}
+
+func (r postgresWaitlistRepository) FindActive(ctx context.Context, sectionID, studentID valueobject.ID) (*domain.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM (` + rankedWaitlist + `) w
+		WHERE w.student_id = $2
+	`
+	entry, err := scanEntry(r.pool.QueryRowContext(ctx, query, sectionID.String(), studentID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotWaitlisted
+	}
+	return entry, err
+}
+
+func (r postgresWaitlistRepository) ListBySection(ctx context.Context, sectionID valueobject.ID) ([]*domain.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM (` + rankedWaitlist + `) w
+		ORDER BY w.status = 'waiting', w.created_at, w.id
+	`
+	return queryEntries(ctx, r.pool, query, sectionID.String())
+}
+
+func (r postgresWaitlistRepository) ExpireOffers(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error) {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// offers can't be accepted once the add deadline of the period passed,
+	// the seat is withdrawn right away
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM (SELECT sw.*, 0 AS position FROM section_waitlist sw) w
+		WHERE w.status = 'offered' AND (
+			w.offer_expires_at <= $1
+			OR w.course_section_id IN (
+				SELECT cs.id
+				FROM course_sections cs
+				JOIN academic_periods p ON p.id = cs.academic_period_id
+				WHERE p.status NOT IN ('registration_open', 'in_progress')
+				   OR COALESCE(p.add_deadline, p.registration_end) < $2::date
+			)
+		)
+		FOR UPDATE
+	`
+	entries, err := queryEntries(ctx, tx, query, now, now.Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.Expire(now)
+		if err := updateEntry(ctx, tx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, tx.Commit()
+}
+
+func (r postgresWaitlistRepository) OfferSeats(ctx context.Context, sectionID valueobject.ID, now time.Time, window time.Duration) ([]*domain.WaitlistEntry, error) {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	admission, err := lockSection(ctx, tx, sectionID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// seats freed after the add deadline are not offered, nobody could take them
+	free := admission.FreeSeats()
+	if free == 0 || !admission.SectionActive || !admission.Window.IsOpen(now) {
+		return nil, nil
+	}
+
+	// students that are no longer active or got a seat some other way keep
+	// their place but are skipped
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM (SELECT sw.*, 0 AS position FROM section_waitlist sw) w
+		JOIN students st ON st.id = w.student_id
+		WHERE w.course_section_id = $1
+		  AND w.status = 'waiting'
+		  AND COALESCE(st.current_status, 'active') = 'active'
+		  AND NOT EXISTS (
+			SELECT 1 FROM enrollments e
+			WHERE e.student_id = w.student_id
+			  AND e.course_section_id = w.course_section_id
+			  AND e.status <> 'dropped'
+		  )
+		ORDER BY w.created_at, w.id
+		LIMIT $2
+	`
+	entries, err := queryEntries(ctx, tx, query, sectionID.String(), free)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.Offer(now, window)
+		if err := updateEntry(ctx, tx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, tx.Commit()
+}
+
+func (r postgresWaitlistRepository) SectionsWithWaitlist(ctx context.Context) ([]valueobject.ID, error) {
+	rows, err := r.pool.QueryContext(ctx, `SELECT DISTINCT course_section_id FROM section_waitlist WHERE status = 'waiting'`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sections []valueobject.ID
+	for rows.Next() {
+		var id valueobject.ID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		sections = append(sections, id)
+	}
+
+	return sections, rows.Err()
+}
+
+func lockEntry(ctx context.Context, tx *sql.Tx, sectionID, studentID valueobject.ID) (*domain.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM (SELECT sw.*, 0 AS position FROM section_waitlist sw) w
+		WHERE w.course_section_id = $1
+		  AND w.student_id = $2
+		  AND w.status IN ('waiting', 'offered')
+		FOR UPDATE
+	`
+	entry, err := scanEntry(tx.QueryRowContext(ctx, query, sectionID.String(), studentID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotWaitlisted
+	}
+	return entry, err
+}
+
+func updateEntry(ctx context.Context, tx *sql.Tx, e *domain.WaitlistEntry) error {
+	query := `
+		UPDATE section_waitlist SET
+			status = $2,
+			offered_at = $3,
+			offer_expires_at = $4,
+			updated_at = $5
+		WHERE id = $1
+	`
+	_, err := tx.ExecContext(ctx, query,
+		e.ID.String(),
+		string(e.Status),
+		e.OfferedAt,
+		e.OfferExpiresAt,
+		e.UpdatedAt,
+	)
+	return err
+}
+
+func queryEntries(ctx context.Context, q querier, query string, args ...any) ([]*domain.WaitlistEntry, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*domain.WaitlistEntry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func scanEntry(row scanner) (*domain.WaitlistEntry, error) {
+	var (
+		id, sectionID, studentID  valueobject.ID
+		status                    string
+		position                  int
+		offeredAt, offerExpiresAt sql.NullTime
+		createdAt, updatedAt      time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&sectionID,
+		&studentID,
+		&status,
+		&position,
+		&offeredAt,
+		&offerExpiresAt,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return domain.WaitlistEntryFromPersistence(
+		id,
+		sectionID,
+		studentID,
+		domain.WaitlistStatus(status),
+		position,
+		nullableTime(offeredAt),
+		nullableTime(offerExpiresAt),
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+func nullableTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,50 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/infra/persistence"
+)
+
+func TestIneligibleWaitersDoNotHoldSeats(t *testing.T) {
+	db := openTestDB(t)
+	f := fixture{t, db}
+	section := f.section(f.openPeriod(), 2)
+	students := f.students(4)
+	enrolled, suspended, waiting, newcomer := students[0], students[1], students[2], students[3]
+
+	ctx := context.Background()
+	repo := persistence.NewEnrollmentRepository(db)
+	if err := repo.Enroll(ctx, domain.NewEnrollment(enrolled, section, time.Now())); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the enrolled student left a stale entry behind, the other two wait
+	// for the free seat
+	for _, student := range []string{enrolled.String(), suspended.String(), waiting.String()} {
+		f.exec(`INSERT INTO section_waitlist (course_section_id, student_id) VALUES ($1, $2)`, section.String(), student)
+	}
+
+	err := repo.Enroll(ctx, domain.NewEnrollment(newcomer, section, time.Now()))
+	if !errors.Is(err, domain.ErrSectionFull) {
+		t.Fatalf("got error %v with a student waiting, want %v", err, domain.ErrSectionFull)
+	}
+
+	f.exec(`UPDATE students SET current_status = 'suspended' WHERE id IN ($1, $2)`, suspended.String(), waiting.String())
+
+	offered, err := persistence.NewWaitlistRepository(db).OfferSeats(ctx, section, time.Now(), time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(offered) != 0 {
+		t.Fatalf("got %d offers, want the ineligible waiters skipped", len(offered))
+	}
+
+	if err := repo.Enroll(ctx, domain.NewEnrollment(newcomer, section, time.Now())); err != nil {
+		t.Errorf("got error %v, want the seat the skipped waiters cannot take", err)
+	}
+}
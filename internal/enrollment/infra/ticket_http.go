@@ -0,0 +1,77 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/enrollment/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type ticketHandler struct {
+	interactor application.TicketInteractor
+}
+
+func NewTicketHandler(uc application.TicketInteractor) *ticketHandler {
+	return &ticketHandler{uc}
+}
+
+// Generate runs the batch that assigns the time tickets of the period
+func (h ticketHandler) Generate(c fiber.Ctx) error {
+	var req application.GenerateTicketsInput
+
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	data, err := h.interactor.Generate(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h ticketHandler) Publish(c fiber.Ctx) error {
+	data, err := h.interactor.Publish(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h ticketHandler) List(c fiber.Ctx) error {
+	data, err := h.interactor.List(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h ticketHandler) Get(c fiber.Ctx) error {
+	data, err := h.interactor.Get(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("studentId"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h ticketHandler) SetPriorityGroups(c fiber.Ctx) error {
+	var req application.PriorityGroupsInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.SetPriorityGroups(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"groups": data})
+}
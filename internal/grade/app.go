@@ -0,0 +1,112 @@
+package grade
+
+import (
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/infra/persistence"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+// Config holds the grade module settings
+type Config struct {
+	// RepeatPolicy decides which attempt of a repeated course counts in the
+	// cumulative GPA, it defaults to the best one
+	RepeatPolicy domain.RepeatPolicy
+	// ProbationGPA is the cumulative GPA under which students are put on
+	// probation, it defaults to 2.0
+	ProbationGPA float64
+	// DismissalGPA is the cumulative GPA under which students are
+	// dismissed, it defaults to 1.0
+	DismissalGPA float64
+	// MaxProbationTerms is how many graded terms in a row a student can be
+	// on probation before being dismissed, zero means no limit
+	MaxProbationTerms int
+}
+
+// Module serves the final grades nested under the sections routes
+type Module struct {
+	name   string
+	engine *fiber.App
+	db     *sql.DB
+	config Config
+	// students is the group of the student routes, it is authenticated
+	students fiber.Router
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB, config Config, students fiber.Router) Module {
+	return Module{name: name, engine: engine, db: db, config: config, students: students}
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.engine.Group(mod.name, httpx.Authenticate())
+
+	scales := persistence.NewScaleRepository(mod.db)
+	reviews := persistence.NewReviewRepository(mod.db)
+	grades := persistence.NewFinalGradeRepository(mod.db)
+
+	f := infra.NewFinalGradeHandler(application.NewFinalGradeInteractor(
+		persistence.NewSchemeRepository(mod.db),
+		scales,
+		reviews,
+		grades,
+	))
+	s := infra.NewScaleHandler(application.NewScaleInteractor(scales))
+	records := application.NewAcademicRecordInteractor(persistence.NewAcademicRecordRepository(mod.db), domain.StandingPolicy{
+		Repeat:            mod.config.RepeatPolicy,
+		ProbationBelow:    mod.config.ProbationGPA,
+		DismissalBelow:    mod.config.DismissalGPA,
+		MaxProbationTerms: mod.config.MaxProbationTerms,
+	})
+	r := infra.NewReviewHandler(application.NewReviewInteractor(
+		reviews,
+		persistence.NewChangeRequestRepository(mod.db),
+		grades,
+		scales,
+		records,
+	))
+	a := infra.NewAcademicRecordHandler(records)
+
+	admin := httpx.RequireRoles(shared.RoleAdmin)
+	professor := httpx.RequireRoles(shared.RoleProfessor)
+	staff := httpx.RequireRoles(shared.RoleAdmin, shared.RoleProfessor)
+
+	group.Get("/:id/grading-scheme", staff, f.GetScheme)
+	group.Put("/:id/grading-scheme", professor, f.SetScheme)
+	group.Get("/:id/final-grades/preview", staff, f.Preview)
+	group.Post("/:id/final-grades", professor, f.Write)
+
+	// the head of the department is a professor too
+	group.Get("/:id/final-grades", staff, r.FinalGrades)
+	group.Get("/:id/final-grades/reviews", staff, r.History)
+	group.Post("/:id/final-grades/submit", professor, r.Submit)
+	group.Post("/:id/final-grades/approve", professor, r.Approve)
+	group.Post("/:id/final-grades/return", professor, r.Return)
+	group.Get("/:id/grade-changes", staff, r.ListChanges)
+	group.Post("/:id/final-grades/:studentId/changes", professor, r.RequestChange)
+	group.Post("/:id/grade-changes/:changeId/approve", professor, r.ApproveChange)
+	group.Post("/:id/grade-changes/:changeId/reject", professor, r.RejectChange)
+
+	// letter grade scales are shared by the whole university
+	gradeScales := mod.engine.Group("/grade-scales", httpx.Authenticate())
+	gradeScales.Get("", s.List)
+	gradeScales.Post("", admin, s.Create)
+	gradeScales.Get("/:id", s.Get)
+	gradeScales.Put("/:id", admin, s.Update)
+	gradeScales.Delete("/:id", admin, s.Delete)
+
+	degrees := mod.engine.Group("/degrees", httpx.Authenticate())
+	degrees.Put("/:id/grade-scale", admin, s.AssignToDegree)
+	degrees.Delete("/:id/grade-scale", admin, s.UnassignFromDegree)
+
+	departments := mod.engine.Group("/departments", httpx.Authenticate())
+	departments.Put("/:id/grade-scale", admin, s.AssignToDepartment)
+	departments.Delete("/:id/grade-scale", admin, s.UnassignFromDepartment)
+
+	// GPAs are read from the student side
+	mod.students.Get("/:id/academic-record", a.Get)
+}
@@ -0,0 +1,240 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	SchemeInput struct {
+		Categories []CategoryInput `json:"categories" validate:"required,min=1,max=5,dive"`
+	}
+
+	CategoryInput struct {
+		Type        string  `json:"assignment_type" validate:"required,oneof=homework project exam quiz essay"`
+		Weight      float64 `json:"weight" validate:"gt=0,lte=100"`
+		DropLowest  int     `json:"drop_lowest" validate:"gte=0,lte=10"`
+		ExtraCredit bool    `json:"extra_credit"`
+	}
+
+	// FinalGradesPreview is what writing the final grades of a section
+	// would store
+	FinalGradesPreview struct {
+		Scheme       *domain.Scheme            `json:"scheme"`
+		Grades       []domain.ProvisionalGrade `json:"grades"`
+		Distribution domain.Distribution       `json:"distribution"`
+	}
+
+	WriteFinalGradesOutput struct {
+		FinalGradesPreview
+		// Written counts the final grades stored, the ones already approved
+		// or published are kept
+		Written int `json:"written"`
+	}
+)
+
+type FinalGradeInteractor interface {
+	SetScheme(ctx context.Context, actor shared.Actor, sectionID string, in SchemeInput) (*domain.Scheme, error)
+	GetScheme(ctx context.Context, actor shared.Actor, sectionID string) (*domain.Scheme, error)
+	// Preview computes the final score of every student of the section with
+	// the work graded so far and its letter, nothing is stored
+	Preview(ctx context.Context, actor shared.Actor, sectionID string) (*FinalGradesPreview, error)
+	// Write stores the previewed final scores in the final grades, it is
+	// refused while they are reviewed or once published
+	Write(ctx context.Context, actor shared.Actor, sectionID string) (*WriteFinalGradesOutput, error)
+}
+
+type finalGradeInteractor struct {
+	schemes    domain.SchemeRepository
+	scales     domain.ScaleRepository
+	reviews    domain.ReviewRepository
+	repository domain.FinalGradeRepository
+	now        func() time.Time
+}
+
+func NewFinalGradeInteractor(s domain.SchemeRepository, sc domain.ScaleRepository, rv domain.ReviewRepository, r domain.FinalGradeRepository) *finalGradeInteractor {
+	return &finalGradeInteractor{s, sc, rv, r, time.Now}
+}
+
+func (interactor finalGradeInteractor) SetScheme(ctx context.Context, actor shared.Actor, sectionID string, in SchemeInput) (*domain.Scheme, error) {
+	section, err := interactor.section(ctx, actor, sectionID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]domain.Category, 0, len(in.Categories))
+	for _, c := range in.Categories {
+		categories = append(categories, domain.Category{
+			Type:        domain.AssignmentType(c.Type),
+			Weight:      c.Weight,
+			DropLowest:  c.DropLowest,
+			ExtraCredit: c.ExtraCredit,
+		})
+	}
+
+	scheme, err := domain.NewScheme(section, categories, actor.ID, interactor.now())
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.schemes.Save(ctx, scheme); err != nil {
+		return nil, mapError(err)
+	}
+
+	return scheme, nil
+}
+
+func (interactor finalGradeInteractor) GetScheme(ctx context.Context, actor shared.Actor, sectionID string) (*domain.Scheme, error) {
+	section, err := interactor.section(ctx, actor, sectionID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, err := interactor.schemes.FindBySection(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return scheme, nil
+}
+
+func (interactor finalGradeInteractor) Preview(ctx context.Context, actor shared.Actor, sectionID string) (*FinalGradesPreview, error) {
+	section, err := interactor.section(ctx, actor, sectionID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return interactor.preview(ctx, section)
+}
+
+func (interactor finalGradeInteractor) Write(ctx context.Context, actor shared.Actor, sectionID string) (*WriteFinalGradesOutput, error) {
+	section, err := interactor.section(ctx, actor, sectionID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := editable(ctx, interactor.reviews, section); err != nil {
+		return nil, err
+	}
+
+	preview, err := interactor.preview(ctx, section)
+	if err != nil {
+		return nil, err
+	}
+
+	written, err := interactor.repository.WriteScores(ctx, actor.ID, preview.Grades)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &WriteFinalGradesOutput{FinalGradesPreview: *preview, Written: written}, nil
+}
+
+func (interactor finalGradeInteractor) preview(ctx context.Context, section valueobject.ID) (*FinalGradesPreview, error) {
+	scheme, err := interactor.schemes.FindBySection(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	scores, err := interactor.repository.Scores(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	scales, err := interactor.scales.Applicable(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	grades := scheme.Compute(scores)
+	for i := range grades {
+		grades[i].ApplyScale(scales[grades[i].EnrollmentID])
+	}
+
+	return &FinalGradesPreview{
+		Scheme:       scheme,
+		Grades:       grades,
+		Distribution: domain.NewDistribution(grades),
+	}, nil
+}
+
+// section parses the section id and checks the actor is its professor,
+// admins may read the grades of any section
+func (interactor finalGradeInteractor) section(ctx context.Context, actor shared.Actor, sectionID string, read bool) (valueobject.ID, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return valueobject.ID{}, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	if read && actor.IsAdmin() {
+		return section, nil
+	}
+
+	if !actor.IsProfessor() {
+		return valueobject.ID{}, mapError(domain.ErrNotSectionProfessor)
+	}
+
+	professor, err := interactor.repository.IsProfessor(ctx, section, actor.ID)
+	if err != nil {
+		return valueobject.ID{}, mapError(err)
+	}
+	if !professor {
+		return valueobject.ID{}, mapError(domain.ErrNotSectionProfessor)
+	}
+
+	return section, nil
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var appErr *shared.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, domain.ErrSectionNotFound),
+		errors.Is(err, domain.ErrSchemeNotFound),
+		errors.Is(err, domain.ErrReviewNotFound),
+		errors.Is(err, domain.ErrChangeRequestNotFound),
+		errors.Is(err, domain.ErrStudentNotGraded),
+		errors.Is(err, domain.ErrStudentNotFound),
+		errors.Is(err, domain.ErrScaleNotFound),
+		errors.Is(err, domain.ErrDegreeNotFound),
+		errors.Is(err, domain.ErrDepartmentNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrNotSectionProfessor),
+		errors.Is(err, domain.ErrNotDepartmentHead):
+		return shared.ErrForbiddenWith(err, err.Error())
+	case errors.Is(err, domain.ErrInvalidScale),
+		errors.Is(err, domain.ErrInvalidLetter),
+		errors.Is(err, domain.ErrDuplicatedLetter),
+		errors.Is(err, domain.ErrDuplicatedMinScore),
+		errors.Is(err, domain.ErrInvalidBandValue),
+		errors.Is(err, domain.ErrScaleDoesNotStartAt),
+		errors.Is(err, domain.ErrGradePointsOrder),
+		errors.Is(err, domain.ErrPassingOrder),
+		errors.Is(err, domain.ErrCommentsRequired),
+		errors.Is(err, domain.ErrInvalidScore),
+		errors.Is(err, domain.ErrReasonRequired),
+		errors.Is(err, domain.ErrSameGrade):
+		return shared.ErrInvalidInputWith(err, err.Error())
+	case errors.Is(err, domain.ErrScaleNameTaken),
+		errors.Is(err, domain.ErrScaleInUse),
+		errors.Is(err, domain.ErrDefaultScale),
+		errors.Is(err, domain.ErrGradesUnderReview),
+		errors.Is(err, domain.ErrGradesPublished),
+		errors.Is(err, domain.ErrGradesIncomplete),
+		errors.Is(err, domain.ErrNotUnderReview),
+		errors.Is(err, domain.ErrNoGradeScale),
+		errors.Is(err, domain.ErrGradeNotPublished),
+		errors.Is(err, domain.ErrChangePending),
+		errors.Is(err, domain.ErrChangeResolved):
+		return shared.ErrConflictWith(err, err.Error())
+	default:
+		return shared.ErrInternalWith(err, "cannot process grades")
+	}
+}
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	defaultProbationBelow = 2.0
+	defaultDismissalBelow = 1.0
+)
+
+type AcademicRecordInteractor interface {
+	// Get returns the GPA and standing of the student, it is computed again
+	// when the stored one is out of date
+	Get(ctx context.Context, actor shared.Actor, studentID string) (*domain.AcademicRecord, error)
+	// Refresh computes again the records of the students whose grades were
+	// published
+	Refresh(ctx context.Context, studentIDs []valueobject.ID)
+}
+
+type academicRecordInteractor struct {
+	repository domain.AcademicRecordRepository
+	policy     domain.StandingPolicy
+	now        func() time.Time
+}
+
+// NewAcademicRecordInteractor fills the unset rules of the policy with the
+// usual ones: best attempt, probation under 2.0 and dismissal under 1.0
+func NewAcademicRecordInteractor(r domain.AcademicRecordRepository, p domain.StandingPolicy) *academicRecordInteractor {
+	if p.Repeat != domain.RepeatLatest {
+		p.Repeat = domain.RepeatBest
+	}
+	if p.ProbationBelow <= 0 {
+		p.ProbationBelow = defaultProbationBelow
+	}
+	if p.DismissalBelow <= 0 {
+		p.DismissalBelow = defaultDismissalBelow
+	}
+	return &academicRecordInteractor{r, p, time.Now}
+}
+
+func (interactor academicRecordInteractor) Get(ctx context.Context, actor shared.Actor, studentID string) (*domain.AcademicRecord, error) {
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	if actor.IsStudent() && !actor.ID.Equals(student) {
+		return nil, shared.ErrForbiddenWith(shared.ErrForbidden, "students can only see their own academic record")
+	}
+
+	record, stale, err := interactor.repository.Find(ctx, student)
+	switch {
+	case err == nil && !stale && record.Policy == interactor.policy:
+		return record, nil
+	case err != nil && !errors.Is(err, domain.ErrRecordNotFound):
+		return nil, mapError(err)
+	}
+
+	return interactor.compute(ctx, student)
+}
+
+func (interactor academicRecordInteractor) Refresh(ctx context.Context, studentIDs []valueobject.ID) {
+	for _, student := range studentIDs {
+		// a record left out of date is computed again when it is read
+		if _, err := interactor.compute(ctx, student); err != nil {
+			log.Printf("cannot refresh the academic record of student %s: %s", student.String(), err.Error())
+		}
+	}
+}
+
+func (interactor academicRecordInteractor) compute(ctx context.Context, student valueobject.ID) (*domain.AcademicRecord, error) {
+	attempts, err := interactor.repository.Attempts(ctx, student)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	record := domain.NewAcademicRecord(student, attempts, interactor.policy, interactor.now())
+
+	if err := interactor.repository.Save(ctx, record); err != nil {
+		return nil, mapError(err)
+	}
+	return record, nil
+}
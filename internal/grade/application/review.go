@@ -0,0 +1,391 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	ReviewInput struct {
+		Comments string `json:"comments" validate:"max=2000"`
+	}
+
+	ChangeRequestInput struct {
+		FinalScore float64 `json:"final_score" validate:"gte=0,lte=100"`
+		Reason     string  `json:"reason" validate:"required,max=2000"`
+	}
+
+	// SectionFinalGrades are the stored final grades of a section and where
+	// they are in the approval process
+	SectionFinalGrades struct {
+		Review *domain.Review      `json:"review,omitempty"`
+		Grades []domain.FinalGrade `json:"grades"`
+	}
+)
+
+type ReviewInteractor interface {
+	// FinalGrades returns the stored final grades of the section
+	FinalGrades(ctx context.Context, actor shared.Actor, sectionID string) (*SectionFinalGrades, error)
+	// Submit sends the final grades of the section to the head of the department
+	Submit(ctx context.Context, actor shared.Actor, sectionID string) (*domain.Review, error)
+	// Approve publishes the submitted grades, closes the enrollments and
+	// updates the academic records of the students
+	Approve(ctx context.Context, actor shared.Actor, sectionID string, in ReviewInput) (*domain.Review, error)
+	// Return sends the grades back to the professor
+	Return(ctx context.Context, actor shared.Actor, sectionID string, in ReviewInput) (*domain.Review, error)
+	History(ctx context.Context, actor shared.Actor, sectionID string) ([]*domain.Review, error)
+
+	// RequestChange asks to change the published grade of the student
+	RequestChange(ctx context.Context, actor shared.Actor, sectionID, studentID string, in ChangeRequestInput) (*domain.ChangeRequest, error)
+	ListChanges(ctx context.Context, actor shared.Actor, sectionID string) ([]*domain.ChangeRequest, error)
+	// ApproveChange publishes the requested grade in place of the current one
+	ApproveChange(ctx context.Context, actor shared.Actor, sectionID, changeID string, in ReviewInput) (*domain.ChangeRequest, error)
+	RejectChange(ctx context.Context, actor shared.Actor, sectionID, changeID string, in ReviewInput) (*domain.ChangeRequest, error)
+}
+
+type reviewInteractor struct {
+	reviews domain.ReviewRepository
+	changes domain.ChangeRequestRepository
+	grades  domain.FinalGradeRepository
+	scales  domain.ScaleRepository
+	records AcademicRecordInteractor
+	now     func() time.Time
+}
+
+func NewReviewInteractor(r domain.ReviewRepository, c domain.ChangeRequestRepository, g domain.FinalGradeRepository, s domain.ScaleRepository, records AcademicRecordInteractor) *reviewInteractor {
+	return &reviewInteractor{r, c, g, s, records, time.Now}
+}
+
+// role is how the actor takes part in the grades of a section
+type role int
+
+const (
+	// roleReader may only read the grades
+	roleReader role = iota
+	roleProfessor
+	roleHead
+)
+
+func (interactor reviewInteractor) FinalGrades(ctx context.Context, actor shared.Actor, sectionID string) (*SectionFinalGrades, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleReader)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := interactor.reviews.FinalGrades(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := &SectionFinalGrades{Grades: grades}
+	if out.Review, err = interactor.latest(ctx, section); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (interactor reviewInteractor) Submit(ctx context.Context, actor shared.Actor, sectionID string) (*domain.Review, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleProfessor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := editable(ctx, interactor.reviews, section); err != nil {
+		return nil, err
+	}
+
+	grades, err := interactor.reviews.FinalGrades(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	review, err := domain.NewReview(section, actor.ID, grades, interactor.now())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.reviews.Submit(ctx, review); err != nil {
+		return nil, mapError(err)
+	}
+
+	return review, nil
+}
+
+func (interactor reviewInteractor) Approve(ctx context.Context, actor shared.Actor, sectionID string, in ReviewInput) (*domain.Review, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleHead)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := interactor.reviews.Latest(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	grades, err := interactor.reviews.FinalGrades(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	scales, err := interactor.scales.Applicable(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	published := make([]domain.PublishedGrade, 0, len(grades))
+	students := make([]valueobject.ID, 0, len(grades))
+	for _, g := range grades {
+		p, err := g.Publish(scales[g.EnrollmentID])
+		if err != nil {
+			return nil, mapError(err)
+		}
+		published = append(published, p)
+		students = append(students, g.StudentID)
+	}
+
+	if err := review.Approve(actor.ID, in.Comments, interactor.now()); err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.reviews.Publish(ctx, review, published); err != nil {
+		return nil, mapError(err)
+	}
+
+	interactor.records.Refresh(ctx, students)
+	return review, nil
+}
+
+func (interactor reviewInteractor) Return(ctx context.Context, actor shared.Actor, sectionID string, in ReviewInput) (*domain.Review, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleHead)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := interactor.reviews.Latest(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := review.Return(actor.ID, in.Comments, interactor.now()); err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.reviews.Return(ctx, review); err != nil {
+		return nil, mapError(err)
+	}
+
+	return review, nil
+}
+
+func (interactor reviewInteractor) History(ctx context.Context, actor shared.Actor, sectionID string) ([]*domain.Review, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleReader)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := interactor.reviews.History(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return reviews, nil
+}
+
+func (interactor reviewInteractor) RequestChange(ctx context.Context, actor shared.Actor, sectionID, studentID string, in ChangeRequestInput) (*domain.ChangeRequest, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleProfessor)
+	if err != nil {
+		return nil, err
+	}
+
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	grade, err := interactor.grade(ctx, section, student)
+	if err != nil {
+		return nil, err
+	}
+
+	scales, err := interactor.scales.Applicable(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	change, err := domain.NewChangeRequest(section, grade, actor.ID, in.FinalScore, in.Reason, scales[grade.EnrollmentID], interactor.now())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.changes.Create(ctx, change); err != nil {
+		return nil, mapError(err)
+	}
+
+	return change, nil
+}
+
+func (interactor reviewInteractor) ListChanges(ctx context.Context, actor shared.Actor, sectionID string) ([]*domain.ChangeRequest, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleReader)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := interactor.changes.ListBySection(ctx, section)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return changes, nil
+}
+
+func (interactor reviewInteractor) ApproveChange(ctx context.Context, actor shared.Actor, sectionID, changeID string, in ReviewInput) (*domain.ChangeRequest, error) {
+	change, err := interactor.change(ctx, actor, sectionID, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	scales, err := interactor.scales.Applicable(ctx, change.SectionID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	grade, err := change.Approve(actor.ID, in.Comments, scales[change.EnrollmentID], interactor.now())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.changes.Resolve(ctx, change, grade); err != nil {
+		return nil, mapError(err)
+	}
+
+	interactor.records.Refresh(ctx, []valueobject.ID{change.StudentID})
+	return change, nil
+}
+
+func (interactor reviewInteractor) RejectChange(ctx context.Context, actor shared.Actor, sectionID, changeID string, in ReviewInput) (*domain.ChangeRequest, error) {
+	change, err := interactor.change(ctx, actor, sectionID, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := change.Reject(actor.ID, in.Comments, interactor.now()); err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.changes.Resolve(ctx, change, nil); err != nil {
+		return nil, mapError(err)
+	}
+
+	return change, nil
+}
+
+// section parses the section id and checks the actor plays the role in it,
+// readers are its professor, the head of its department or an admin
+func (interactor reviewInteractor) section(ctx context.Context, actor shared.Actor, sectionID string, r role) (valueobject.ID, error) {
+	section, err := valueobject.IDFromString(sectionID)
+	if err != nil {
+		return valueobject.ID{}, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	if r == roleReader && actor.IsAdmin() {
+		return section, nil
+	}
+
+	if r != roleHead {
+		professor, err := interactor.grades.IsProfessor(ctx, section, actor.ID)
+		if err != nil {
+			return valueobject.ID{}, mapError(err)
+		}
+		if professor {
+			return section, nil
+		}
+	}
+
+	if r != roleProfessor {
+		head, err := interactor.reviews.IsDepartmentHead(ctx, section, actor.ID)
+		if err != nil {
+			return valueobject.ID{}, mapError(err)
+		}
+		if head {
+			return section, nil
+		}
+	}
+
+	if r == roleHead {
+		return valueobject.ID{}, mapError(domain.ErrNotDepartmentHead)
+	}
+	return valueobject.ID{}, mapError(domain.ErrNotSectionProfessor)
+}
+
+// editable checks the professor can still change the final grades of the
+// section, they can't while waiting for review or once published
+func editable(ctx context.Context, reviews domain.ReviewRepository, section valueobject.ID) error {
+	review, err := reviews.Latest(ctx, section)
+	if errors.Is(err, domain.ErrReviewNotFound) {
+		return nil
+	}
+	if err != nil {
+		return mapError(err)
+	}
+
+	switch review.Status {
+	case domain.ReviewSubmitted:
+		return mapError(domain.ErrGradesUnderReview)
+	case domain.ReviewApproved:
+		return mapError(domain.ErrGradesPublished)
+	default:
+		return nil
+	}
+}
+
+func (interactor reviewInteractor) latest(ctx context.Context, section valueobject.ID) (*domain.Review, error) {
+	review, err := interactor.reviews.Latest(ctx, section)
+	if errors.Is(err, domain.ErrReviewNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return review, nil
+}
+
+// grade returns the final grade of the student in the section
+func (interactor reviewInteractor) grade(ctx context.Context, section, student valueobject.ID) (domain.FinalGrade, error) {
+	grades, err := interactor.reviews.FinalGrades(ctx, section)
+	if err != nil {
+		return domain.FinalGrade{}, mapError(err)
+	}
+
+	for _, g := range grades {
+		if g.StudentID.Equals(student) {
+			return g, nil
+		}
+	}
+	return domain.FinalGrade{}, mapError(domain.ErrStudentNotGraded)
+}
+
+// change returns the request of the section, only the head of its
+// department decides on it
+func (interactor reviewInteractor) change(ctx context.Context, actor shared.Actor, sectionID, changeID string) (*domain.ChangeRequest, error) {
+	section, err := interactor.section(ctx, actor, sectionID, roleHead)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := valueobject.IDFromString(changeID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid grade change request id")
+	}
+
+	change, err := interactor.changes.FindByID(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if !change.SectionID.Equals(section) {
+		return nil, mapError(domain.ErrChangeRequestNotFound)
+	}
+	return change, nil
+}
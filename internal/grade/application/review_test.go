@@ -0,0 +1,217 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// reviewStore keeps the latest review of a single section and who heads its
+// department, other calls are not expected
+type reviewStore struct {
+	domain.ReviewRepository
+	head      valueobject.ID
+	latest    *domain.Review
+	grades    []domain.FinalGrade
+	published int
+	returned  int
+}
+
+func (s *reviewStore) IsDepartmentHead(_ context.Context, _, userID valueobject.ID) (bool, error) {
+	return userID.Equals(s.head), nil
+}
+
+func (s *reviewStore) Latest(context.Context, valueobject.ID) (*domain.Review, error) {
+	if s.latest == nil {
+		return nil, domain.ErrReviewNotFound
+	}
+	return s.latest, nil
+}
+
+func (s *reviewStore) History(context.Context, valueobject.ID) ([]*domain.Review, error) {
+	return []*domain.Review{s.latest}, nil
+}
+
+func (s *reviewStore) FinalGrades(context.Context, valueobject.ID) ([]domain.FinalGrade, error) {
+	return s.grades, nil
+}
+
+func (s *reviewStore) Submit(_ context.Context, r *domain.Review) error {
+	s.latest = r
+	return nil
+}
+
+func (s *reviewStore) Return(context.Context, *domain.Review) error {
+	s.returned++
+	return nil
+}
+
+func (s *reviewStore) Publish(context.Context, *domain.Review, []domain.PublishedGrade) error {
+	s.published++
+	return nil
+}
+
+// professorOf answers for the professor assigned to the section
+type professorOf struct {
+	domain.FinalGradeRepository
+	professor valueobject.ID
+}
+
+func (p professorOf) IsProfessor(_ context.Context, _, userID valueobject.ID) (bool, error) {
+	return userID.Equals(p.professor), nil
+}
+
+// passFail grades every enrollment with a single passing band
+type passFail struct {
+	domain.ScaleRepository
+	grades []domain.FinalGrade
+}
+
+func (s passFail) Applicable(context.Context, valueobject.ID) (map[valueobject.ID]*domain.Scale, error) {
+	scale := &domain.Scale{Bands: []domain.Band{{Letter: "P", GradePoints: 4, IsPassing: true}}}
+	scales := make(map[valueobject.ID]*domain.Scale, len(s.grades))
+	for _, g := range s.grades {
+		scales[g.EnrollmentID] = scale
+	}
+	return scales, nil
+}
+
+type records struct {
+	application.AcademicRecordInteractor
+}
+
+func (records) Refresh(context.Context, []valueobject.ID) {}
+
+func TestReviewWhoMayAct(t *testing.T) {
+	professor, head := valueobject.NewID(), valueobject.NewID()
+
+	actors := map[string]shared.Actor{
+		"professor":       {ID: professor, Role: shared.RoleProfessor},
+		"head":            {ID: head, Role: shared.RoleProfessor},
+		"admin":           {ID: valueobject.NewID(), Role: shared.RoleAdmin},
+		"other professor": {ID: valueobject.NewID(), Role: shared.RoleProfessor},
+		"student":         {ID: valueobject.NewID(), Role: shared.RoleStudent},
+	}
+
+	type action func(i application.ReviewInteractor, actor shared.Actor, section string) error
+	actions := map[string]struct {
+		from domain.ReviewStatus
+		run  action
+		// may lists the actors allowed, the others get wantErr
+		may     []string
+		wantErr error
+	}{
+		"read": {
+			from: domain.ReviewSubmitted,
+			run: func(i application.ReviewInteractor, actor shared.Actor, section string) error {
+				_, err := i.History(context.Background(), actor, section)
+				return err
+			},
+			may:     []string{"professor", "head", "admin"},
+			wantErr: domain.ErrNotSectionProfessor,
+		},
+		"submit": {
+			from: domain.ReviewReturned,
+			run: func(i application.ReviewInteractor, actor shared.Actor, section string) error {
+				_, err := i.Submit(context.Background(), actor, section)
+				return err
+			},
+			may:     []string{"professor"},
+			wantErr: domain.ErrNotSectionProfessor,
+		},
+		"approve": {
+			from: domain.ReviewSubmitted,
+			run: func(i application.ReviewInteractor, actor shared.Actor, section string) error {
+				_, err := i.Approve(context.Background(), actor, section, application.ReviewInput{})
+				return err
+			},
+			may:     []string{"head"},
+			wantErr: domain.ErrNotDepartmentHead,
+		},
+		"return": {
+			from: domain.ReviewSubmitted,
+			run: func(i application.ReviewInteractor, actor shared.Actor, section string) error {
+				_, err := i.Return(context.Background(), actor, section, application.ReviewInput{Comments: "check the exam"})
+				return err
+			},
+			may:     []string{"head"},
+			wantErr: domain.ErrNotDepartmentHead,
+		},
+	}
+
+	score := 75.0
+	for actionName, a := range actions {
+		for actorName, actor := range actors {
+			grades := []domain.FinalGrade{{ID: valueobject.NewID(), SectionStudent: domain.SectionStudent{EnrollmentID: valueobject.NewID(), StudentID: valueobject.NewID()}, FinalScore: &score, LetterGrade: "P"}}
+			store := &reviewStore{head: head, grades: grades, latest: &domain.Review{ID: valueobject.NewID(), Status: a.from}}
+			interactor := application.NewReviewInteractor(store, nil, professorOf{professor: professor}, passFail{grades: grades}, records{})
+
+			err := a.run(interactor, actor, valueobject.NewID().String())
+
+			may := false
+			for _, name := range a.may {
+				may = may || name == actorName
+			}
+
+			if may {
+				if err != nil {
+					t.Errorf("%s by %s: unexpected error: %v", actionName, actorName, err)
+				}
+				continue
+			}
+			var appErr *shared.AppError
+			if !errors.Is(err, a.wantErr) || !errors.As(err, &appErr) || appErr.Code != shared.CodeForbidden {
+				t.Errorf("%s by %s: got error %v, want forbidden %v", actionName, actorName, err, a.wantErr)
+			}
+			if store.published != 0 || store.returned != 0 || store.latest.Status != a.from {
+				t.Errorf("%s by %s: the review changed although the actor may not", actionName, actorName)
+			}
+		}
+	}
+}
+
+func TestReviewApprove(t *testing.T) {
+	head := valueobject.NewID()
+	actor := shared.Actor{ID: head, Role: shared.RoleProfessor}
+	score := 75.0
+
+	tests := []struct {
+		name    string
+		latest  *domain.Review
+		grades  []domain.FinalGrade
+		wantErr error
+	}{
+		{name: "submitted grades", latest: &domain.Review{Status: domain.ReviewSubmitted}, grades: []domain.FinalGrade{{FinalScore: &score, LetterGrade: "P"}}},
+		{name: "nothing submitted", wantErr: domain.ErrReviewNotFound},
+		{name: "returned grades", latest: &domain.Review{Status: domain.ReviewReturned}, wantErr: domain.ErrNotUnderReview},
+		{name: "already approved", latest: &domain.Review{Status: domain.ReviewApproved}, wantErr: domain.ErrNotUnderReview},
+		{name: "a grade lost its score", latest: &domain.Review{Status: domain.ReviewSubmitted}, grades: []domain.FinalGrade{{LetterGrade: "P"}}, wantErr: domain.ErrGradesIncomplete},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &reviewStore{head: head, latest: tt.latest, grades: tt.grades}
+			interactor := application.NewReviewInteractor(store, nil, professorOf{}, passFail{grades: tt.grades}, records{})
+
+			review, err := interactor.Approve(context.Background(), actor, valueobject.NewID().String(), application.ReviewInput{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if store.published != 0 {
+					t.Error("the grades were published on error")
+				}
+				return
+			}
+
+			if review.Status != domain.ReviewApproved || !review.ReviewedBy.Equals(head) || store.published != 1 {
+				t.Errorf("got %+v published %d times, want it approved by the head once", review, store.published)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	ScaleInput struct {
+		Name      string      `json:"name" validate:"required,max=100"`
+		IsDefault bool        `json:"is_default"`
+		Bands     []BandInput `json:"bands" validate:"required,min=1,max=20,dive"`
+	}
+
+	BandInput struct {
+		Letter      string  `json:"letter" validate:"required,max=2"`
+		MinScore    float64 `json:"min_score" validate:"gte=0,lte=100"`
+		GradePoints float64 `json:"grade_points" validate:"gte=0,lte=5"`
+		IsPassing   bool    `json:"is_passing"`
+	}
+
+	AssignScaleInput struct {
+		GradeScaleID string `json:"grade_scale_id" validate:"required,uuid"`
+	}
+)
+
+type ScaleInteractor interface {
+	Create(ctx context.Context, in ScaleInput) (*domain.Scale, error)
+	Update(ctx context.Context, scaleID string, in ScaleInput) (*domain.Scale, error)
+	// Delete removes a scale no degree or department uses, the default one
+	// can't be removed
+	Delete(ctx context.Context, scaleID string) error
+	Get(ctx context.Context, scaleID string) (*domain.Scale, error)
+	List(ctx context.Context) ([]*domain.Scale, error)
+	// AssignToDegree grades the students of the degree with the scale, an
+	// empty id goes back to the scale of the department
+	AssignToDegree(ctx context.Context, degreeID string, scaleID string) error
+	// AssignToDepartment grades the courses of the department with the
+	// scale, an empty id goes back to the default scale
+	AssignToDepartment(ctx context.Context, departmentID string, scaleID string) error
+}
+
+type scaleInteractor struct {
+	repository domain.ScaleRepository
+	now        func() time.Time
+}
+
+func NewScaleInteractor(r domain.ScaleRepository) *scaleInteractor {
+	return &scaleInteractor{r, time.Now}
+}
+
+func (interactor scaleInteractor) Create(ctx context.Context, in ScaleInput) (*domain.Scale, error) {
+	scale, err := domain.NewScale(in.Name, bands(in.Bands), in.IsDefault, interactor.now())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.repository.Save(ctx, scale); err != nil {
+		return nil, mapError(err)
+	}
+
+	return scale, nil
+}
+
+func (interactor scaleInteractor) Update(ctx context.Context, scaleID string, in ScaleInput) (*domain.Scale, error) {
+	scale, err := interactor.find(ctx, scaleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := scale.Update(in.Name, bands(in.Bands), in.IsDefault, interactor.now()); err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := interactor.repository.Save(ctx, scale); err != nil {
+		return nil, mapError(err)
+	}
+
+	return scale, nil
+}
+
+func (interactor scaleInteractor) Delete(ctx context.Context, scaleID string) error {
+	scale, err := interactor.find(ctx, scaleID)
+	if err != nil {
+		return err
+	}
+
+	if scale.IsDefault {
+		return mapError(domain.ErrDefaultScale)
+	}
+
+	if err := interactor.repository.Delete(ctx, scale.ID); err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+func (interactor scaleInteractor) Get(ctx context.Context, scaleID string) (*domain.Scale, error) {
+	return interactor.find(ctx, scaleID)
+}
+
+func (interactor scaleInteractor) List(ctx context.Context) ([]*domain.Scale, error) {
+	scales, err := interactor.repository.List(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if scales == nil {
+		scales = []*domain.Scale{}
+	}
+	return scales, nil
+}
+
+func (interactor scaleInteractor) AssignToDegree(ctx context.Context, degreeID string, scaleID string) error {
+	id, err := valueobject.IDFromString(degreeID)
+	if err != nil {
+		return shared.ErrInvalidInputWith(err, "invalid degree id")
+	}
+
+	scale, err := optionalScale(scaleID)
+	if err != nil {
+		return err
+	}
+
+	if err := interactor.repository.AssignToDegree(ctx, id, scale); err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+func (interactor scaleInteractor) AssignToDepartment(ctx context.Context, departmentID string, scaleID string) error {
+	id, err := valueobject.IDFromString(departmentID)
+	if err != nil {
+		return shared.ErrInvalidInputWith(err, "invalid department id")
+	}
+
+	scale, err := optionalScale(scaleID)
+	if err != nil {
+		return err
+	}
+
+	if err := interactor.repository.AssignToDepartment(ctx, id, scale); err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+func (interactor scaleInteractor) find(ctx context.Context, scaleID string) (*domain.Scale, error) {
+	id, err := valueobject.IDFromString(scaleID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid grade scale id")
+	}
+
+	scale, err := interactor.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return scale, nil
+}
+
+// optionalScale parses the scale id, empty means none
+func optionalScale(scaleID string) (*valueobject.ID, error) {
+	if scaleID == "" {
+		return nil, nil
+	}
+
+	id, err := valueobject.IDFromString(scaleID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid grade scale id")
+	}
+	return &id, nil
+}
+
+func bands(in []BandInput) []domain.Band {
+	bands := make([]domain.Band, 0, len(in))
+	for _, b := range in {
+		bands = append(bands, domain.Band{
+			Letter:      b.Letter,
+			MinScore:    b.MinScore,
+			GradePoints: b.GradePoints,
+			IsPassing:   b.IsPassing,
+		})
+	}
+	return bands
+}
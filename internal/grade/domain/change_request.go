@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrChangeRequestNotFound = errors.New("grade change request does not exist")
+	ErrGradeNotPublished     = errors.New("only published grades need a change request, unpublished ones are written again")
+	ErrStudentNotGraded      = errors.New("the student has no final grade in the section")
+	ErrSameGrade             = errors.New("the requested score is the current one")
+	ErrInvalidScore          = errors.New("final scores go from 0 to 100")
+	ErrReasonRequired        = errors.New("grade changes need a reason")
+	ErrChangePending         = errors.New("the grade already has a change waiting for review")
+	ErrChangeResolved        = errors.New("the grade change request was already reviewed")
+)
+
+type ChangeRequestRepository interface {
+	// Create stores the request, a grade has one pending at most
+	Create(ctx context.Context, c *ChangeRequest) (err error)
+	FindByID(ctx context.Context, id valueobject.ID) (*ChangeRequest, error)
+	ListBySection(ctx context.Context, sectionID valueobject.ID) ([]*ChangeRequest, error)
+	// Resolve stores the decision, the grade of approved requests is
+	// published again and copied into the enrollment
+	Resolve(ctx context.Context, c *ChangeRequest, grade *PublishedGrade) (err error)
+}
+
+// ChangeStatus is the state of a grade change request
+type ChangeStatus string
+
+const (
+	ChangePending  ChangeStatus = "pending"
+	ChangeApproved ChangeStatus = "approved"
+	ChangeRejected ChangeStatus = "rejected"
+)
+
+// ChangeRequest asks the head of the department to change a published final
+// grade, the previous values remain as the audit trail
+type ChangeRequest struct {
+	ID             valueobject.ID  `json:"id"`
+	FinalGradeID   valueobject.ID  `json:"-"`
+	SectionID      valueobject.ID  `json:"course_section_id"`
+	EnrollmentID   valueobject.ID  `json:"enrollment_id"`
+	StudentID      valueobject.ID  `json:"student_id"`
+	RequestedBy    valueobject.ID  `json:"requested_by"`
+	RequestedAt    time.Time       `json:"requested_at"`
+	Reason         string          `json:"reason"`
+	PreviousScore  float64         `json:"previous_score"`
+	PreviousLetter string          `json:"previous_letter,omitempty"`
+	NewScore       float64         `json:"new_score"`
+	NewLetter      string          `json:"new_letter,omitempty"`
+	Status         ChangeStatus    `json:"status"`
+	ReviewedBy     *valueobject.ID `json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time      `json:"reviewed_at,omitempty"`
+	ReviewComments string          `json:"review_comments,omitempty"`
+}
+
+// NewChangeRequest asks to change the published grade to the new score
+func NewChangeRequest(sectionID valueobject.ID, grade FinalGrade, professorID valueobject.ID, newScore float64, reason string, s *Scale, now time.Time) (*ChangeRequest, error) {
+	if grade.FinalScore == nil {
+		return nil, ErrStudentNotGraded
+	}
+	if grade.PublishedAt == nil {
+		return nil, ErrGradeNotPublished
+	}
+	if newScore < 0 || newScore > 100 {
+		return nil, ErrInvalidScore
+	}
+	newScore = round2(newScore)
+	if newScore == *grade.FinalScore {
+		return nil, ErrSameGrade
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	changed := grade
+	changed.FinalScore = &newScore
+	published, err := changed.Publish(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangeRequest{
+		ID:             valueobject.NewID(),
+		FinalGradeID:   grade.ID,
+		SectionID:      sectionID,
+		EnrollmentID:   grade.EnrollmentID,
+		StudentID:      grade.StudentID,
+		RequestedBy:    professorID,
+		RequestedAt:    now,
+		Reason:         reason,
+		PreviousScore:  *grade.FinalScore,
+		PreviousLetter: grade.LetterGrade,
+		NewScore:       newScore,
+		NewLetter:      published.LetterGrade,
+		Status:         ChangePending,
+	}, nil
+}
+
+// Approve accepts the change, the returned grade is what gets published with
+// the letter of the current scale of the student
+func (c *ChangeRequest) Approve(headID valueobject.ID, comments string, s *Scale, now time.Time) (*PublishedGrade, error) {
+	if c.Status != ChangePending {
+		return nil, ErrChangeResolved
+	}
+
+	grade := FinalGrade{ID: c.FinalGradeID, SectionStudent: SectionStudent{EnrollmentID: c.EnrollmentID, StudentID: c.StudentID}, FinalScore: &c.NewScore}
+	published, err := grade.Publish(s)
+	if err != nil {
+		return nil, err
+	}
+
+	c.NewLetter = published.LetterGrade
+	c.review(ChangeApproved, headID, comments, now)
+	return &published, nil
+}
+
+// Reject keeps the published grade, the professor is told why
+func (c *ChangeRequest) Reject(headID valueobject.ID, comments string, now time.Time) error {
+	if c.Status != ChangePending {
+		return ErrChangeResolved
+	}
+	if strings.TrimSpace(comments) == "" {
+		return ErrCommentsRequired
+	}
+
+	c.review(ChangeRejected, headID, comments, now)
+	return nil
+}
+
+func (c *ChangeRequest) review(status ChangeStatus, headID valueobject.ID, comments string, now time.Time) {
+	c.Status = status
+	c.ReviewedBy = &headID
+	c.ReviewedAt = &now
+	c.ReviewComments = strings.TrimSpace(comments)
+}
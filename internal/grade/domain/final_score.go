@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type FinalGradeRepository interface {
+	// IsProfessor checks if the user is the professor assigned to the section
+	IsProfessor(ctx context.Context, sectionID, userID valueobject.ID) (bool, error)
+	// Scores returns the students taking the section, its published
+	// assignments and the scores the students got on them
+	Scores(ctx context.Context, sectionID valueobject.ID) (*SectionScores, error)
+	// WriteScores stores the final scores of the section with their letters,
+	// grades already on their way to publication are left as they are. It
+	// returns how many were written.
+	WriteScores(ctx context.Context, professorID valueobject.ID, grades []ProvisionalGrade) (int, error)
+}
+
+// SectionScores is what a section final scores are computed from
+type SectionScores struct {
+	SectionID   valueobject.ID
+	Students    []SectionStudent
+	Assignments []ScoredAssignment
+	// Points holds the points of the graded and missing submissions
+	Points map[ScoreKey]float64
+}
+
+type SectionStudent struct {
+	EnrollmentID valueobject.ID `json:"enrollment_id"`
+	StudentID    valueobject.ID `json:"student_id"`
+	FirstName    string         `json:"first_name"`
+	LastName     string         `json:"last_name"`
+}
+
+type ScoredAssignment struct {
+	ID        valueobject.ID
+	Type      AssignmentType
+	MaxPoints float64
+}
+
+type ScoreKey struct {
+	AssignmentID valueobject.ID
+	StudentID    valueobject.ID
+}
+
+// CategoryScore is how a student did on a category of the scheme
+type CategoryScore struct {
+	Type        AssignmentType `json:"assignment_type"`
+	Weight      float64        `json:"weight"`
+	ExtraCredit bool           `json:"extra_credit"`
+	// Percentage is nil while nothing of the category is graded
+	Percentage *float64 `json:"percentage"`
+	Counted    int      `json:"counted"`
+	Dropped    int      `json:"dropped"`
+}
+
+// ProvisionalGrade is the final score of a student as computed from the
+// work graded so far
+type ProvisionalGrade struct {
+	SectionStudent
+	// FinalScore is nil while nothing of the student is graded
+	FinalScore *float64 `json:"final_score"`
+	// LetterGrade and GradePoints come from the scale of the student, they
+	// are empty while the final score is
+	LetterGrade string          `json:"letter_grade,omitempty"`
+	GradePoints *float64        `json:"grade_points,omitempty"`
+	Categories  []CategoryScore `json:"categories"`
+}
+
+// ApplyScale derives the letter and the grade points of the final score
+func (g *ProvisionalGrade) ApplyScale(s *Scale) {
+	if g.FinalScore == nil || s == nil {
+		return
+	}
+	band := s.Grade(*g.FinalScore)
+	g.LetterGrade = band.Letter
+	g.GradePoints = ptr(band.GradePoints)
+}
+
+// Compute returns the final score of every student of the section. Each
+// category scores the points earned over the points possible, once its
+// lowest scores are dropped. Regular categories without graded work don't
+// count and their weight is shared among the others, extra credit adds its
+// weight times its score and the result is capped at 100.
+func (s Scheme) Compute(scores *SectionScores) []ProvisionalGrade {
+	grades := make([]ProvisionalGrade, 0, len(scores.Students))
+
+	for _, student := range scores.Students {
+		grade := ProvisionalGrade{SectionStudent: student, Categories: make([]CategoryScore, 0, len(s.Categories))}
+
+		var weighted, weights, extra float64
+		for _, category := range s.Categories {
+			result := category.score(student.StudentID, scores)
+			grade.Categories = append(grade.Categories, result)
+
+			if result.Percentage == nil {
+				continue
+			}
+			if category.ExtraCredit {
+				extra += category.Weight * *result.Percentage / 100
+				continue
+			}
+			weighted += category.Weight * *result.Percentage
+			weights += category.Weight
+		}
+
+		if weights > 0 {
+			final := round2(math.Min(100, weighted/weights+extra))
+			grade.FinalScore = &final
+		}
+
+		grades = append(grades, grade)
+	}
+
+	return grades
+}
+
+func (c Category) score(studentID valueobject.ID, scores *SectionScores) CategoryScore {
+	result := CategoryScore{Type: c.Type, Weight: c.Weight, ExtraCredit: c.ExtraCredit}
+
+	type item struct{ earned, possible float64 }
+	var items []item
+	for _, a := range scores.Assignments {
+		if a.Type != c.Type {
+			continue
+		}
+		if points, ok := scores.Points[ScoreKey{AssignmentID: a.ID, StudentID: studentID}]; ok {
+			items = append(items, item{points, a.MaxPoints})
+		}
+	}
+
+	if len(items) == 0 {
+		return result
+	}
+
+	// the lowest scores go first, at least one score is always kept
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].earned/items[i].possible < items[j].earned/items[j].possible
+	})
+	result.Dropped = min(c.DropLowest, len(items)-1)
+	items = items[result.Dropped:]
+
+	var earned, possible float64
+	for _, it := range items {
+		earned += it.earned
+		possible += it.possible
+	}
+
+	percentage := round2(earned / possible * 100)
+	result.Percentage = &percentage
+	result.Counted = len(items)
+	return result
+}
+
+// Distribution summarizes the final scores of a section
+type Distribution struct {
+	Graded   int      `json:"graded"`
+	Ungraded int      `json:"ungraded"`
+	Mean     *float64 `json:"mean,omitempty"`
+	Median   *float64 `json:"median,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+	StdDev   *float64 `json:"std_dev,omitempty"`
+	// Buckets count the scores by ranges of 10 points, the last one
+	// includes 100
+	Buckets []Bucket `json:"buckets"`
+}
+
+type Bucket struct {
+	From  int `json:"from"`
+	To    int `json:"to"`
+	Count int `json:"count"`
+}
+
+// NewDistribution summarizes the final scores of the grades
+func NewDistribution(grades []ProvisionalGrade) Distribution {
+	d := Distribution{Buckets: make([]Bucket, 10)}
+	for i := range d.Buckets {
+		d.Buckets[i] = Bucket{From: i * 10, To: (i + 1) * 10}
+	}
+
+	var values []float64
+	for _, g := range grades {
+		if g.FinalScore == nil {
+			d.Ungraded++
+			continue
+		}
+		values = append(values, *g.FinalScore)
+		d.Buckets[min(int(*g.FinalScore/10), 9)].Count++
+	}
+
+	d.Graded = len(values)
+	if d.Graded == 0 {
+		return d
+	}
+
+	sort.Float64s(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(values))
+
+	median := values[len(values)/2]
+	if len(values)%2 == 0 {
+		median = (values[len(values)/2-1] + values[len(values)/2]) / 2
+	}
+
+	d.Mean = ptr(round2(mean))
+	d.Median = ptr(round2(median))
+	d.Min = ptr(values[0])
+	d.Max = ptr(values[len(values)-1])
+	d.StdDev = ptr(round2(math.Sqrt(variance)))
+	return d
+}
+
+// round2 rounds to the two decimals the grades are stored with
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+func ptr(f float64) *float64 {
+	return &f
+}
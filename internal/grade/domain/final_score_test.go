@@ -0,0 +1,222 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func TestNewScheme(t *testing.T) {
+	tests := []struct {
+		name       string
+		categories []domain.Category
+		wantErr    error
+	}{
+		{
+			name: "regular and extra credit",
+			categories: []domain.Category{
+				{Type: domain.TypeHomework, Weight: 40, DropLowest: 2},
+				{Type: domain.TypeExam, Weight: 60},
+				{Type: domain.TypeQuiz, Weight: 20, ExtraCredit: true},
+			},
+		},
+		{name: "no categories", wantErr: domain.ErrInvalidScheme},
+		{name: "unknown type", categories: []domain.Category{{Type: "lab", Weight: 100}}, wantErr: domain.ErrInvalidCategoryType},
+		{name: "repeated type", categories: []domain.Category{{Type: domain.TypeExam, Weight: 50}, {Type: domain.TypeExam, Weight: 50}}, wantErr: domain.ErrDuplicatedCategory},
+		{name: "zero weight", categories: []domain.Category{{Type: domain.TypeExam, Weight: 100}, {Type: domain.TypeQuiz}}, wantErr: domain.ErrInvalidCategoryValue},
+		{name: "too many drops", categories: []domain.Category{{Type: domain.TypeExam, Weight: 100, DropLowest: domain.MaxDropLowest + 1}}, wantErr: domain.ErrInvalidCategoryValue},
+		{name: "weights under 100", categories: []domain.Category{{Type: domain.TypeExam, Weight: 60}, {Type: domain.TypeQuiz, Weight: 30}}, wantErr: domain.ErrWeightsDontAddUp},
+		{name: "extra credit does not count towards 100", categories: []domain.Category{{Type: domain.TypeExam, Weight: 90}, {Type: domain.TypeQuiz, Weight: 10, ExtraCredit: true}}, wantErr: domain.ErrWeightsDontAddUp},
+		{
+			name: "too much extra credit",
+			categories: []domain.Category{
+				{Type: domain.TypeExam, Weight: 100},
+				{Type: domain.TypeQuiz, Weight: 15, ExtraCredit: true},
+				{Type: domain.TypeEssay, Weight: 10, ExtraCredit: true},
+			},
+			wantErr: domain.ErrExtraCreditTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewScheme(valueobject.NewID(), tt.categories, valueobject.NewID(), time.Now())
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemeCompute(t *testing.T) {
+	scheme := domain.Scheme{Categories: []domain.Category{
+		{Type: domain.TypeHomework, Weight: 40, DropLowest: 1},
+		{Type: domain.TypeExam, Weight: 60},
+		{Type: domain.TypeQuiz, Weight: 10, ExtraCredit: true},
+	}}
+
+	hw1 := domain.ScoredAssignment{ID: valueobject.NewID(), Type: domain.TypeHomework, MaxPoints: 10}
+	hw2 := domain.ScoredAssignment{ID: valueobject.NewID(), Type: domain.TypeHomework, MaxPoints: 20}
+	hw3 := domain.ScoredAssignment{ID: valueobject.NewID(), Type: domain.TypeHomework, MaxPoints: 10}
+	exam := domain.ScoredAssignment{ID: valueobject.NewID(), Type: domain.TypeExam, MaxPoints: 100}
+	quiz := domain.ScoredAssignment{ID: valueobject.NewID(), Type: domain.TypeQuiz, MaxPoints: 2}
+	// essays are not part of the scheme, they never count
+	essay := domain.ScoredAssignment{ID: valueobject.NewID(), Type: domain.TypeEssay, MaxPoints: 10}
+
+	type score struct {
+		assignment domain.ScoredAssignment
+		points     float64
+	}
+
+	tests := []struct {
+		name   string
+		scores []score
+		want   *float64
+		// homework, exam and quiz percentages, -1 when not graded
+		categories [3]float64
+		dropped    int
+	}{
+		{
+			name:       "drops the lowest ratio, not the lowest points",
+			scores:     []score{{hw1, 10}, {hw2, 10}, {hw3, 8}, {exam, 80}, {quiz, 1}, {essay, 0}},
+			want:       ptr(89),
+			categories: [3]float64{90, 80, 50},
+			dropped:    1,
+		},
+		{
+			name:       "keeps at least one score",
+			scores:     []score{{hw1, 4}, {exam, 50}},
+			want:       ptr(46),
+			categories: [3]float64{40, 50, -1},
+		},
+		{
+			name:       "shares the weight of ungraded categories",
+			scores:     []score{{exam, 70}, {quiz, 2}},
+			want:       ptr(80),
+			categories: [3]float64{-1, 70, 100},
+		},
+		{
+			name:       "caps at 100",
+			scores:     []score{{hw1, 10}, {hw2, 20}, {exam, 100}, {quiz, 2}},
+			want:       ptr(100),
+			categories: [3]float64{100, 100, 100},
+			dropped:    1,
+		},
+		{
+			name:       "extra credit alone is no final score",
+			scores:     []score{{quiz, 2}},
+			categories: [3]float64{-1, -1, 100},
+		},
+		{
+			name:       "nothing graded",
+			categories: [3]float64{-1, -1, -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			student := domain.SectionStudent{EnrollmentID: valueobject.NewID(), StudentID: valueobject.NewID()}
+			scores := &domain.SectionScores{
+				Students:    []domain.SectionStudent{student},
+				Assignments: []domain.ScoredAssignment{hw1, hw2, hw3, exam, quiz, essay},
+				Points:      map[domain.ScoreKey]float64{},
+			}
+			for _, s := range tt.scores {
+				scores.Points[domain.ScoreKey{AssignmentID: s.assignment.ID, StudentID: student.StudentID}] = s.points
+			}
+
+			grades := scheme.Compute(scores)
+			if len(grades) != 1 {
+				t.Fatalf("got %d grades, want 1", len(grades))
+			}
+			grade := grades[0]
+
+			if !equalScore(grade.FinalScore, tt.want) {
+				t.Errorf("got final score %v, want %v", value(grade.FinalScore), value(tt.want))
+			}
+
+			if len(grade.Categories) != len(tt.categories) {
+				t.Fatalf("got %d categories, want %d", len(grade.Categories), len(tt.categories))
+			}
+			for i, c := range grade.Categories {
+				var want *float64
+				if tt.categories[i] >= 0 {
+					want = ptr(tt.categories[i])
+				}
+				if !equalScore(c.Percentage, want) {
+					t.Errorf("got %s percentage %v, want %v", c.Type, value(c.Percentage), value(want))
+				}
+			}
+			if grade.Categories[0].Dropped != tt.dropped {
+				t.Errorf("got %d homework dropped, want %d", grade.Categories[0].Dropped, tt.dropped)
+			}
+		})
+	}
+}
+
+func TestNewDistribution(t *testing.T) {
+	grades := []domain.ProvisionalGrade{
+		{FinalScore: ptr(89)},
+		{FinalScore: ptr(80)},
+		{},
+		{FinalScore: ptr(100)},
+		{FinalScore: ptr(46)},
+		{},
+	}
+
+	d := domain.NewDistribution(grades)
+
+	if d.Graded != 4 || d.Ungraded != 2 {
+		t.Errorf("got %d graded and %d ungraded, want 4 and 2", d.Graded, d.Ungraded)
+	}
+
+	stats := []struct {
+		name string
+		got  *float64
+		want float64
+	}{
+		{"mean", d.Mean, 78.75},
+		{"median", d.Median, 84.5},
+		{"min", d.Min, 46},
+		{"max", d.Max, 100},
+		{"std dev", d.StdDev, 20.19},
+	}
+	for _, s := range stats {
+		if !equalScore(s.got, &s.want) {
+			t.Errorf("got %s %v, want %v", s.name, value(s.got), s.want)
+		}
+	}
+
+	counts := map[int]int{40: 1, 80: 2, 90: 1}
+	for _, b := range d.Buckets {
+		if b.Count != counts[b.From] {
+			t.Errorf("got %d scores from %d to %d, want %d", b.Count, b.From, b.To, counts[b.From])
+		}
+	}
+
+	empty := domain.NewDistribution([]domain.ProvisionalGrade{{}})
+	if empty.Ungraded != 1 || empty.Mean != nil || empty.Median != nil || len(empty.Buckets) != 10 {
+		t.Errorf("got %+v for a section without scores", empty)
+	}
+}
+
+func ptr(f float64) *float64 {
+	return &f
+}
+
+func value(f *float64) any {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
+
+func equalScore(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrStudentNotFound = errors.New("student does not exist")
+	ErrRecordNotFound  = errors.New("the academic record of the student was not computed yet")
+)
+
+type AcademicRecordRepository interface {
+	// Attempts returns every enrollment of the student but the dropped ones
+	Attempts(ctx context.Context, studentID valueobject.ID) ([]Attempt, error)
+	// Find returns the stored record, stale tells if the enrollments of the
+	// student changed after it was computed
+	Find(ctx context.Context, studentID valueobject.ID) (record *AcademicRecord, stale bool, err error)
+	Save(ctx context.Context, r *AcademicRecord) (err error)
+}
+
+// RepeatPolicy decides which attempt of a repeated course counts
+type RepeatPolicy string
+
+const (
+	RepeatBest   RepeatPolicy = "best"
+	RepeatLatest RepeatPolicy = "latest"
+)
+
+// Standing is the academic situation of a student by their GPA
+type Standing string
+
+const (
+	StandingGood      Standing = "good_standing"
+	StandingProbation Standing = "probation"
+	StandingDismissal Standing = "dismissal"
+)
+
+// StandingPolicy holds the rules the academic standing is derived with
+type StandingPolicy struct {
+	Repeat RepeatPolicy `json:"repeat_policy"`
+	// ProbationBelow is the cumulative GPA under which students are put on
+	// probation
+	ProbationBelow float64 `json:"probation_below"`
+	// DismissalBelow is the cumulative GPA under which students are
+	// dismissed
+	DismissalBelow float64 `json:"dismissal_below"`
+	// MaxProbationTerms is how many graded terms in a row a student can be on
+	// probation before being dismissed, zero means no limit
+	MaxProbationTerms int `json:"max_probation_terms"`
+}
+
+// Attempt is a course taken by the student in an academic period
+type Attempt struct {
+	EnrollmentID valueobject.ID `json:"enrollment_id"`
+	SectionID    valueobject.ID `json:"course_section_id"`
+	CourseID     valueobject.ID `json:"course_id"`
+	CourseCode   string         `json:"course_code"`
+	CourseName   string         `json:"course_name"`
+	Credits      int            `json:"credits"`
+	PeriodID     valueobject.ID `json:"-"`
+	PeriodName   string         `json:"-"`
+	PeriodStart  time.Time      `json:"-"`
+	// Status is the one of the enrollment, only completed and failed
+	// attempts are graded
+	Status        string   `json:"status"`
+	FinalGrade    *float64 `json:"final_grade,omitempty"`
+	LetterGrade   string   `json:"letter_grade,omitempty"`
+	GradePoints   *float64 `json:"grade_points,omitempty"`
+	CreditsEarned int      `json:"credits_earned"`
+	// Counted tells if the attempt is part of the cumulative GPA, repeated
+	// courses count a single attempt
+	Counted bool `json:"counted"`
+}
+
+// IsGraded checks if the attempt has a published grade worth grade points
+func (a Attempt) IsGraded() bool {
+	return (a.Status == "completed" || a.Status == "failed") && a.GradePoints != nil
+}
+
+// TermRecord is how the student did on an academic period
+type TermRecord struct {
+	PeriodID         valueobject.ID `json:"academic_period_id"`
+	PeriodName       string         `json:"academic_period_name"`
+	Courses          []Attempt      `json:"courses"`
+	CreditsAttempted int            `json:"credits_attempted"`
+	CreditsEarned    int            `json:"credits_earned"`
+	// TermGPA is nil while nothing of the term is graded
+	TermGPA       *float64 `json:"term_gpa"`
+	CumulativeGPA *float64 `json:"cumulative_gpa"`
+	Standing      Standing `json:"standing"`
+}
+
+// AcademicRecord is the GPA and standing of a student term by term
+type AcademicRecord struct {
+	StudentID        valueobject.ID `json:"student_id"`
+	Terms            []TermRecord   `json:"terms"`
+	CumulativeGPA    *float64       `json:"cumulative_gpa"`
+	CreditsAttempted int            `json:"credits_attempted"`
+	CreditsEarned    int            `json:"credits_earned"`
+	Standing         Standing       `json:"standing"`
+	Policy           StandingPolicy `json:"policy"`
+	ComputedAt       time.Time      `json:"computed_at"`
+}
+
+// NewAcademicRecord computes the GPA of the student from the grade points of
+// the graded attempts weighted by their credits. Term GPAs count every
+// attempt of the term, the cumulative GPA counts one attempt per course as
+// the repeat policy says. The standing of each graded term follows its
+// cumulative GPA, terms without grades keep the previous one.
+func NewAcademicRecord(studentID valueobject.ID, attempts []Attempt, policy StandingPolicy, now time.Time) *AcademicRecord {
+	record := &AcademicRecord{StudentID: studentID, Terms: []TermRecord{}, Standing: StandingGood, Policy: policy, ComputedAt: now}
+
+	sorted := make([]Attempt, len(attempts))
+	copy(sorted, attempts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].PeriodStart.Equal(sorted[j].PeriodStart) {
+			return sorted[i].PeriodStart.Before(sorted[j].PeriodStart)
+		}
+		return sorted[i].CourseCode < sorted[j].CourseCode
+	})
+
+	var (
+		// counted holds the index in sorted of the attempt counting for
+		// each course so far
+		counted   = make(map[valueobject.ID]int)
+		probation int
+	)
+
+	for start := 0; start < len(sorted); {
+		end := start
+		for end < len(sorted) && sorted[end].PeriodID.Equals(sorted[start].PeriodID) {
+			end++
+		}
+
+		term := TermRecord{PeriodID: sorted[start].PeriodID, PeriodName: sorted[start].PeriodName, Standing: record.Standing}
+
+		var points, credits float64
+		for i := start; i < end; i++ {
+			a := sorted[i]
+			if !a.IsGraded() {
+				continue
+			}
+
+			points += *a.GradePoints * float64(a.Credits)
+			credits += float64(a.Credits)
+			term.CreditsAttempted += a.Credits
+			term.CreditsEarned += a.CreditsEarned
+
+			if previous, ok := counted[a.CourseID]; !ok || policy.replaces(sorted[previous], a) {
+				counted[a.CourseID] = i
+			}
+		}
+
+		if credits > 0 {
+			term.TermGPA = ptr(round2(points / credits))
+			record.summarize(sorted, counted)
+			term.CumulativeGPA = record.CumulativeGPA
+
+			term.Standing, probation = policy.standing(record.CumulativeGPA, probation)
+			record.Standing = term.Standing
+		}
+
+		// the courses share sorted, so they see the counted attempts
+		// marked below
+		term.Courses = sorted[start:end]
+		record.Terms = append(record.Terms, term)
+		start = end
+	}
+
+	for _, i := range counted {
+		sorted[i].Counted = true
+	}
+
+	return record
+}
+
+// summarize computes the cumulative figures of the counted attempts
+func (r *AcademicRecord) summarize(attempts []Attempt, counted map[valueobject.ID]int) {
+	var points, credits float64
+	r.CreditsAttempted, r.CreditsEarned = 0, 0
+
+	for _, i := range counted {
+		a := attempts[i]
+		points += *a.GradePoints * float64(a.Credits)
+		credits += float64(a.Credits)
+		r.CreditsAttempted += a.Credits
+		r.CreditsEarned += a.CreditsEarned
+	}
+
+	if credits > 0 {
+		r.CumulativeGPA = ptr(round2(points / credits))
+	}
+}
+
+// replaces checks if the attempt counts instead of the previous one of the
+// same course, attempts come in chronological order
+func (p StandingPolicy) replaces(previous, attempt Attempt) bool {
+	if p.Repeat == RepeatLatest {
+		return true
+	}
+	return *attempt.GradePoints >= *previous.GradePoints
+}
+
+// standing derives the standing of a graded term, probation counts the
+// terms in a row the student has been on probation
+func (p StandingPolicy) standing(gpa *float64, probation int) (Standing, int) {
+	switch {
+	case gpa == nil || *gpa >= p.ProbationBelow:
+		return StandingGood, 0
+	case *gpa < p.DismissalBelow:
+		return StandingDismissal, probation
+	case p.MaxProbationTerms > 0 && probation >= p.MaxProbationTerms:
+		return StandingDismissal, probation + 1
+	default:
+		return StandingProbation, probation + 1
+	}
+}
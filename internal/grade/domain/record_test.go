@@ -0,0 +1,201 @@
+package domain_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type term struct {
+	id    valueobject.ID
+	name  string
+	start time.Time
+}
+
+func terms(n int) []term {
+	list := make([]term, n)
+	for i := range list {
+		list[i] = term{
+			id:    valueobject.NewID(),
+			name:  fmt.Sprintf("Term %d", i+1),
+			start: time.Date(2022+i/2, time.Month(1+6*(i%2)), 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	return list
+}
+
+func attempt(t term, course valueobject.ID, code string, credits int, gradePoints float64) domain.Attempt {
+	status := "completed"
+	if gradePoints == 0 {
+		status = "failed"
+	}
+
+	earned := credits
+	if status == "failed" {
+		earned = 0
+	}
+
+	return domain.Attempt{
+		EnrollmentID:  valueobject.NewID(),
+		CourseID:      course,
+		CourseCode:    code,
+		Credits:       credits,
+		PeriodID:      t.id,
+		PeriodName:    t.name,
+		PeriodStart:   t.start,
+		Status:        status,
+		GradePoints:   ptr(gradePoints),
+		CreditsEarned: earned,
+	}
+}
+
+func TestNewAcademicRecordRepeatPolicy(t *testing.T) {
+	periods := terms(3)
+	calculus, physics := valueobject.NewID(), valueobject.NewID()
+
+	// MAT101 is failed, passed with a 3 and retaken for a 2, the attempts
+	// are given out of order
+	attempts := []domain.Attempt{
+		attempt(periods[2], calculus, "MAT101", 3, 2),
+		attempt(periods[0], physics, "PHY101", 4, 4),
+		attempt(periods[1], calculus, "MAT101", 3, 3),
+		attempt(periods[0], calculus, "MAT101", 3, 0),
+	}
+
+	tests := []struct {
+		policy     domain.RepeatPolicy
+		cumulative []float64
+		counted    []string
+	}{
+		{policy: domain.RepeatBest, cumulative: []float64{2.29, 3.57, 3.57}, counted: []string{"Term 1 PHY101", "Term 2 MAT101"}},
+		{policy: domain.RepeatLatest, cumulative: []float64{2.29, 3.57, 3.14}, counted: []string{"Term 1 PHY101", "Term 3 MAT101"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			record := domain.NewAcademicRecord(valueobject.NewID(), attempts, domain.StandingPolicy{Repeat: tt.policy, ProbationBelow: 2, DismissalBelow: 1}, time.Now())
+
+			if len(record.Terms) != 3 {
+				t.Fatalf("got %d terms, want 3", len(record.Terms))
+			}
+
+			var counted []string
+			for i, term := range record.Terms {
+				if term.PeriodName != periods[i].name {
+					t.Errorf("got %s in place of %s", term.PeriodName, periods[i].name)
+				}
+				if !equalScore(term.CumulativeGPA, &tt.cumulative[i]) {
+					t.Errorf("%s: got cumulative GPA %v, want %v", term.PeriodName, value(term.CumulativeGPA), tt.cumulative[i])
+				}
+				for _, c := range term.Courses {
+					if c.Counted {
+						counted = append(counted, term.PeriodName+" "+c.CourseCode)
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(counted, tt.counted) {
+				t.Errorf("got counted attempts %v, want %v", counted, tt.counted)
+			}
+
+			// term GPAs count every attempt of the term
+			for i, want := range []float64{2.29, 3, 2} {
+				if !equalScore(record.Terms[i].TermGPA, &want) {
+					t.Errorf("%s: got term GPA %v, want %v", record.Terms[i].PeriodName, value(record.Terms[i].TermGPA), want)
+				}
+			}
+
+			if !equalScore(record.CumulativeGPA, &tt.cumulative[2]) || record.CreditsAttempted != 7 || record.CreditsEarned != 7 {
+				t.Errorf("got cumulative GPA %v over %d credits, %d earned", value(record.CumulativeGPA), record.CreditsAttempted, record.CreditsEarned)
+			}
+		})
+	}
+}
+
+func TestNewAcademicRecordStanding(t *testing.T) {
+	const ungraded = -1
+
+	tests := []struct {
+		name string
+		// gpas has a 3 credit course per term, ungraded terms have an
+		// enrollment in progress
+		gpas     []float64
+		maxTerms int
+		want     []domain.Standing
+	}{
+		{
+			name:     "good standing",
+			gpas:     []float64{3, 2},
+			maxTerms: 2,
+			want:     []domain.Standing{domain.StandingGood, domain.StandingGood},
+		},
+		{
+			name:     "dismissed after too many terms on probation",
+			gpas:     []float64{1.5, 1.5, 1.5},
+			maxTerms: 2,
+			want:     []domain.Standing{domain.StandingProbation, domain.StandingProbation, domain.StandingDismissal},
+		},
+		{
+			name: "no limit of terms on probation",
+			gpas: []float64{1.5, 1.5, 1.5},
+			want: []domain.Standing{domain.StandingProbation, domain.StandingProbation, domain.StandingProbation},
+		},
+		{
+			name:     "recovering resets the terms on probation",
+			gpas:     []float64{1.5, 3, 1, 1},
+			maxTerms: 2,
+			want:     []domain.Standing{domain.StandingProbation, domain.StandingGood, domain.StandingProbation, domain.StandingProbation},
+		},
+		{
+			name:     "dismissed under the dismissal GPA",
+			gpas:     []float64{0.5},
+			maxTerms: 2,
+			want:     []domain.Standing{domain.StandingDismissal},
+		},
+		{
+			name:     "ungraded terms keep the standing and are not counted",
+			gpas:     []float64{1.5, ungraded, 1.5},
+			maxTerms: 1,
+			want:     []domain.Standing{domain.StandingProbation, domain.StandingProbation, domain.StandingDismissal},
+		},
+		{
+			name:     "nothing graded",
+			gpas:     []float64{ungraded},
+			maxTerms: 1,
+			want:     []domain.Standing{domain.StandingGood},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := terms(len(tt.gpas))
+
+			var attempts []domain.Attempt
+			for i, gpa := range tt.gpas {
+				a := attempt(periods[i], valueobject.NewID(), fmt.Sprintf("CRS%d", i), 3, gpa)
+				if gpa == ungraded {
+					a.Status, a.GradePoints, a.CreditsEarned = "enrolled", nil, 0
+				}
+				attempts = append(attempts, a)
+			}
+
+			policy := domain.StandingPolicy{Repeat: domain.RepeatBest, ProbationBelow: 2, DismissalBelow: 1, MaxProbationTerms: tt.maxTerms}
+			record := domain.NewAcademicRecord(valueobject.NewID(), attempts, policy, time.Now())
+
+			var got []domain.Standing
+			for _, term := range record.Terms {
+				got = append(got, term.Standing)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got standings %v, want %v", got, tt.want)
+			}
+			if record.Standing != tt.want[len(tt.want)-1] {
+				t.Errorf("got standing %s, want the one of the last term %s", record.Standing, tt.want[len(tt.want)-1])
+			}
+		})
+	}
+}
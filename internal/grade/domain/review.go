@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrReviewNotFound    = errors.New("the final grades of the section were not submitted")
+	ErrNotDepartmentHead = errors.New("only the head of the department offering the course can review its final grades")
+	ErrGradesUnderReview = errors.New("the final grades of the section are waiting for review")
+	ErrGradesPublished   = errors.New("the final grades of the section are already published")
+	ErrGradesIncomplete  = errors.New("every student of the section needs a final grade and letter before submitting")
+	ErrNotUnderReview    = errors.New("the final grades of the section are not waiting for review")
+	ErrCommentsRequired  = errors.New("returned grades need comments for the professor")
+	ErrNoGradeScale      = errors.New("no grade scale applies to the student, a default scale is needed")
+)
+
+type ReviewRepository interface {
+	// IsDepartmentHead checks if the user heads the department offering the
+	// course of the section
+	IsDepartmentHead(ctx context.Context, sectionID, userID valueobject.ID) (bool, error)
+	// Latest returns the last time the grades of the section were submitted
+	Latest(ctx context.Context, sectionID valueobject.ID) (*Review, error)
+	// History returns every submission of the section, the latest first
+	History(ctx context.Context, sectionID valueobject.ID) ([]*Review, error)
+	// FinalGrades returns the stored final grade of every student taking the
+	// section, students without one have an empty grade
+	FinalGrades(ctx context.Context, sectionID valueobject.ID) ([]FinalGrade, error)
+	Submit(ctx context.Context, r *Review) (err error)
+	Return(ctx context.Context, r *Review) (err error)
+	// Publish approves the review, publishes the grades and copies them into
+	// the enrollments, completing or failing them
+	Publish(ctx context.Context, r *Review, grades []PublishedGrade) (err error)
+}
+
+// ReviewStatus is the state of the final grades of a section
+type ReviewStatus string
+
+const (
+	ReviewSubmitted ReviewStatus = "submitted"
+	ReviewReturned  ReviewStatus = "returned"
+	ReviewApproved  ReviewStatus = "approved"
+)
+
+// Review is a submission of the final grades of a section to the head of the
+// department
+type Review struct {
+	ID          valueobject.ID  `json:"id"`
+	SectionID   valueobject.ID  `json:"course_section_id"`
+	Status      ReviewStatus    `json:"status"`
+	SubmittedBy valueobject.ID  `json:"submitted_by"`
+	SubmittedAt time.Time       `json:"submitted_at"`
+	ReviewedBy  *valueobject.ID `json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time      `json:"reviewed_at,omitempty"`
+	Comments    string          `json:"comments,omitempty"`
+}
+
+// FinalGrade is the stored final grade of a student of a section
+type FinalGrade struct {
+	ID valueobject.ID `json:"-"`
+	SectionStudent
+	FinalScore  *float64   `json:"final_score"`
+	LetterGrade string     `json:"letter_grade,omitempty"`
+	GradePoints *float64   `json:"grade_points,omitempty"`
+	IsApproved  bool       `json:"is_approved"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+}
+
+// PublishedGrade is what the enrollment of a student keeps from its final
+// grade, passing grades complete it and earn the credits of the course
+type PublishedGrade struct {
+	EnrollmentID valueobject.ID
+	FinalGradeID valueobject.ID
+	FinalScore   float64
+	LetterGrade  string
+	GradePoints  float64
+	IsPassing    bool
+}
+
+// NewReview submits the final grades of the section, every student needs one
+func NewReview(sectionID, professorID valueobject.ID, grades []FinalGrade, now time.Time) (*Review, error) {
+	var missing int
+	for _, g := range grades {
+		if g.FinalScore == nil || g.LetterGrade == "" {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return nil, fmt.Errorf("%w: %d students are missing it", ErrGradesIncomplete, missing)
+	}
+
+	return &Review{
+		ID:          valueobject.NewID(),
+		SectionID:   sectionID,
+		Status:      ReviewSubmitted,
+		SubmittedBy: professorID,
+		SubmittedAt: now,
+	}, nil
+}
+
+// Approve accepts the submitted grades so they are published
+func (r *Review) Approve(headID valueobject.ID, comments string, now time.Time) error {
+	if r.Status != ReviewSubmitted {
+		return ErrNotUnderReview
+	}
+
+	r.review(ReviewApproved, headID, comments, now)
+	return nil
+}
+
+// Return sends the grades back to the professor with what has to be fixed
+func (r *Review) Return(headID valueobject.ID, comments string, now time.Time) error {
+	if r.Status != ReviewSubmitted {
+		return ErrNotUnderReview
+	}
+	if strings.TrimSpace(comments) == "" {
+		return ErrCommentsRequired
+	}
+
+	r.review(ReviewReturned, headID, comments, now)
+	return nil
+}
+
+func (r *Review) review(status ReviewStatus, headID valueobject.ID, comments string, now time.Time) {
+	r.Status = status
+	r.ReviewedBy = &headID
+	r.ReviewedAt = &now
+	r.Comments = strings.TrimSpace(comments)
+}
+
+// Publish derives the letter of the final score with the scale of the
+// student, the letter stored with the grade may come from an older scale
+func (g FinalGrade) Publish(s *Scale) (PublishedGrade, error) {
+	if g.FinalScore == nil {
+		return PublishedGrade{}, ErrGradesIncomplete
+	}
+	if s == nil {
+		return PublishedGrade{}, ErrNoGradeScale
+	}
+
+	band := s.Grade(*g.FinalScore)
+	return PublishedGrade{
+		EnrollmentID: g.EnrollmentID,
+		FinalGradeID: g.ID,
+		FinalScore:   *g.FinalScore,
+		LetterGrade:  band.Letter,
+		GradePoints:  band.GradePoints,
+		IsPassing:    band.IsPassing,
+	}, nil
+}
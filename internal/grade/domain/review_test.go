@@ -0,0 +1,148 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func TestReviewTransitions(t *testing.T) {
+	statuses := []domain.ReviewStatus{domain.ReviewSubmitted, domain.ReviewReturned, domain.ReviewApproved}
+
+	actions := map[string]func(r *domain.Review, head valueobject.ID, at time.Time) error{
+		"approve": func(r *domain.Review, head valueobject.ID, at time.Time) error {
+			return r.Approve(head, " looks good ", at)
+		},
+		"return": func(r *domain.Review, head valueobject.ID, at time.Time) error {
+			return r.Return(head, " looks good ", at)
+		},
+	}
+
+	// only submitted grades are reviewed, a returned submission is replaced
+	// by a new one and approved grades are final
+	allowed := map[string]map[domain.ReviewStatus]domain.ReviewStatus{
+		"approve": {domain.ReviewSubmitted: domain.ReviewApproved},
+		"return":  {domain.ReviewSubmitted: domain.ReviewReturned},
+	}
+
+	submitted := time.Date(2025, time.December, 15, 9, 0, 0, 0, time.UTC)
+	reviewed := submitted.Add(48 * time.Hour)
+
+	for name, action := range actions {
+		for _, from := range statuses {
+			r := &domain.Review{ID: valueobject.NewID(), Status: from, SubmittedBy: valueobject.NewID(), SubmittedAt: submitted}
+			head := valueobject.NewID()
+
+			err := action(r, head, reviewed)
+
+			to, ok := allowed[name][from]
+			if !ok {
+				if !errors.Is(err, domain.ErrNotUnderReview) {
+					t.Errorf("%s %s: got error %v, want %v", name, from, err, domain.ErrNotUnderReview)
+				}
+				if r.Status != from || r.ReviewedBy != nil || r.ReviewedAt != nil || r.Comments != "" {
+					t.Errorf("%s %s: got %+v, the review changed on error", name, from, r)
+				}
+				continue
+			}
+
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", name, from, err)
+				continue
+			}
+			if r.Status != to {
+				t.Errorf("%s %s: got status %s, want %s", name, from, r.Status, to)
+			}
+			if r.ReviewedBy == nil || !r.ReviewedBy.Equals(head) || r.ReviewedAt == nil || !r.ReviewedAt.Equal(reviewed) {
+				t.Errorf("%s %s: got %+v, want it reviewed by the head", name, from, r)
+			}
+			if r.Comments != "looks good" {
+				t.Errorf("%s %s: got comments %q, want them trimmed", name, from, r.Comments)
+			}
+		}
+	}
+}
+
+func TestReviewComments(t *testing.T) {
+	tests := []struct {
+		name     string
+		approve  bool
+		comments string
+		want     domain.ReviewStatus
+		wantErr  error
+	}{
+		{name: "approves without comments", approve: true, want: domain.ReviewApproved},
+		{name: "approves with comments", approve: true, comments: "thanks", want: domain.ReviewApproved},
+		{name: "returns with comments", comments: "check the final exam scores", want: domain.ReviewReturned},
+		{name: "refuses returning without comments", wantErr: domain.ErrCommentsRequired},
+		{name: "refuses returning with blank comments", comments: " \n ", wantErr: domain.ErrCommentsRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &domain.Review{Status: domain.ReviewSubmitted}
+			now := time.Date(2025, time.December, 17, 9, 0, 0, 0, time.UTC)
+
+			var err error
+			if tt.approve {
+				err = r.Approve(valueobject.NewID(), tt.comments, now)
+			} else {
+				err = r.Return(valueobject.NewID(), tt.comments, now)
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if r.Status != domain.ReviewSubmitted || r.ReviewedBy != nil {
+					t.Errorf("got %+v, the review changed on error", r)
+				}
+				return
+			}
+			if r.Status != tt.want {
+				t.Errorf("got status %s, want %s", r.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewReview(t *testing.T) {
+	score := 91.5
+	graded := domain.FinalGrade{FinalScore: &score, LetterGrade: "A"}
+
+	tests := []struct {
+		name    string
+		grades  []domain.FinalGrade
+		wantErr error
+	}{
+		{name: "every student graded", grades: []domain.FinalGrade{graded, graded}},
+		{name: "no students", grades: nil},
+		{name: "a student without a score", grades: []domain.FinalGrade{graded, {LetterGrade: "A"}}, wantErr: domain.ErrGradesIncomplete},
+		{name: "a student without a letter", grades: []domain.FinalGrade{{FinalScore: &score}, graded}, wantErr: domain.ErrGradesIncomplete},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			section, professor := valueobject.NewID(), valueobject.NewID()
+			now := time.Date(2025, time.December, 15, 9, 0, 0, 0, time.UTC)
+
+			r, err := domain.NewReview(section, professor, tt.grades, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if r.Status != domain.ReviewSubmitted || !r.SectionID.Equals(section) || !r.SubmittedBy.Equals(professor) || !r.SubmittedAt.Equal(now) {
+				t.Errorf("got %+v, want it submitted by the professor", r)
+			}
+			if r.ReviewedBy != nil || r.ReviewedAt != nil {
+				t.Errorf("got %+v, want it waiting for review", r)
+			}
+		})
+	}
+}
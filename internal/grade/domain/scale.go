@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	// MaxBands caps the letters a scale can have
+	MaxBands = 20
+	// MaxGradePoints is the highest grade points a letter can be worth
+	MaxGradePoints = 5
+	// WithdrawalMark is kept by the enrollments for withdrawals, no scale
+	// can use it
+	WithdrawalMark = "W"
+)
+
+var (
+	ErrScaleNotFound       = errors.New("grade scale does not exist")
+	ErrDegreeNotFound      = errors.New("degree does not exist")
+	ErrDepartmentNotFound  = errors.New("department does not exist")
+	ErrInvalidScale        = errors.New("invalid grade scale")
+	ErrInvalidLetter       = errors.New("letters are one uppercase letter optionally followed by + or -, W is reserved for withdrawals")
+	ErrDuplicatedLetter    = errors.New("each letter can only appear once in the scale")
+	ErrDuplicatedMinScore  = errors.New("each band of the scale needs its own minimum score")
+	ErrInvalidBandValue    = errors.New("minimum scores go from 0 to 100 and grade points from 0 to 5")
+	ErrScaleDoesNotStartAt = errors.New("the lowest band of the scale must start at 0")
+	ErrGradePointsOrder    = errors.New("higher bands cannot be worth fewer grade points")
+	ErrPassingOrder        = errors.New("bands above a passing band must also pass")
+	ErrScaleNameTaken      = errors.New("a grade scale with that name already exists")
+	ErrScaleInUse          = errors.New("the grade scale is assigned to degrees or departments")
+	ErrDefaultScale        = errors.New("another scale must become the default first")
+)
+
+var letterPattern = regexp.MustCompile(`^[A-Z][+-]?$`)
+
+type ScaleRepository interface {
+	// Save stores the scale with its bands, a default scale takes the place
+	// of the previous one
+	Save(ctx context.Context, s *Scale) (err error)
+	Delete(ctx context.Context, id valueobject.ID) (err error)
+	FindByID(ctx context.Context, id valueobject.ID) (*Scale, error)
+	List(ctx context.Context) ([]*Scale, error)
+	// AssignToDegree sets the scale of the degree, nil goes back to the one
+	// of its department
+	AssignToDegree(ctx context.Context, degreeID valueobject.ID, scaleID *valueobject.ID) (err error)
+	// AssignToDepartment sets the scale of the department, nil goes back to
+	// the default one
+	AssignToDepartment(ctx context.Context, departmentID valueobject.ID, scaleID *valueobject.ID) (err error)
+	// Applicable returns the scale each enrollment of the section is graded
+	// with, by enrollment id
+	Applicable(ctx context.Context, sectionID valueobject.ID) (map[valueobject.ID]*Scale, error)
+}
+
+// Band is the letter given to the final scores from MinScore up to the next
+// band
+type Band struct {
+	Letter      string  `json:"letter"`
+	MinScore    float64 `json:"min_score"`
+	GradePoints float64 `json:"grade_points"`
+	IsPassing   bool    `json:"is_passing"`
+}
+
+// Scale maps final scores to letters and grade points
+type Scale struct {
+	ID        valueobject.ID `json:"id"`
+	Name      string         `json:"name"`
+	IsDefault bool           `json:"is_default"`
+	// Bands are ordered from the highest score down
+	Bands     []Band    `json:"bands"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewScale creates a new Scale with validation
+func NewScale(name string, bands []Band, isDefault bool, now time.Time) (*Scale, error) {
+	s := &Scale{ID: valueobject.NewID(), CreatedAt: now}
+	if err := s.Update(name, bands, isDefault, now); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update replaces the name and bands of the scale. The default scale stays
+// so until another one takes its place.
+func (s *Scale) Update(name string, bands []Band, isDefault bool, now time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: it needs a name", ErrInvalidScale)
+	}
+	if len(bands) == 0 || len(bands) > MaxBands {
+		return fmt.Errorf("%w: it needs from 1 to %d bands", ErrInvalidScale, MaxBands)
+	}
+	if s.IsDefault && !isDefault {
+		return ErrDefaultScale
+	}
+
+	sorted := make([]Band, len(bands))
+	copy(sorted, bands)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].MinScore > sorted[j].MinScore })
+
+	letters := make(map[string]bool)
+	for i, b := range sorted {
+		b.Letter = strings.ToUpper(strings.TrimSpace(b.Letter))
+		if !letterPattern.MatchString(b.Letter) || b.Letter == WithdrawalMark {
+			return ErrInvalidLetter
+		}
+		if letters[b.Letter] {
+			return ErrDuplicatedLetter
+		}
+		letters[b.Letter] = true
+
+		if b.MinScore < 0 || b.MinScore > 100 || b.GradePoints < 0 || b.GradePoints > MaxGradePoints {
+			return ErrInvalidBandValue
+		}
+
+		if i > 0 {
+			above := sorted[i-1]
+			if above.MinScore == b.MinScore {
+				return ErrDuplicatedMinScore
+			}
+			if above.GradePoints < b.GradePoints {
+				return ErrGradePointsOrder
+			}
+			if b.IsPassing && !above.IsPassing {
+				return ErrPassingOrder
+			}
+		}
+		sorted[i] = b
+	}
+
+	if sorted[len(sorted)-1].MinScore != 0 {
+		return ErrScaleDoesNotStartAt
+	}
+
+	s.Name = name
+	s.Bands = sorted
+	s.IsDefault = isDefault
+	s.UpdatedAt = now
+	return nil
+}
+
+// Grade returns the band the final score falls in
+func (s Scale) Grade(score float64) Band {
+	for _, b := range s.Bands {
+		if score >= b.MinScore {
+			return b
+		}
+	}
+	// validated scales always start at 0
+	return s.Bands[len(s.Bands)-1]
+}
@@ -0,0 +1,122 @@
+package domain_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+)
+
+// letterBands is the usual A to F scale, given out of order
+func letterBands() []domain.Band {
+	return []domain.Band{
+		{Letter: "c", MinScore: 70, GradePoints: 2, IsPassing: true},
+		{Letter: "A", MinScore: 90, GradePoints: 4, IsPassing: true},
+		{Letter: "F", MinScore: 0, GradePoints: 0},
+		{Letter: " b+ ", MinScore: 85, GradePoints: 3.3, IsPassing: true},
+		{Letter: "B", MinScore: 80, GradePoints: 3, IsPassing: true},
+		{Letter: "D", MinScore: 60, GradePoints: 1},
+	}
+}
+
+func TestScaleUpdate(t *testing.T) {
+	with := func(i int, change func(b *domain.Band)) []domain.Band {
+		bands := letterBands()
+		change(&bands[i])
+		return bands
+	}
+
+	tests := []struct {
+		name    string
+		scale   domain.Scale
+		title   string
+		bands   []domain.Band
+		dflt    bool
+		wantErr error
+	}{
+		{name: "valid", title: "Letters", bands: letterBands()},
+		{name: "a single band", title: "Pass", bands: []domain.Band{{Letter: "P", GradePoints: 4, IsPassing: true}}},
+		{name: "blank name", title: " ", bands: letterBands(), wantErr: domain.ErrInvalidScale},
+		{name: "no bands", title: "Letters", wantErr: domain.ErrInvalidScale},
+		{name: "too many bands", title: "Letters", bands: make([]domain.Band, domain.MaxBands+1), wantErr: domain.ErrInvalidScale},
+		{name: "stops being the default", scale: domain.Scale{IsDefault: true}, title: "Letters", bands: letterBands(), wantErr: domain.ErrDefaultScale},
+		{name: "two letters", title: "Letters", bands: with(0, func(b *domain.Band) { b.Letter = "CD" }), wantErr: domain.ErrInvalidLetter},
+		{name: "withdrawal mark", title: "Letters", bands: with(0, func(b *domain.Band) { b.Letter = "w" }), wantErr: domain.ErrInvalidLetter},
+		{name: "repeated letter", title: "Letters", bands: with(0, func(b *domain.Band) { b.Letter = "a" }), wantErr: domain.ErrDuplicatedLetter},
+		{name: "score over 100", title: "Letters", bands: with(1, func(b *domain.Band) { b.MinScore = 101 }), wantErr: domain.ErrInvalidBandValue},
+		{name: "too many grade points", title: "Letters", bands: with(1, func(b *domain.Band) { b.GradePoints = 5.1 }), wantErr: domain.ErrInvalidBandValue},
+		{name: "repeated min score", title: "Letters", bands: with(0, func(b *domain.Band) { b.MinScore = 80 }), wantErr: domain.ErrDuplicatedMinScore},
+		{name: "lower band worth more", title: "Letters", bands: with(5, func(b *domain.Band) { b.GradePoints = 2.5 }), wantErr: domain.ErrGradePointsOrder},
+		{name: "passing under a failing band", title: "Letters", bands: with(2, func(b *domain.Band) { b.IsPassing = true }), wantErr: domain.ErrPassingOrder},
+		{name: "does not start at 0", title: "Letters", bands: with(2, func(b *domain.Band) { b.MinScore = 10 }), wantErr: domain.ErrScaleDoesNotStartAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scale := tt.scale
+			before := scale
+
+			err := scale.Update(tt.title, tt.bands, tt.dflt, time.Now())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && !reflect.DeepEqual(scale, before) {
+				t.Errorf("the scale changed on error: %+v", scale)
+			}
+		})
+	}
+}
+
+func TestNewScaleSortsAndNormalizes(t *testing.T) {
+	bands := letterBands()
+	scale, err := domain.NewScale(" Letters ", bands, true, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var letters []string
+	for _, b := range scale.Bands {
+		letters = append(letters, b.Letter)
+	}
+	if want := []string{"A", "B+", "B", "C", "D", "F"}; !reflect.DeepEqual(letters, want) {
+		t.Errorf("got bands %v, want %v", letters, want)
+	}
+	if scale.Name != "Letters" || !scale.IsDefault {
+		t.Errorf("got name %q and default %v", scale.Name, scale.IsDefault)
+	}
+	if bands[0].Letter != "c" {
+		t.Error("the given bands were modified")
+	}
+}
+
+func TestScaleGrade(t *testing.T) {
+	scale, err := domain.NewScale("Letters", letterBands(), false, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		score   float64
+		letter  string
+		passing bool
+	}{
+		{score: 100, letter: "A", passing: true},
+		{score: 90, letter: "A", passing: true},
+		{score: 89.99, letter: "B+", passing: true},
+		{score: 85, letter: "B+", passing: true},
+		{score: 80, letter: "B", passing: true},
+		{score: 70, letter: "C", passing: true},
+		{score: 69.99, letter: "D"},
+		{score: 0, letter: "F"},
+		{score: -1, letter: "F"},
+	}
+
+	for _, tt := range tests {
+		band := scale.Grade(tt.score)
+		if band.Letter != tt.letter || band.IsPassing != tt.passing {
+			t.Errorf("Grade(%v): got %s passing %v, want %s passing %v", tt.score, band.Letter, band.IsPassing, tt.letter, tt.passing)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	// MaxDropLowest is how many scores a category can drop
+	MaxDropLowest = 10
+	// MaxExtraCredit caps the points extra credit categories add together
+	MaxExtraCredit = 20
+)
+
+var (
+	ErrSectionNotFound      = errors.New("course section does not exist")
+	ErrSchemeNotFound       = errors.New("the section has no grading scheme")
+	ErrNotSectionProfessor  = errors.New("only the professor assigned to the section can manage its grades")
+	ErrInvalidScheme        = errors.New("invalid grading scheme")
+	ErrInvalidCategoryType  = errors.New("category type must be homework, project, exam, quiz or essay")
+	ErrDuplicatedCategory   = errors.New("each assignment type can only appear once in the scheme")
+	ErrWeightsDontAddUp     = errors.New("the weights of the regular categories must add up to 100")
+	ErrExtraCreditTooLarge  = errors.New("extra credit categories cannot add more than 20 points")
+	ErrInvalidCategoryValue = errors.New("weights go from 0 to 100 and categories drop up to 10 scores")
+)
+
+type SchemeRepository interface {
+	// Save stores the scheme replacing the one the section had
+	Save(ctx context.Context, s *Scheme) (err error)
+	FindBySection(ctx context.Context, sectionID valueobject.ID) (*Scheme, error)
+}
+
+// AssignmentType mirrors the types of the assignments, each one is a
+// category of the scheme
+type AssignmentType string
+
+const (
+	TypeHomework AssignmentType = "homework"
+	TypeProject  AssignmentType = "project"
+	TypeExam     AssignmentType = "exam"
+	TypeQuiz     AssignmentType = "quiz"
+	TypeEssay    AssignmentType = "essay"
+)
+
+// IsValid checks if the assignment type is valid
+func (t AssignmentType) IsValid() bool {
+	switch t {
+	case TypeHomework, TypeProject, TypeExam, TypeQuiz, TypeEssay:
+		return true
+	default:
+		return false
+	}
+}
+
+// Category is the share of the final score given to the assignments of a
+// type. Extra credit categories add their weight on top of the regular ones.
+type Category struct {
+	Type   AssignmentType `json:"assignment_type"`
+	Weight float64        `json:"weight"`
+	// DropLowest is how many of the lowest scores of the category are ignored
+	DropLowest  int  `json:"drop_lowest"`
+	ExtraCredit bool `json:"extra_credit"`
+}
+
+// Scheme is how the final score of a section is computed from the scores of
+// its assignments
+type Scheme struct {
+	SectionID  valueobject.ID `json:"course_section_id"`
+	Categories []Category     `json:"categories"`
+	UpdatedBy  valueobject.ID `json:"updated_by"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+// NewScheme creates a new Scheme with validation
+func NewScheme(sectionID valueobject.ID, categories []Category, updatedBy valueobject.ID, now time.Time) (*Scheme, error) {
+	if len(categories) == 0 {
+		return nil, fmt.Errorf("%w: it needs at least one category", ErrInvalidScheme)
+	}
+
+	var (
+		seen               = make(map[AssignmentType]bool)
+		regular, extraOnly float64
+	)
+	for _, c := range categories {
+		if !c.Type.IsValid() {
+			return nil, ErrInvalidCategoryType
+		}
+		if seen[c.Type] {
+			return nil, ErrDuplicatedCategory
+		}
+		seen[c.Type] = true
+
+		if c.Weight <= 0 || c.Weight > 100 || c.DropLowest < 0 || c.DropLowest > MaxDropLowest {
+			return nil, ErrInvalidCategoryValue
+		}
+
+		if c.ExtraCredit {
+			extraOnly += c.Weight
+		} else {
+			regular += c.Weight
+		}
+	}
+
+	if math.Abs(regular-100) > 0.001 {
+		return nil, ErrWeightsDontAddUp
+	}
+
+	if extraOnly > MaxExtraCredit {
+		return nil, ErrExtraCreditTooLarge
+	}
+
+	return &Scheme{
+		SectionID:  sectionID,
+		Categories: categories,
+		UpdatedBy:  updatedBy,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Category returns the category of the assignment type
+func (s Scheme) Category(t AssignmentType) (Category, bool) {
+	for _, c := range s.Categories {
+		if c.Type == t {
+			return c, true
+		}
+	}
+	return Category{}, false
+}
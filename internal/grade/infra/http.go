@@ -0,0 +1,59 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type finalGradeHandler struct {
+	interactor application.FinalGradeInteractor
+}
+
+func NewFinalGradeHandler(uc application.FinalGradeInteractor) *finalGradeHandler {
+	return &finalGradeHandler{uc}
+}
+
+func (h finalGradeHandler) SetScheme(c fiber.Ctx) error {
+	var req application.SchemeInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.SetScheme(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h finalGradeHandler) GetScheme(c fiber.Ctx) error {
+	data, err := h.interactor.GetScheme(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h finalGradeHandler) Preview(c fiber.Ctx) error {
+	data, err := h.interactor.Preview(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h finalGradeHandler) Write(c fiber.Ctx) error {
+	data, err := h.interactor.Write(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
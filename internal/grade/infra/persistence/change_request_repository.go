@@ -0,0 +1,162 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const changeRequestColumns = `
+	r.id, r.final_grade_id, e.course_section_id, e.id, e.student_id,
+	r.requested_by, r.requested_at, r.reason,
+	r.previous_score, COALESCE(r.previous_letter, ''), r.new_score, COALESCE(r.new_letter, ''),
+	r.status, r.reviewed_by, r.reviewed_at, COALESCE(r.review_comments, '')
+`
+
+const changeRequestTables = `
+	FROM grade_change_requests r
+	JOIN final_grades fg ON fg.id = r.final_grade_id
+	JOIN enrollments e ON e.id = fg.enrollment_id
+`
+
+type postgresChangeRequestRepository struct {
+	pool *sql.DB
+}
+
+func NewChangeRequestRepository(db *sql.DB) *postgresChangeRequestRepository {
+	return &postgresChangeRequestRepository{db}
+}
+
+func (r postgresChangeRequestRepository) Create(ctx context.Context, c *domain.ChangeRequest) error {
+	query := `
+		INSERT INTO grade_change_requests (
+			id, final_grade_id, requested_by, requested_at, reason,
+			previous_score, previous_letter, new_score, new_letter, status
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, NULLIF($9, ''), $10)
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		c.ID.String(),
+		c.FinalGradeID.String(),
+		c.RequestedBy.String(),
+		c.RequestedAt,
+		c.Reason,
+		c.PreviousScore,
+		c.PreviousLetter,
+		c.NewScore,
+		c.NewLetter,
+		string(c.Status),
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsUniqueConstraintViolation(pgerr) {
+				return domain.ErrChangePending
+			}
+			if db.IsForeignKeyViolation(pgerr) {
+				return domain.ErrStudentNotGraded
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r postgresChangeRequestRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.ChangeRequest, error) {
+	query := `SELECT ` + changeRequestColumns + changeRequestTables + ` WHERE r.id = $1`
+
+	c, err := scanChangeRequest(r.pool.QueryRowContext(ctx, query, id.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrChangeRequestNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r postgresChangeRequestRepository) ListBySection(ctx context.Context, sectionID valueobject.ID) ([]*domain.ChangeRequest, error) {
+	query := `SELECT ` + changeRequestColumns + changeRequestTables + ` WHERE e.course_section_id = $1 ORDER BY r.requested_at DESC`
+
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := []*domain.ChangeRequest{}
+	for rows.Next() {
+		c, err := scanChangeRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, c)
+	}
+
+	return requests, rows.Err()
+}
+
+func (r postgresChangeRequestRepository) Resolve(ctx context.Context, c *domain.ChangeRequest, grade *domain.PublishedGrade) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE grade_change_requests SET
+			status = $2,
+			new_letter = NULLIF($3, ''),
+			reviewed_by = $4,
+			reviewed_at = $5,
+			review_comments = NULLIF($6, '')
+		WHERE id = $1 AND status = 'pending'
+	`
+	result, err := tx.ExecContext(ctx, query,
+		c.ID.String(),
+		string(c.Status),
+		c.NewLetter,
+		c.ReviewedBy.String(),
+		c.ReviewedAt,
+		c.ReviewComments,
+	)
+	if err != nil {
+		return err
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrChangeResolved
+	}
+
+	if grade != nil {
+		if err := publish(ctx, tx, *grade, *c.ReviewedAt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func scanChangeRequest(row scanner) (*domain.ChangeRequest, error) {
+	var (
+		c          domain.ChangeRequest
+		reviewedBy valueobject.ID
+		reviewedAt sql.NullTime
+	)
+	if err := row.Scan(&c.ID, &c.FinalGradeID, &c.SectionID, &c.EnrollmentID, &c.StudentID,
+		&c.RequestedBy, &c.RequestedAt, &c.Reason,
+		&c.PreviousScore, &c.PreviousLetter, &c.NewScore, &c.NewLetter,
+		&c.Status, &reviewedBy, &reviewedAt, &c.ReviewComments); err != nil {
+		return nil, err
+	}
+
+	if !reviewedBy.IsEmpty() {
+		c.ReviewedBy = &reviewedBy
+	}
+	if reviewedAt.Valid {
+		c.ReviewedAt = &reviewedAt.Time
+	}
+	return &c, nil
+}
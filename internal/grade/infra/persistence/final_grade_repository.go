@@ -0,0 +1,167 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresFinalGradeRepository struct {
+	pool *sql.DB
+}
+
+func NewFinalGradeRepository(db *sql.DB) *postgresFinalGradeRepository {
+	return &postgresFinalGradeRepository{db}
+}
+
+func (r postgresFinalGradeRepository) IsProfessor(ctx context.Context, sectionID, userID valueobject.ID) (bool, error) {
+	var exists, professor bool
+
+	query := `
+		SELECT
+			EXISTS (SELECT 1 FROM course_sections WHERE id = $1),
+			EXISTS (SELECT 1 FROM course_sections WHERE id = $1 AND professor_id = $2)
+	`
+	if err := r.pool.QueryRowContext(ctx, query, sectionID.String(), userID.String()).Scan(&exists, &professor); err != nil {
+		return false, err
+	}
+
+	if !exists {
+		return false, domain.ErrSectionNotFound
+	}
+	return professor, nil
+}
+
+func (r postgresFinalGradeRepository) Scores(ctx context.Context, sectionID valueobject.ID) (*domain.SectionScores, error) {
+	scores := &domain.SectionScores{SectionID: sectionID, Points: make(map[domain.ScoreKey]float64)}
+
+	students := `
+		SELECT e.id, e.student_id, u.first_name, u.last_name
+		FROM enrollments e
+		JOIN users u ON u.id = e.student_id
+		WHERE e.course_section_id = $1 AND COALESCE(e.status, 'enrolled') NOT IN ('dropped', 'withdrawn')
+		ORDER BY u.last_name, u.first_name, u.id
+	`
+	rows, err := r.pool.QueryContext(ctx, students, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var s domain.SectionStudent
+		if err := rows.Scan(&s.EnrollmentID, &s.StudentID, &s.FirstName, &s.LastName); err != nil {
+			return nil, err
+		}
+		scores.Students = append(scores.Students, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	assignments := `
+		SELECT id, COALESCE(assignment_type, ''), max_points
+		FROM assignments
+		WHERE course_section_id = $1 AND COALESCE(is_published, false)
+	`
+	rows, err = r.pool.QueryContext(ctx, assignments, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a domain.ScoredAssignment
+		if err := rows.Scan(&a.ID, &a.Type, &a.MaxPoints); err != nil {
+			return nil, err
+		}
+		scores.Assignments = append(scores.Assignments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// work handed in but not graded yet doesn't count
+	points := `
+		SELECT s.assignment_id, s.student_id, s.points_earned
+		FROM submissions s
+		JOIN assignments a ON a.id = s.assignment_id
+		WHERE a.course_section_id = $1
+		  AND COALESCE(a.is_published, false)
+		  AND s.status IN ('graded', 'missing')
+		  AND s.points_earned IS NOT NULL
+	`
+	rows, err = r.pool.QueryContext(ctx, points, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			key    domain.ScoreKey
+			earned float64
+		)
+		if err := rows.Scan(&key.AssignmentID, &key.StudentID, &earned); err != nil {
+			return nil, err
+		}
+		scores.Points[key] = earned
+	}
+
+	return scores, rows.Err()
+}
+
+func (r postgresFinalGradeRepository) WriteScores(ctx context.Context, professorID valueobject.ID, grades []domain.ProvisionalGrade) (int, error) {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// approved or published grades only change through their own process
+	query := `
+		INSERT INTO final_grades (id, enrollment_id, professor_id, final_score, letter_grade, grade_points, updated_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NOW())
+		ON CONFLICT (enrollment_id) DO UPDATE SET
+			professor_id = EXCLUDED.professor_id,
+			final_score = EXCLUDED.final_score,
+			letter_grade = EXCLUDED.letter_grade,
+			grade_points = EXCLUDED.grade_points,
+			updated_at = EXCLUDED.updated_at
+		WHERE final_grades.published_at IS NULL
+		  AND NOT COALESCE(final_grades.is_approved, false)
+	`
+	var written int
+	for _, g := range grades {
+		if g.FinalScore == nil {
+			continue
+		}
+
+		result, err := tx.ExecContext(ctx, query,
+			valueobject.NewID().String(),
+			g.EnrollmentID.String(),
+			professorID.String(),
+			*g.FinalScore,
+			g.LetterGrade,
+			g.GradePoints,
+		)
+		if err != nil {
+			if ok, pgerr := db.IsPgError(err); ok {
+				return 0, db.ExchangePGError(pgerr)
+			}
+			return 0, err
+		}
+
+		if rows, err := result.RowsAffected(); err == nil {
+			written += int(rows)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return written, nil
+}
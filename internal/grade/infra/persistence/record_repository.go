@@ -0,0 +1,142 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresAcademicRecordRepository struct {
+	pool *sql.DB
+}
+
+func NewAcademicRecordRepository(db *sql.DB) *postgresAcademicRecordRepository {
+	return &postgresAcademicRecordRepository{db}
+}
+
+func (r postgresAcademicRecordRepository) Attempts(ctx context.Context, studentID valueobject.ID) ([]domain.Attempt, error) {
+	var exists bool
+	if err := r.pool.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM students WHERE id = $1)`, studentID.String()).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrStudentNotFound
+	}
+
+	// grades published before the scales existed only have a letter, they
+	// are worth the grade points of the letter on the default scale
+	query := `
+		SELECT e.id, cs.id, c.id, c.code, c.name, c.credits,
+			ap.id, ap.name, ap.start_date,
+			COALESCE(e.status, 'enrolled'), e.final_grade, COALESCE(e.letter_grade, ''),
+			COALESCE(e.grade_points, (
+				SELECT b.grade_points
+				FROM grade_scale_bands b
+				JOIN grade_scales s ON s.id = b.grade_scale_id
+				WHERE s.is_default AND b.letter = e.letter_grade
+			)),
+			COALESCE(e.credits_earned, 0)
+		FROM enrollments e
+		JOIN course_sections cs ON cs.id = e.course_section_id
+		JOIN courses c ON c.id = cs.course_id
+		JOIN academic_periods ap ON ap.id = cs.academic_period_id
+		WHERE e.student_id = $1 AND COALESCE(e.status, 'enrolled') <> 'dropped'
+		ORDER BY ap.start_date, c.code
+	`
+	rows, err := r.pool.QueryContext(ctx, query, studentID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []domain.Attempt
+	for rows.Next() {
+		var (
+			a                       domain.Attempt
+			finalGrade, gradePoints sql.NullFloat64
+		)
+		if err := rows.Scan(&a.EnrollmentID, &a.SectionID, &a.CourseID, &a.CourseCode, &a.CourseName, &a.Credits,
+			&a.PeriodID, &a.PeriodName, &a.PeriodStart,
+			&a.Status, &finalGrade, &a.LetterGrade, &gradePoints, &a.CreditsEarned); err != nil {
+			return nil, err
+		}
+
+		if finalGrade.Valid {
+			a.FinalGrade = &finalGrade.Float64
+		}
+		if gradePoints.Valid {
+			a.GradePoints = &gradePoints.Float64
+		}
+		attempts = append(attempts, a)
+	}
+
+	return attempts, rows.Err()
+}
+
+func (r postgresAcademicRecordRepository) Find(ctx context.Context, studentID valueobject.ID) (*domain.AcademicRecord, bool, error) {
+	var (
+		raw   []byte
+		stale bool
+	)
+
+	query := `
+		SELECT ar.record,
+			ar.computed_at < COALESCE((SELECT MAX(e.updated_at) FROM enrollments e WHERE e.student_id = ar.student_id), ar.computed_at)
+		FROM academic_records ar
+		WHERE ar.student_id = $1
+	`
+	if err := r.pool.QueryRowContext(ctx, query, studentID.String()).Scan(&raw, &stale); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, domain.ErrRecordNotFound
+		}
+		return nil, false, err
+	}
+
+	var record domain.AcademicRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, false, err
+	}
+	return &record, stale, nil
+}
+
+func (r postgresAcademicRecordRepository) Save(ctx context.Context, record *domain.AcademicRecord) error {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO academic_records (student_id, cumulative_gpa, credits_attempted, credits_earned, standing, record, computed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (student_id) DO UPDATE SET
+			cumulative_gpa = EXCLUDED.cumulative_gpa,
+			credits_attempted = EXCLUDED.credits_attempted,
+			credits_earned = EXCLUDED.credits_earned,
+			standing = EXCLUDED.standing,
+			record = EXCLUDED.record,
+			computed_at = EXCLUDED.computed_at
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		record.StudentID.String(),
+		record.CumulativeGPA,
+		record.CreditsAttempted,
+		record.CreditsEarned,
+		string(record.Standing),
+		raw,
+		record.ComputedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsForeignKeyViolation(pgerr) {
+				return domain.ErrStudentNotFound
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
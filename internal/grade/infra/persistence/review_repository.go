@@ -0,0 +1,265 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const reviewColumns = `id, course_section_id, status, submitted_by, submitted_at, reviewed_by, reviewed_at, COALESCE(comments, '')`
+
+type postgresReviewRepository struct {
+	pool *sql.DB
+}
+
+func NewReviewRepository(db *sql.DB) *postgresReviewRepository {
+	return &postgresReviewRepository{db}
+}
+
+func (r postgresReviewRepository) IsDepartmentHead(ctx context.Context, sectionID, userID valueobject.ID) (bool, error) {
+	var exists, head bool
+
+	query := `
+		SELECT
+			EXISTS (SELECT 1 FROM course_sections WHERE id = $1),
+			EXISTS (
+				SELECT 1
+				FROM course_sections cs
+				JOIN courses c ON c.id = cs.course_id
+				JOIN departments d ON d.id = c.department_id
+				WHERE cs.id = $1 AND d.head_id = $2
+			)
+	`
+	if err := r.pool.QueryRowContext(ctx, query, sectionID.String(), userID.String()).Scan(&exists, &head); err != nil {
+		return false, err
+	}
+
+	if !exists {
+		return false, domain.ErrSectionNotFound
+	}
+	return head, nil
+}
+
+func (r postgresReviewRepository) Latest(ctx context.Context, sectionID valueobject.ID) (*domain.Review, error) {
+	query := `SELECT ` + reviewColumns + ` FROM final_grade_reviews WHERE course_section_id = $1 ORDER BY submitted_at DESC LIMIT 1`
+
+	review, err := scanReview(r.pool.QueryRowContext(ctx, query, sectionID.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+func (r postgresReviewRepository) History(ctx context.Context, sectionID valueobject.ID) ([]*domain.Review, error) {
+	query := `SELECT ` + reviewColumns + ` FROM final_grade_reviews WHERE course_section_id = $1 ORDER BY submitted_at DESC`
+
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []*domain.Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, rows.Err()
+}
+
+func (r postgresReviewRepository) FinalGrades(ctx context.Context, sectionID valueobject.ID) ([]domain.FinalGrade, error) {
+	query := `
+		SELECT e.id, e.student_id, u.first_name, u.last_name,
+			fg.id, fg.final_score, COALESCE(fg.letter_grade, ''), fg.grade_points,
+			COALESCE(fg.is_approved, false), fg.published_at
+		FROM enrollments e
+		JOIN users u ON u.id = e.student_id
+		LEFT JOIN final_grades fg ON fg.enrollment_id = e.id
+		WHERE e.course_section_id = $1 AND COALESCE(e.status, 'enrolled') NOT IN ('dropped', 'withdrawn')
+		ORDER BY u.last_name, u.first_name, u.id
+	`
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grades := []domain.FinalGrade{}
+	for rows.Next() {
+		var (
+			g                       domain.FinalGrade
+			finalScore, gradePoints sql.NullFloat64
+			publishedAt             sql.NullTime
+		)
+		if err := rows.Scan(&g.EnrollmentID, &g.StudentID, &g.FirstName, &g.LastName,
+			&g.ID, &finalScore, &g.LetterGrade, &gradePoints, &g.IsApproved, &publishedAt); err != nil {
+			return nil, err
+		}
+
+		if finalScore.Valid {
+			g.FinalScore = &finalScore.Float64
+		}
+		if gradePoints.Valid {
+			g.GradePoints = &gradePoints.Float64
+		}
+		if publishedAt.Valid {
+			g.PublishedAt = &publishedAt.Time
+		}
+		grades = append(grades, g)
+	}
+
+	return grades, rows.Err()
+}
+
+func (r postgresReviewRepository) Submit(ctx context.Context, review *domain.Review) error {
+	query := `
+		INSERT INTO final_grade_reviews (id, course_section_id, status, submitted_by, submitted_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		review.ID.String(),
+		review.SectionID.String(),
+		string(review.Status),
+		review.SubmittedBy.String(),
+		review.SubmittedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsUniqueConstraintViolation(pgerr) {
+				return domain.ErrGradesUnderReview
+			}
+			if db.IsForeignKeyViolation(pgerr) {
+				return domain.ErrSectionNotFound
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r postgresReviewRepository) Return(ctx context.Context, review *domain.Review) error {
+	return decide(ctx, r.pool, review)
+}
+
+func (r postgresReviewRepository) Publish(ctx context.Context, review *domain.Review, grades []domain.PublishedGrade) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := decide(ctx, tx, review); err != nil {
+		return err
+	}
+
+	for _, g := range grades {
+		if err := publish(ctx, tx, g, *review.ReviewedAt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// execer is implemented by both the pool and its transactions
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// decide stores the decision on a review still waiting for it
+func decide(ctx context.Context, ex execer, review *domain.Review) error {
+	query := `
+		UPDATE final_grade_reviews SET
+			status = $2,
+			reviewed_by = $3,
+			reviewed_at = $4,
+			comments = NULLIF($5, '')
+		WHERE id = $1 AND status = 'submitted'
+	`
+	result, err := ex.ExecContext(ctx, query,
+		review.ID.String(),
+		string(review.Status),
+		review.ReviewedBy.String(),
+		review.ReviewedAt,
+		review.Comments,
+	)
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrNotUnderReview
+	}
+	return nil
+}
+
+// publish approves the final grade and copies it into its enrollment, the
+// course credits are only earned by passing grades
+func publish(ctx context.Context, ex execer, g domain.PublishedGrade, now time.Time) error {
+	grade := `
+		UPDATE final_grades SET
+			final_score = $2,
+			letter_grade = $3,
+			grade_points = $4,
+			is_approved = true,
+			published_at = $5,
+			updated_at = $5
+		WHERE id = $1
+	`
+	if _, err := ex.ExecContext(ctx, grade, g.FinalGradeID.String(), g.FinalScore, g.LetterGrade, g.GradePoints, now); err != nil {
+		return err
+	}
+
+	enrollment := `
+		UPDATE enrollments e SET
+			final_grade = $2,
+			letter_grade = $3,
+			grade_points = $4,
+			credits_earned = CASE WHEN $5 THEN c.credits ELSE 0 END,
+			status = CASE WHEN $5 THEN 'completed' ELSE 'failed' END,
+			updated_at = $6
+		FROM course_sections cs
+		JOIN courses c ON c.id = cs.course_id
+		WHERE e.id = $1
+		  AND cs.id = e.course_section_id
+		  AND COALESCE(e.status, 'enrolled') NOT IN ('dropped', 'withdrawn')
+	`
+	_, err := ex.ExecContext(ctx, enrollment, g.EnrollmentID.String(), g.FinalScore, g.LetterGrade, g.GradePoints, g.IsPassing, now)
+	return err
+}
+
+// scanner is implemented by both *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanReview(row scanner) (*domain.Review, error) {
+	var (
+		review     domain.Review
+		reviewedBy valueobject.ID
+		reviewedAt sql.NullTime
+	)
+	if err := row.Scan(&review.ID, &review.SectionID, &review.Status, &review.SubmittedBy, &review.SubmittedAt, &reviewedBy, &reviewedAt, &review.Comments); err != nil {
+		return nil, err
+	}
+
+	if !reviewedBy.IsEmpty() {
+		review.ReviewedBy = &reviewedBy
+	}
+	if reviewedAt.Valid {
+		review.ReviewedAt = &reviewedAt.Time
+	}
+	return &review, nil
+}
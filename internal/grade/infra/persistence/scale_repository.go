@@ -0,0 +1,235 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresScaleRepository struct {
+	pool *sql.DB
+}
+
+func NewScaleRepository(db *sql.DB) *postgresScaleRepository {
+	return &postgresScaleRepository{db}
+}
+
+func (r postgresScaleRepository) Save(ctx context.Context, s *domain.Scale) error {
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if s.IsDefault {
+		if _, err := tx.ExecContext(ctx, `UPDATE grade_scales SET is_default = false, updated_at = $2 WHERE is_default AND id <> $1`, s.ID.String(), s.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		INSERT INTO grade_scales (id, name, is_default, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			is_default = EXCLUDED.is_default,
+			updated_at = EXCLUDED.updated_at
+	`
+	if _, err := tx.ExecContext(ctx, query, s.ID.String(), s.Name, s.IsDefault, s.CreatedAt, s.UpdatedAt); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsUniqueConstraintViolation(pgerr) {
+				return domain.ErrScaleNameTaken
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM grade_scale_bands WHERE grade_scale_id = $1`, s.ID.String()); err != nil {
+		return err
+	}
+
+	insert := `
+		INSERT INTO grade_scale_bands (grade_scale_id, letter, min_score, grade_points, is_passing)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	for _, b := range s.Bands {
+		if _, err := tx.ExecContext(ctx, insert, s.ID.String(), b.Letter, b.MinScore, b.GradePoints, b.IsPassing); err != nil {
+			if ok, pgerr := db.IsPgError(err); ok {
+				return db.ExchangePGError(pgerr)
+			}
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresScaleRepository) Delete(ctx context.Context, id valueobject.ID) error {
+	var inUse bool
+
+	query := `
+		SELECT EXISTS (SELECT 1 FROM degrees WHERE grade_scale_id = $1)
+			OR EXISTS (SELECT 1 FROM departments WHERE grade_scale_id = $1)
+	`
+	if err := r.pool.QueryRowContext(ctx, query, id.String()).Scan(&inUse); err != nil {
+		return err
+	}
+	if inUse {
+		return domain.ErrScaleInUse
+	}
+
+	result, err := r.pool.ExecContext(ctx, `DELETE FROM grade_scales WHERE id = $1`, id.String())
+	if err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsForeignKeyViolation(pgerr) {
+				return domain.ErrScaleInUse
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrScaleNotFound
+	}
+	return nil
+}
+
+func (r postgresScaleRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.Scale, error) {
+	scales, err := r.find(ctx, `WHERE s.id = $1`, id.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(scales) == 0 {
+		return nil, domain.ErrScaleNotFound
+	}
+	return scales[0], nil
+}
+
+func (r postgresScaleRepository) List(ctx context.Context) ([]*domain.Scale, error) {
+	return r.find(ctx, ``)
+}
+
+func (r postgresScaleRepository) AssignToDegree(ctx context.Context, degreeID valueobject.ID, scaleID *valueobject.ID) error {
+	return r.assign(ctx, `UPDATE degrees SET grade_scale_id = $2, updated_at = NOW() WHERE id = $1`, degreeID, scaleID, domain.ErrDegreeNotFound)
+}
+
+func (r postgresScaleRepository) AssignToDepartment(ctx context.Context, departmentID valueobject.ID, scaleID *valueobject.ID) error {
+	return r.assign(ctx, `UPDATE departments SET grade_scale_id = $2, updated_at = NOW() WHERE id = $1`, departmentID, scaleID, domain.ErrDepartmentNotFound)
+}
+
+func (r postgresScaleRepository) Applicable(ctx context.Context, sectionID valueobject.ID) (map[valueobject.ID]*domain.Scale, error) {
+	query := `
+		SELECT e.id, COALESCE(d.grade_scale_id, dep.grade_scale_id, (SELECT id FROM grade_scales WHERE is_default))
+		FROM enrollments e
+		JOIN course_sections cs ON cs.id = e.course_section_id
+		LEFT JOIN courses c ON c.id = cs.course_id
+		LEFT JOIN departments dep ON dep.id = c.department_id
+		LEFT JOIN students st ON st.id = e.student_id
+		LEFT JOIN degrees d ON d.id = st.degree_id
+		WHERE e.course_section_id = $1
+	`
+	rows, err := r.pool.QueryContext(ctx, query, sectionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[valueobject.ID]valueobject.ID)
+	for rows.Next() {
+		var enrollmentID, scaleID valueobject.ID
+		if err := rows.Scan(&enrollmentID, &scaleID); err != nil {
+			return nil, err
+		}
+		if !scaleID.IsEmpty() {
+			ids[enrollmentID] = scaleID
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	scales, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[valueobject.ID]*domain.Scale, len(scales))
+	for _, s := range scales {
+		byID[s.ID] = s
+	}
+
+	applicable := make(map[valueobject.ID]*domain.Scale, len(ids))
+	for enrollment, scale := range ids {
+		if s, ok := byID[scale]; ok {
+			applicable[enrollment] = s
+		}
+	}
+	return applicable, nil
+}
+
+func (r postgresScaleRepository) assign(ctx context.Context, query string, id valueobject.ID, scaleID *valueobject.ID, notFound error) error {
+	var scale any
+	if scaleID != nil {
+		scale = scaleID.String()
+	}
+
+	result, err := r.pool.ExecContext(ctx, query, id.String(), scale)
+	if err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsForeignKeyViolation(pgerr) {
+				return domain.ErrScaleNotFound
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return notFound
+	}
+	return nil
+}
+
+// find loads the scales matching the filter with their bands
+func (r postgresScaleRepository) find(ctx context.Context, filter string, args ...any) ([]*domain.Scale, error) {
+	query := `
+		SELECT s.id, s.name, s.is_default, s.created_at, s.updated_at,
+			b.letter, b.min_score, b.grade_points, b.is_passing
+		FROM grade_scales s
+		JOIN grade_scale_bands b ON b.grade_scale_id = s.id
+		` + filter + `
+		ORDER BY s.name, s.id, b.min_score DESC
+	`
+	rows, err := r.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scales []*domain.Scale
+	for rows.Next() {
+		var (
+			s    domain.Scale
+			b    domain.Band
+			last *domain.Scale
+		)
+		if err := rows.Scan(&s.ID, &s.Name, &s.IsDefault, &s.CreatedAt, &s.UpdatedAt,
+			&b.Letter, &b.MinScore, &b.GradePoints, &b.IsPassing); err != nil {
+			return nil, err
+		}
+		if len(scales) > 0 {
+			last = scales[len(scales)-1]
+		}
+		if last == nil || !last.ID.Equals(s.ID) {
+			last = &s
+			scales = append(scales, last)
+		}
+		last.Bands = append(last.Bands, b)
+	}
+
+	return scales, rows.Err()
+}
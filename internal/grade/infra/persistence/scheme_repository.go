@@ -0,0 +1,66 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type postgresSchemeRepository struct {
+	pool *sql.DB
+}
+
+func NewSchemeRepository(db *sql.DB) *postgresSchemeRepository {
+	return &postgresSchemeRepository{db}
+}
+
+func (r postgresSchemeRepository) Save(ctx context.Context, s *domain.Scheme) error {
+	categories, err := json.Marshal(s.Categories)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO grading_schemes (course_section_id, categories, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (course_section_id) DO UPDATE SET
+			categories = EXCLUDED.categories,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+	`
+	if _, err := r.pool.ExecContext(ctx, query, s.SectionID.String(), categories, s.UpdatedBy.String(), s.UpdatedAt); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			if db.IsForeignKeyViolation(pgerr) {
+				return domain.ErrSectionNotFound
+			}
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r postgresSchemeRepository) FindBySection(ctx context.Context, sectionID valueobject.ID) (*domain.Scheme, error) {
+	var (
+		scheme     = &domain.Scheme{SectionID: sectionID}
+		categories []byte
+	)
+
+	query := `SELECT categories, updated_by, updated_at FROM grading_schemes WHERE course_section_id = $1`
+	if err := r.pool.QueryRowContext(ctx, query, sectionID.String()).Scan(&categories, &scheme.UpdatedBy, &scheme.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSchemeNotFound
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(categories, &scheme.Categories); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
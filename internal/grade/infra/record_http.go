@@ -0,0 +1,26 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type academicRecordHandler struct {
+	interactor application.AcademicRecordInteractor
+}
+
+func NewAcademicRecordHandler(uc application.AcademicRecordInteractor) *academicRecordHandler {
+	return &academicRecordHandler{uc}
+}
+
+func (h academicRecordHandler) Get(c fiber.Ctx) error {
+	data, err := h.interactor.Get(c.Context(), httpx.Actor(c), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
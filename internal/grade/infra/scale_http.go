@@ -0,0 +1,117 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/grade/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type scaleHandler struct {
+	interactor application.ScaleInteractor
+}
+
+func NewScaleHandler(uc application.ScaleInteractor) *scaleHandler {
+	return &scaleHandler{uc}
+}
+
+func (h scaleHandler) Create(c fiber.Ctx) error {
+	var req application.ScaleInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Create(c.Context(), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h scaleHandler) Update(c fiber.Ctx) error {
+	var req application.ScaleInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Update(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h scaleHandler) Delete(c fiber.Ctx) error {
+	if err := h.interactor.Delete(c.Context(), c.Params("id")); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h scaleHandler) Get(c fiber.Ctx) error {
+	data, err := h.interactor.Get(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h scaleHandler) List(c fiber.Ctx) error {
+	data, err := h.interactor.List(c.Context())
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h scaleHandler) AssignToDegree(c fiber.Ctx) error {
+	var req application.AssignScaleInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.interactor.AssignToDegree(c.Context(), c.Params("id"), req.GradeScaleID); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h scaleHandler) UnassignFromDegree(c fiber.Ctx) error {
+	if err := h.interactor.AssignToDegree(c.Context(), c.Params("id"), ""); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h scaleHandler) AssignToDepartment(c fiber.Ctx) error {
+	var req application.AssignScaleInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.interactor.AssignToDepartment(c.Context(), c.Params("id"), req.GradeScaleID); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h scaleHandler) UnassignFromDepartment(c fiber.Ctx) error {
+	if err := h.interactor.AssignToDepartment(c.Context(), c.Params("id"), ""); err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
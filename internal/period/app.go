@@ -0,0 +1,59 @@
+package period
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/period/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/period/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/period/infra/persistence"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/scheduler"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Module struct {
+	name   string
+	engine *fiber.App
+	db     *sql.DB
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB) Module {
+	return Module{name: name, engine: engine, db: db}
+}
+
+// Interactor builds the academic period use cases for other modules
+func (mod Module) Interactor() application.PeriodInteractor {
+	return application.NewPeriodInteractor(persistence.NewPeriodRepository(mod.db))
+}
+
+// AdvanceJob opens and closes periods when their dates are reached
+func (mod Module) AdvanceJob() scheduler.Job {
+	interactor := mod.Interactor()
+
+	return func(ctx context.Context, now time.Time) error {
+		transitions, err := interactor.Advance(ctx, now)
+		for _, t := range transitions {
+			log.Printf("academic period %s moved from %s to %s", t.Name, t.From, t.To)
+		}
+		return err
+	}
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.engine.Group(mod.name, httpx.Authenticate())
+
+	h := infra.NewPeriodHandler(mod.Interactor())
+
+	admin := httpx.RequireRoles(shared.RoleAdmin)
+
+	group.Get("", h.ListPeriods)
+	group.Post("", admin, h.CreatePeriod)
+	group.Get("/current", h.GetCurrentPeriod)
+	group.Get("/:id", h.GetPeriod)
+	group.Put("/:id", admin, h.UpdatePeriod)
+	group.Post("/:id/status", admin, h.ChangeStatus)
+}
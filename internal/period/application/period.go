@@ -0,0 +1,299 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/period/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const dateLayout = time.DateOnly
+
+type (
+	CreatePeriodInput struct {
+		Year              int    `json:"year" validate:"required,min=1900,max=2200"`
+		Period            string `json:"period" validate:"required,oneof=fall spring summer winter"`
+		Name              string `json:"name" validate:"max=100"`
+		StartDate         string `json:"start_date" validate:"required,datetime=2006-01-02"`
+		EndDate           string `json:"end_date" validate:"required,datetime=2006-01-02"`
+		RegistrationStart string `json:"registration_start" validate:"required,datetime=2006-01-02"`
+		RegistrationEnd   string `json:"registration_end" validate:"required,datetime=2006-01-02"`
+		PeriodDeadlinesInput
+	}
+
+	UpdatePeriodInput struct {
+		Name              string `json:"name" validate:"required,max=100"`
+		StartDate         string `json:"start_date" validate:"required,datetime=2006-01-02"`
+		EndDate           string `json:"end_date" validate:"required,datetime=2006-01-02"`
+		RegistrationStart string `json:"registration_start" validate:"required,datetime=2006-01-02"`
+		RegistrationEnd   string `json:"registration_end" validate:"required,datetime=2006-01-02"`
+		PeriodDeadlinesInput
+	}
+
+	// PeriodDeadlinesInput holds the optional enrollment deadlines, empty
+	// values take their defaults
+	PeriodDeadlinesInput struct {
+		AddDeadline        string `json:"add_deadline" validate:"omitempty,datetime=2006-01-02"`
+		DropDeadline       string `json:"drop_deadline" validate:"omitempty,datetime=2006-01-02"`
+		WithdrawalDeadline string `json:"withdrawal_deadline" validate:"omitempty,datetime=2006-01-02"`
+	}
+
+	ChangePeriodStatusInput struct {
+		Status string `json:"status" validate:"required,oneof=registration_open in_progress closed"`
+	}
+
+	// PeriodTransition is a status change applied by Advance
+	PeriodTransition struct {
+		PeriodID valueobject.ID `json:"period_id"`
+		Name     string         `json:"name"`
+		From     domain.Status  `json:"from"`
+		To       domain.Status  `json:"to"`
+	}
+)
+
+type PeriodInteractor interface {
+	Create(ctx context.Context, in CreatePeriodInput) (*domain.AcademicPeriod, error)
+	Update(ctx context.Context, id string, in UpdatePeriodInput) (*domain.AcademicPeriod, error)
+	Get(ctx context.Context, id string) (*domain.AcademicPeriod, error)
+	Current(ctx context.Context) (*domain.AcademicPeriod, error)
+	List(ctx context.Context, onlyOpen bool) ([]*domain.AcademicPeriod, error)
+	ChangeStatus(ctx context.Context, id string, in ChangePeriodStatusInput) (*domain.AcademicPeriod, error)
+	// Advance moves every open period to the status its dates dictate for now
+	Advance(ctx context.Context, now time.Time) ([]PeriodTransition, error)
+}
+
+type periodInteractor struct {
+	repository domain.PeriodRepository
+}
+
+func NewPeriodInteractor(r domain.PeriodRepository) *periodInteractor {
+	return &periodInteractor{r}
+}
+
+func (interactor periodInteractor) Create(ctx context.Context, in CreatePeriodInput) (*domain.AcademicPeriod, error) {
+	dates, err := parseDates(in.StartDate, in.EndDate, in.RegistrationStart, in.RegistrationEnd, in.PeriodDeadlinesInput)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := domain.NewAcademicPeriod(in.Year, domain.Term(in.Period), in.Name, dates)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Create(ctx, period); err != nil {
+		return nil, mapError(err)
+	}
+
+	return period, nil
+}
+
+func (interactor periodInteractor) Update(ctx context.Context, id string, in UpdatePeriodInput) (*domain.AcademicPeriod, error) {
+	period, err := interactor.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := parseDates(in.StartDate, in.EndDate, in.RegistrationStart, in.RegistrationEnd, in.PeriodDeadlinesInput)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := period.Reschedule(in.Name, dates); err != nil {
+		if errors.Is(err, domain.ErrPeriodNotEditable) {
+			return nil, shared.ErrConflictWith(err, err.Error())
+		}
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Update(ctx, period); err != nil {
+		return nil, mapError(err)
+	}
+
+	return period, nil
+}
+
+func (interactor periodInteractor) Get(ctx context.Context, id string) (*domain.AcademicPeriod, error) {
+	periodID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid period id")
+	}
+
+	period, err := interactor.repository.FindByID(ctx, periodID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return period, nil
+}
+
+func (interactor periodInteractor) Current(ctx context.Context) (*domain.AcademicPeriod, error) {
+	period, err := interactor.repository.FindActive(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return period, nil
+}
+
+func (interactor periodInteractor) List(ctx context.Context, onlyOpen bool) ([]*domain.AcademicPeriod, error) {
+	periods, err := interactor.repository.List(ctx, onlyOpen)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if periods == nil {
+		periods = []*domain.AcademicPeriod{}
+	}
+
+	return periods, nil
+}
+
+// ChangeStatus moves the period forward by hand, e.g. to open registration
+// earlier than scheduled
+func (interactor periodInteractor) ChangeStatus(ctx context.Context, id string, in ChangePeriodStatusInput) (*domain.AcademicPeriod, error) {
+	period, err := interactor.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := period.TransitionTo(domain.Status(in.Status)); err != nil {
+		return nil, shared.ErrConflictWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Update(ctx, period); err != nil {
+		return nil, mapError(err)
+	}
+
+	return period, nil
+}
+
+func (interactor periodInteractor) Advance(ctx context.Context, now time.Time) ([]PeriodTransition, error) {
+	// periods come ordered by start date so the previous one closes before
+	// the next one tries to become active
+	periods, err := interactor.repository.List(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		applied []PeriodTransition
+		errs    []error
+	)
+	for _, period := range periods {
+		from := period.Status
+		target := period.ScheduledStatus(now)
+
+		for period.Status != target {
+			// the next term opens its registration once the current one
+			// closes, the store only allows one active period
+			if period.Status == domain.StatusPlanned && anotherActive(periods, period) {
+				break
+			}
+			if err := period.TransitionTo(nextStatus(period.Status)); err != nil {
+				break
+			}
+		}
+
+		if period.Status == from {
+			continue
+		}
+
+		if err := interactor.repository.Update(ctx, period); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		applied = append(applied, PeriodTransition{
+			PeriodID: period.ID,
+			Name:     period.Name,
+			From:     from,
+			To:       period.Status,
+		})
+	}
+
+	return applied, errors.Join(errs...)
+}
+
+func anotherActive(periods []*domain.AcademicPeriod, period *domain.AcademicPeriod) bool {
+	for _, p := range periods {
+		if p != period && p.IsActive() {
+			return true
+		}
+	}
+	return false
+}
+
+func nextStatus(s domain.Status) domain.Status {
+	for _, next := range []domain.Status{domain.StatusRegistrationOpen, domain.StatusInProgress, domain.StatusClosed} {
+		if s.CanTransitionTo(next) {
+			return next
+		}
+	}
+	return s
+}
+
+func parseDates(start, end, registrationStart, registrationEnd string, deadlines PeriodDeadlinesInput) (domain.Dates, error) {
+	var (
+		dates domain.Dates
+		err   error
+	)
+
+	fields := []struct {
+		value string
+		dest  *time.Time
+		name  string
+	}{
+		{start, &dates.StartDate, "start date"},
+		{end, &dates.EndDate, "end date"},
+		{registrationStart, &dates.RegistrationStart, "registration start"},
+		{registrationEnd, &dates.RegistrationEnd, "registration end"},
+	}
+
+	for _, field := range fields {
+		if *field.dest, err = time.Parse(dateLayout, field.value); err != nil {
+			return domain.Dates{}, shared.ErrInvalidInputWith(err, "invalid "+field.name)
+		}
+	}
+
+	optional := []struct {
+		value string
+		dest  *time.Time
+		name  string
+	}{
+		{deadlines.AddDeadline, &dates.AddDeadline, "add deadline"},
+		{deadlines.DropDeadline, &dates.DropDeadline, "drop deadline"},
+		{deadlines.WithdrawalDeadline, &dates.WithdrawalDeadline, "withdrawal deadline"},
+	}
+
+	for _, field := range optional {
+		if field.value == "" {
+			continue
+		}
+		if *field.dest, err = time.Parse(dateLayout, field.value); err != nil {
+			return domain.Dates{}, shared.ErrInvalidInputWith(err, "invalid "+field.name)
+		}
+	}
+
+	return dates, nil
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var appErr *shared.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, domain.ErrPeriodNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrAnotherPeriodActive):
+		return shared.ErrConflictWith(err, err.Error())
+	case errors.Is(err, shared.ErrConflict):
+		return shared.ErrConflictWith(err, "a period for that year and term already exists")
+	default:
+		return shared.ErrInternalWith(err, "cannot process academic period")
+	}
+}
@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrPeriodNotFound         = errors.New("academic period does not exist")
+	ErrInvalidTerm            = errors.New("term must be one of fall, spring, summer or winter")
+	ErrInvalidYear            = errors.New("year is out of range")
+	ErrEmptyPeriodName        = errors.New("period name cannot be empty")
+	ErrEndBeforeStart         = errors.New("end date must be after the start date")
+	ErrRegistrationEndsBefore = errors.New("registration end must not be before registration start")
+	ErrRegistrationAfterStart = errors.New("registration must start before the period starts")
+	ErrRegistrationAfterEnd   = errors.New("registration must end before the period ends")
+	ErrDeadlinesOutOfOrder    = errors.New("deadlines must follow registration end <= add <= drop <= withdrawal <= period end")
+	ErrInvalidPeriodStatus    = errors.New("invalid period status")
+	ErrInvalidTransition      = errors.New("invalid period status transition")
+	ErrPeriodNotEditable      = errors.New("only planned periods can change their dates")
+	ErrAnotherPeriodActive    = errors.New("another academic period is already active")
+)
+
+type PeriodRepository interface {
+	Create(ctx context.Context, p *AcademicPeriod) (err error)
+	// Update stores the period, implementations must fail with
+	// ErrAnotherPeriodActive when it would leave two active periods
+	Update(ctx context.Context, p *AcademicPeriod) (err error)
+	FindByID(ctx context.Context, id valueobject.ID) (*AcademicPeriod, error)
+	FindActive(ctx context.Context) (*AcademicPeriod, error)
+	// List returns the periods ordered by start date, open periods only
+	// when onlyOpen is set
+	List(ctx context.Context, onlyOpen bool) ([]*AcademicPeriod, error)
+}
+
+// Term is the part of the academic year a period covers
+type Term string
+
+const (
+	TermFall   Term = "fall"
+	TermSpring Term = "spring"
+	TermSummer Term = "summer"
+	TermWinter Term = "winter"
+)
+
+// IsValid checks if the term is valid
+func (t Term) IsValid() bool {
+	switch t {
+	case TermFall, TermSpring, TermSummer, TermWinter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Status is the lifecycle stage of an academic period
+type Status string
+
+const (
+	StatusPlanned          Status = "planned"
+	StatusRegistrationOpen Status = "registration_open"
+	StatusInProgress       Status = "in_progress"
+	StatusClosed           Status = "closed"
+)
+
+// transitions lists the statuses reachable from each status, the lifecycle
+// only moves forward
+var transitions = map[Status]Status{
+	StatusPlanned:          StatusRegistrationOpen,
+	StatusRegistrationOpen: StatusInProgress,
+	StatusInProgress:       StatusClosed,
+}
+
+// IsValid checks if the status is valid
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPlanned, StatusRegistrationOpen, StatusInProgress, StatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of Status
+func (s Status) String() string {
+	return string(s)
+}
+
+// CanTransitionTo checks if the lifecycle allows moving to next
+func (s Status) CanTransitionTo(next Status) bool {
+	return transitions[s] == next && next != ""
+}
+
+// IsActive checks if a period in this status is the current one
+func (s Status) IsActive() bool {
+	return s == StatusRegistrationOpen || s == StatusInProgress
+}
+
+// AcademicPeriod is a term of an academic year, e.g. "Fall 2025"
+type AcademicPeriod struct {
+	ID                valueobject.ID `json:"id"`
+	Year              int            `json:"year"`
+	Term              Term           `json:"period"`
+	Name              string         `json:"name"`
+	StartDate         time.Time      `json:"start_date"`
+	EndDate           time.Time      `json:"end_date"`
+	RegistrationStart time.Time      `json:"registration_start"`
+	RegistrationEnd   time.Time      `json:"registration_end"`
+	// AddDeadline is the last day students can enroll, late adds run
+	// past the registration end into the first weeks of classes
+	AddDeadline time.Time `json:"add_deadline"`
+	// DropDeadline is the last day a drop leaves no trace on the transcript
+	DropDeadline time.Time `json:"drop_deadline"`
+	// WithdrawalDeadline is the last day students can withdraw with a W
+	WithdrawalDeadline time.Time `json:"withdrawal_deadline"`
+	Status             Status    `json:"status"`
+}
+
+// Dates groups the calendar of a period. Zero deadlines take their default:
+// adds end with registration, drops with adds and withdrawals with the period.
+type Dates struct {
+	StartDate          time.Time
+	EndDate            time.Time
+	RegistrationStart  time.Time
+	RegistrationEnd    time.Time
+	AddDeadline        time.Time
+	DropDeadline       time.Time
+	WithdrawalDeadline time.Time
+}
+
+// NewAcademicPeriod creates a planned AcademicPeriod with validation
+func NewAcademicPeriod(year int, term Term, name string, dates Dates) (*AcademicPeriod, error) {
+	if year < 1900 || year > 2200 {
+		return nil, ErrInvalidYear
+	}
+
+	if !term.IsValid() {
+		return nil, ErrInvalidTerm
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = fmt.Sprintf("%s %d", strings.ToUpper(string(term[:1]))+string(term[1:]), year)
+	}
+
+	p := &AcademicPeriod{
+		ID:     valueobject.NewID(),
+		Year:   year,
+		Term:   term,
+		Name:   name,
+		Status: StatusPlanned,
+	}
+
+	if err := p.setDates(dates); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// AcademicPeriodFromPersistence creates an AcademicPeriod instance from database records
+func AcademicPeriodFromPersistence(
+	id valueobject.ID,
+	year int,
+	term Term,
+	name string,
+	dates Dates,
+	status Status,
+) *AcademicPeriod {
+	return &AcademicPeriod{
+		ID:                 id,
+		Year:               year,
+		Term:               term,
+		Name:               name,
+		StartDate:          dates.StartDate,
+		EndDate:            dates.EndDate,
+		RegistrationStart:  dates.RegistrationStart,
+		RegistrationEnd:    dates.RegistrationEnd,
+		AddDeadline:        dates.AddDeadline,
+		DropDeadline:       dates.DropDeadline,
+		WithdrawalDeadline: dates.WithdrawalDeadline,
+		Status:             status,
+	}
+}
+
+// IsActive checks if the period is the current one
+func (p *AcademicPeriod) IsActive() bool {
+	return p.Status.IsActive()
+}
+
+// Reschedule replaces the calendar of a period that hasn't opened yet
+func (p *AcademicPeriod) Reschedule(name string, dates Dates) error {
+	if p.Status != StatusPlanned {
+		return ErrPeriodNotEditable
+	}
+
+	if name = strings.TrimSpace(name); name == "" {
+		return ErrEmptyPeriodName
+	}
+
+	if err := p.setDates(dates); err != nil {
+		return err
+	}
+
+	p.Name = name
+	return nil
+}
+
+// TransitionTo moves the period to the next lifecycle status
+func (p *AcademicPeriod) TransitionTo(next Status) error {
+	if !next.IsValid() {
+		return ErrInvalidPeriodStatus
+	}
+
+	if !p.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, p.Status, next)
+	}
+
+	p.Status = next
+	return nil
+}
+
+// ScheduledStatus returns the status the calendar dictates for the given
+// day. Closing happens the day after EndDate.
+func (p *AcademicPeriod) ScheduledStatus(today time.Time) Status {
+	today = truncateToDate(today)
+	switch {
+	case today.After(p.EndDate):
+		return StatusClosed
+	case !today.Before(p.StartDate):
+		return StatusInProgress
+	case !today.Before(p.RegistrationStart):
+		return StatusRegistrationOpen
+	default:
+		return StatusPlanned
+	}
+}
+
+func (p *AcademicPeriod) setDates(d Dates) error {
+	d = Dates{
+		StartDate:          truncateToDate(d.StartDate),
+		EndDate:            truncateToDate(d.EndDate),
+		RegistrationStart:  truncateToDate(d.RegistrationStart),
+		RegistrationEnd:    truncateToDate(d.RegistrationEnd),
+		AddDeadline:        truncateToDate(d.AddDeadline),
+		DropDeadline:       truncateToDate(d.DropDeadline),
+		WithdrawalDeadline: truncateToDate(d.WithdrawalDeadline),
+	}
+
+	if !d.EndDate.After(d.StartDate) {
+		return ErrEndBeforeStart
+	}
+
+	if d.RegistrationEnd.Before(d.RegistrationStart) {
+		return ErrRegistrationEndsBefore
+	}
+
+	// late registration may run into the first weeks, but it must be open
+	// before classes start and end before the period does
+	if d.RegistrationStart.After(d.StartDate) {
+		return ErrRegistrationAfterStart
+	}
+
+	if d.RegistrationEnd.After(d.EndDate) {
+		return ErrRegistrationAfterEnd
+	}
+
+	if d.AddDeadline.IsZero() {
+		d.AddDeadline = d.RegistrationEnd
+	}
+	if d.DropDeadline.IsZero() {
+		d.DropDeadline = d.AddDeadline
+	}
+	if d.WithdrawalDeadline.IsZero() {
+		d.WithdrawalDeadline = d.EndDate
+	}
+
+	if d.AddDeadline.Before(d.RegistrationEnd) ||
+		d.DropDeadline.Before(d.AddDeadline) ||
+		d.WithdrawalDeadline.Before(d.DropDeadline) ||
+		d.WithdrawalDeadline.After(d.EndDate) {
+		return ErrDeadlinesOutOfOrder
+	}
+
+	p.StartDate = d.StartDate
+	p.EndDate = d.EndDate
+	p.RegistrationStart = d.RegistrationStart
+	p.RegistrationEnd = d.RegistrationEnd
+	p.AddDeadline = d.AddDeadline
+	p.DropDeadline = d.DropDeadline
+	p.WithdrawalDeadline = d.WithdrawalDeadline
+	return nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,225 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/period/domain"
+)
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+// fall is registration in August, classes from September to December
+func fall() domain.Dates {
+	return domain.Dates{
+		StartDate:         day(time.September, 1),
+		EndDate:           day(time.December, 19),
+		RegistrationStart: day(time.August, 1),
+		RegistrationEnd:   day(time.August, 29),
+	}
+}
+
+func TestStatusCanTransitionTo(t *testing.T) {
+	statuses := []domain.Status{domain.StatusPlanned, domain.StatusRegistrationOpen, domain.StatusInProgress, domain.StatusClosed}
+
+	allowed := map[domain.Status]domain.Status{
+		domain.StatusPlanned:          domain.StatusRegistrationOpen,
+		domain.StatusRegistrationOpen: domain.StatusInProgress,
+		domain.StatusInProgress:       domain.StatusClosed,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[from] == to
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("%s -> %s: got %v, want %v", from, to, got, want)
+			}
+		}
+	}
+
+	for _, s := range statuses {
+		want := s == domain.StatusRegistrationOpen || s == domain.StatusInProgress
+		if got := s.IsActive(); got != want {
+			t.Errorf("%s: got active %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestAcademicPeriodTransitionTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    domain.Status
+		to      domain.Status
+		wantErr error
+	}{
+		{name: "opens registration", from: domain.StatusPlanned, to: domain.StatusRegistrationOpen},
+		{name: "starts classes", from: domain.StatusRegistrationOpen, to: domain.StatusInProgress},
+		{name: "closes", from: domain.StatusInProgress, to: domain.StatusClosed},
+		{name: "refuses skipping registration", from: domain.StatusPlanned, to: domain.StatusInProgress, wantErr: domain.ErrInvalidTransition},
+		{name: "refuses going back", from: domain.StatusInProgress, to: domain.StatusRegistrationOpen, wantErr: domain.ErrInvalidTransition},
+		{name: "refuses reopening", from: domain.StatusClosed, to: domain.StatusPlanned, wantErr: domain.ErrInvalidTransition},
+		{name: "refuses staying in the same status", from: domain.StatusPlanned, to: domain.StatusPlanned, wantErr: domain.ErrInvalidTransition},
+		{name: "refuses unknown statuses", from: domain.StatusPlanned, to: domain.Status("archived"), wantErr: domain.ErrInvalidPeriodStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := domain.NewAcademicPeriod(2025, domain.TermFall, "", fall())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			p.Status = tt.from
+
+			err = p.TransitionTo(tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			want := tt.to
+			if tt.wantErr != nil {
+				want = tt.from
+			}
+			if p.Status != want {
+				t.Errorf("got status %s, want %s", p.Status, want)
+			}
+		})
+	}
+}
+
+func TestAcademicPeriodScheduledStatus(t *testing.T) {
+	p, err := domain.NewAcademicPeriod(2025, domain.TermFall, "", fall())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		today time.Time
+		want  domain.Status
+	}{
+		{today: day(time.July, 31), want: domain.StatusPlanned},
+		{today: day(time.August, 1), want: domain.StatusRegistrationOpen},
+		{today: day(time.August, 31).Add(23 * time.Hour), want: domain.StatusRegistrationOpen},
+		{today: day(time.September, 1), want: domain.StatusInProgress},
+		{today: day(time.December, 19).Add(23 * time.Hour), want: domain.StatusInProgress},
+		{today: day(time.December, 20), want: domain.StatusClosed},
+	}
+
+	for _, tt := range tests {
+		if got := p.ScheduledStatus(tt.today); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.today, got, tt.want)
+		}
+	}
+}
+
+func TestNewAcademicPeriodDates(t *testing.T) {
+	with := func(change func(d *domain.Dates)) domain.Dates {
+		d := fall()
+		change(&d)
+		return d
+	}
+
+	tests := []struct {
+		name    string
+		dates   domain.Dates
+		wantErr error
+	}{
+		{name: "default deadlines", dates: fall()},
+		{name: "late adds into the first weeks", dates: with(func(d *domain.Dates) {
+			d.AddDeadline, d.DropDeadline, d.WithdrawalDeadline = day(time.September, 12), day(time.September, 26), day(time.November, 14)
+		})},
+		{name: "registration on the first day of classes", dates: with(func(d *domain.Dates) { d.RegistrationStart, d.RegistrationEnd = d.StartDate, d.StartDate })},
+		{name: "ends on the start date", dates: with(func(d *domain.Dates) { d.EndDate = d.StartDate }), wantErr: domain.ErrEndBeforeStart},
+		{name: "registration ends before it starts", dates: with(func(d *domain.Dates) { d.RegistrationEnd = day(time.July, 31) }), wantErr: domain.ErrRegistrationEndsBefore},
+		{name: "registration starts after classes", dates: with(func(d *domain.Dates) {
+			d.RegistrationStart, d.RegistrationEnd = day(time.September, 2), day(time.September, 5)
+		}), wantErr: domain.ErrRegistrationAfterStart},
+		{name: "registration ends after the period", dates: with(func(d *domain.Dates) { d.RegistrationEnd = day(time.December, 20) }), wantErr: domain.ErrRegistrationAfterEnd},
+		{name: "adds end before registration", dates: with(func(d *domain.Dates) { d.AddDeadline = day(time.August, 28) }), wantErr: domain.ErrDeadlinesOutOfOrder},
+		{name: "drops end before adds", dates: with(func(d *domain.Dates) {
+			d.AddDeadline, d.DropDeadline = day(time.September, 12), day(time.September, 11)
+		}), wantErr: domain.ErrDeadlinesOutOfOrder},
+		{name: "withdrawals end before drops", dates: with(func(d *domain.Dates) {
+			d.DropDeadline, d.WithdrawalDeadline = day(time.October, 1), day(time.September, 30)
+		}), wantErr: domain.ErrDeadlinesOutOfOrder},
+		{name: "withdrawals end after the period", dates: with(func(d *domain.Dates) { d.WithdrawalDeadline = day(time.December, 20) }), wantErr: domain.ErrDeadlinesOutOfOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := domain.NewAcademicPeriod(2025, domain.TermFall, "", tt.dates)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if p.Status != domain.StatusPlanned || p.Name != "Fall 2025" {
+				t.Errorf("got a %s period named %q, want a planned Fall 2025", p.Status, p.Name)
+			}
+
+			want := tt.dates
+			if want.AddDeadline.IsZero() {
+				want.AddDeadline = want.RegistrationEnd
+			}
+			if want.DropDeadline.IsZero() {
+				want.DropDeadline = want.AddDeadline
+			}
+			if want.WithdrawalDeadline.IsZero() {
+				want.WithdrawalDeadline = want.EndDate
+			}
+			got := domain.Dates{
+				StartDate:          p.StartDate,
+				EndDate:            p.EndDate,
+				RegistrationStart:  p.RegistrationStart,
+				RegistrationEnd:    p.RegistrationEnd,
+				AddDeadline:        p.AddDeadline,
+				DropDeadline:       p.DropDeadline,
+				WithdrawalDeadline: p.WithdrawalDeadline,
+			}
+			if got != want {
+				t.Errorf("got dates %+v, want %+v", got, want)
+			}
+		})
+	}
+
+	t.Run("truncates to the day", func(t *testing.T) {
+		dates := fall()
+		dates.StartDate = dates.StartDate.Add(15 * time.Hour)
+		p, err := domain.NewAcademicPeriod(2025, domain.TermFall, "", dates)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !p.StartDate.Equal(day(time.September, 1)) {
+			t.Errorf("got start date %s, want it truncated", p.StartDate)
+		}
+	})
+}
+
+func TestAcademicPeriodReschedule(t *testing.T) {
+	p, err := domain.NewAcademicPeriod(2025, domain.TermFall, "", fall())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	late := fall()
+	late.EndDate = day(time.August, 15)
+	if err := p.Reschedule("Fall 2025", late); !errors.Is(err, domain.ErrEndBeforeStart) {
+		t.Fatalf("got error %v, want %v", err, domain.ErrEndBeforeStart)
+	}
+	if !p.EndDate.Equal(day(time.December, 19)) {
+		t.Errorf("got end date %s, the dates changed on error", p.EndDate)
+	}
+
+	if err := p.Reschedule(" ", fall()); !errors.Is(err, domain.ErrEmptyPeriodName) {
+		t.Errorf("got error %v, want %v", err, domain.ErrEmptyPeriodName)
+	}
+
+	p.Status = domain.StatusRegistrationOpen
+	if err := p.Reschedule("Fall 2025", fall()); !errors.Is(err, domain.ErrPeriodNotEditable) {
+		t.Errorf("got error %v, want %v", err, domain.ErrPeriodNotEditable)
+	}
+}
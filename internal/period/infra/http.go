@@ -0,0 +1,89 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/period/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type periodHandler struct {
+	interactor application.PeriodInteractor
+}
+
+func NewPeriodHandler(uc application.PeriodInteractor) *periodHandler {
+	return &periodHandler{uc}
+}
+
+func (h periodHandler) CreatePeriod(c fiber.Ctx) error {
+	var req application.CreatePeriodInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Create(c.Context(), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h periodHandler) UpdatePeriod(c fiber.Ctx) error {
+	var req application.UpdatePeriodInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Update(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h periodHandler) GetPeriod(c fiber.Ctx) error {
+	data, err := h.interactor.Get(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h periodHandler) GetCurrentPeriod(c fiber.Ctx) error {
+	data, err := h.interactor.Current(c.Context())
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h periodHandler) ListPeriods(c fiber.Ctx) error {
+	data, err := h.interactor.List(c.Context(), c.Query("open") == "true")
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h periodHandler) ChangeStatus(c fiber.Ctx) error {
+	var req application.ChangePeriodStatusInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.ChangeStatus(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
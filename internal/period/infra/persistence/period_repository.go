@@ -0,0 +1,229 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/period/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const (
+	periodColumns = `
+	id,
+	year,
+	period,
+	name,
+	start_date,
+	end_date,
+	registration_start,
+	registration_end,
+	COALESCE(add_deadline, registration_end),
+	COALESCE(drop_deadline, add_deadline, registration_end),
+	COALESCE(withdrawal_deadline, end_date),
+	status
+`
+	singleActiveConstraint = "uq_academic_periods_single_active"
+)
+
+type postgresPeriodRepository struct {
+	pool *sql.DB
+}
+
+func NewPeriodRepository(db *sql.DB) *postgresPeriodRepository {
+	return &postgresPeriodRepository{db}
+}
+
+func (r postgresPeriodRepository) Create(ctx context.Context, p *domain.AcademicPeriod) error {
+	query := `
+		INSERT INTO academic_periods (
+			id,
+			year,
+			period,
+			name,
+			start_date,
+			end_date,
+			registration_start,
+			registration_end,
+			add_deadline,
+			drop_deadline,
+			withdrawal_deadline,
+			status,
+			is_active
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		p.ID.String(),
+		p.Year,
+		string(p.Term),
+		p.Name,
+		p.StartDate,
+		p.EndDate,
+		p.RegistrationStart,
+		p.RegistrationEnd,
+		p.AddDeadline,
+		p.DropDeadline,
+		p.WithdrawalDeadline,
+		string(p.Status),
+		p.IsActive(),
+	); err != nil {
+		return exchangeError(err)
+	}
+	return nil
+}
+
+func (r postgresPeriodRepository) Update(ctx context.Context, p *domain.AcademicPeriod) error {
+	query := `
+		UPDATE academic_periods SET
+			name = $2,
+			start_date = $3,
+			end_date = $4,
+			registration_start = $5,
+			registration_end = $6,
+			add_deadline = $7,
+			drop_deadline = $8,
+			withdrawal_deadline = $9,
+			status = $10,
+			is_active = $11,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+	result, err := r.pool.ExecContext(ctx, query,
+		p.ID.String(),
+		p.Name,
+		p.StartDate,
+		p.EndDate,
+		p.RegistrationStart,
+		p.RegistrationEnd,
+		p.AddDeadline,
+		p.DropDeadline,
+		p.WithdrawalDeadline,
+		string(p.Status),
+		p.IsActive(),
+	)
+	if err != nil {
+		return exchangeError(err)
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrPeriodNotFound
+	}
+	return nil
+}
+
+func (r postgresPeriodRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.AcademicPeriod, error) {
+	query := `SELECT ` + periodColumns + ` FROM academic_periods WHERE id = $1`
+
+	period, err := scanPeriod(r.pool.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrPeriodNotFound
+	}
+	return period, err
+}
+
+func (r postgresPeriodRepository) FindActive(ctx context.Context) (*domain.AcademicPeriod, error) {
+	query := `SELECT ` + periodColumns + ` FROM academic_periods WHERE is_active`
+
+	period, err := scanPeriod(r.pool.QueryRowContext(ctx, query))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrPeriodNotFound
+	}
+	return period, err
+}
+
+func (r postgresPeriodRepository) List(ctx context.Context, onlyOpen bool) ([]*domain.AcademicPeriod, error) {
+	query := `SELECT ` + periodColumns + ` FROM academic_periods`
+	if onlyOpen {
+		query += ` WHERE status <> 'closed'`
+	}
+	query += ` ORDER BY start_date`
+
+	rows, err := r.pool.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []*domain.AcademicPeriod
+	for rows.Next() {
+		period, err := scanPeriod(rows)
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+
+	return periods, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPeriod(row scanner) (*domain.AcademicPeriod, error) {
+	var (
+		id, term, name, status string
+		year                   int
+		dates                  domain.Dates
+	)
+
+	if err := row.Scan(
+		&id,
+		&year,
+		&term,
+		&name,
+		&dates.StartDate,
+		&dates.EndDate,
+		&dates.RegistrationStart,
+		&dates.RegistrationEnd,
+		&dates.AddDeadline,
+		&dates.DropDeadline,
+		&dates.WithdrawalDeadline,
+		&status,
+	); err != nil {
+		return nil, err
+	}
+
+	periodID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.AcademicPeriodFromPersistence(
+		periodID,
+		year,
+		domain.Term(term),
+		name,
+		utcDates(dates),
+		domain.Status(status),
+	), nil
+}
+
+// utcDates drops the session time zone lib/pq attaches to DATE columns
+func utcDates(d domain.Dates) domain.Dates {
+	toUTC := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return domain.Dates{
+		StartDate:          toUTC(d.StartDate),
+		EndDate:            toUTC(d.EndDate),
+		RegistrationStart:  toUTC(d.RegistrationStart),
+		RegistrationEnd:    toUTC(d.RegistrationEnd),
+		AddDeadline:        toUTC(d.AddDeadline),
+		DropDeadline:       toUTC(d.DropDeadline),
+		WithdrawalDeadline: toUTC(d.WithdrawalDeadline),
+	}
+}
+
+func exchangeError(err error) error {
+	if ok, pgerr := db.IsPgError(err); ok {
+		if pgerr.Constraint == singleActiveConstraint {
+			return domain.ErrAnotherPeriodActive
+		}
+		return db.ExchangePGError(pgerr)
+	}
+	return err
+}
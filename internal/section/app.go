@@ -0,0 +1,35 @@
+package section
+
+import (
+	"database/sql"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/section/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/section/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/section/infra/persistence"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Module struct {
+	name   string
+	engine *fiber.App
+	db     *sql.DB
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB) Module {
+	return Module{name: name, engine: engine, db: db}
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.engine.Group(mod.name, httpx.Authenticate())
+
+	h := infra.NewSectionHandler(application.NewSectionInteractor(persistence.NewSectionRepository(mod.db)))
+
+	admin := httpx.RequireRoles(shared.RoleAdmin)
+
+	group.Get("", h.ListSections)
+	group.Post("", admin, h.CreateSection)
+	group.Get("/:id", h.GetSection)
+	group.Put("/:id", admin, h.UpdateSection)
+}
@@ -0,0 +1,215 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/section/domain"
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+type (
+	ScheduleInput struct {
+		Days      []string `json:"days" validate:"required,min=1,max=7"`
+		StartTime string   `json:"start_time" validate:"required,datetime=15:04"`
+		EndTime   string   `json:"end_time" validate:"required,datetime=15:04"`
+		Room      string   `json:"room" validate:"max=50"`
+	}
+
+	CreateSectionInput struct {
+		CourseID    string         `json:"course_id" validate:"required,uuid"`
+		PeriodID    string         `json:"academic_period_id" validate:"required,uuid"`
+		SectionCode string         `json:"section_code" validate:"required,max=10"`
+		ProfessorID string         `json:"professor_id" validate:"omitempty,uuid"`
+		Schedule    *ScheduleInput `json:"schedule"`
+		Capacity    int            `json:"capacity" validate:"required,min=1"`
+		Modality    string         `json:"modality" validate:"omitempty,oneof=in_person virtual hybrid"`
+	}
+
+	UpdateSectionInput struct {
+		SectionCode string         `json:"section_code" validate:"required,max=10"`
+		ProfessorID string         `json:"professor_id" validate:"omitempty,uuid"`
+		Schedule    *ScheduleInput `json:"schedule"`
+		Capacity    int            `json:"capacity" validate:"required,min=1"`
+		Modality    string         `json:"modality" validate:"omitempty,oneof=in_person virtual hybrid"`
+		IsActive    *bool          `json:"is_active"`
+	}
+
+	ListSectionsInput struct {
+		PeriodID    string `query:"period_id" validate:"omitempty,uuid"`
+		CourseID    string `query:"course_id" validate:"omitempty,uuid"`
+		ProfessorID string `query:"professor_id" validate:"omitempty,uuid"`
+	}
+)
+
+type SectionInteractor interface {
+	Create(ctx context.Context, in CreateSectionInput) (*domain.Section, error)
+	Update(ctx context.Context, id string, in UpdateSectionInput) (*domain.Section, error)
+	Get(ctx context.Context, id string) (*domain.Section, error)
+	List(ctx context.Context, in ListSectionsInput) ([]*domain.Section, error)
+}
+
+type sectionInteractor struct {
+	repository domain.SectionRepository
+}
+
+func NewSectionInteractor(r domain.SectionRepository) *sectionInteractor {
+	return &sectionInteractor{r}
+}
+
+func (interactor sectionInteractor) Create(ctx context.Context, in CreateSectionInput) (*domain.Section, error) {
+	courseID, err := valueobject.IDFromString(in.CourseID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid course id")
+	}
+
+	periodID, err := valueobject.IDFromString(in.PeriodID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid period id")
+	}
+
+	details, err := sectionDetails(in.SectionCode, in.ProfessorID, in.Schedule, in.Capacity, in.Modality)
+	if err != nil {
+		return nil, err
+	}
+
+	section, err := domain.NewSection(courseID, periodID, details)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Create(ctx, section); err != nil {
+		return nil, mapError(err)
+	}
+
+	return section, nil
+}
+
+func (interactor sectionInteractor) Update(ctx context.Context, id string, in UpdateSectionInput) (*domain.Section, error) {
+	section, err := interactor.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := sectionDetails(in.SectionCode, in.ProfessorID, in.Schedule, in.Capacity, in.Modality)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := section.UpdateDetails(details); err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if in.IsActive != nil {
+		if *in.IsActive {
+			section.Activate()
+		} else {
+			section.Deactivate()
+		}
+	}
+
+	if err := interactor.repository.Update(ctx, section); err != nil {
+		return nil, mapError(err)
+	}
+
+	return section, nil
+}
+
+func (interactor sectionInteractor) Get(ctx context.Context, id string) (*domain.Section, error) {
+	sectionID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid section id")
+	}
+
+	section, err := interactor.repository.FindByID(ctx, sectionID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return section, nil
+}
+
+func (interactor sectionInteractor) List(ctx context.Context, in ListSectionsInput) ([]*domain.Section, error) {
+	var (
+		filter domain.SectionFilter
+		err    error
+	)
+
+	if in.PeriodID != "" {
+		if filter.PeriodID, err = valueobject.IDFromString(in.PeriodID); err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid period id")
+		}
+	}
+
+	if in.CourseID != "" {
+		if filter.CourseID, err = valueobject.IDFromString(in.CourseID); err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid course id")
+		}
+	}
+
+	if in.ProfessorID != "" {
+		if filter.ProfessorID, err = valueobject.IDFromString(in.ProfessorID); err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid professor id")
+		}
+	}
+
+	sections, err := interactor.repository.List(ctx, filter)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if sections == nil {
+		sections = []*domain.Section{}
+	}
+
+	return sections, nil
+}
+
+func sectionDetails(code, professor string, schedule *ScheduleInput, capacity int, modality string) (domain.SectionDetails, error) {
+	details := domain.SectionDetails{
+		SectionCode: code,
+		Capacity:    capacity,
+		Modality:    domain.Modality(modality),
+	}
+
+	if professor != "" {
+		professorID, err := valueobject.IDFromString(professor)
+		if err != nil {
+			return domain.SectionDetails{}, shared.ErrInvalidInputWith(err, "invalid professor id")
+		}
+		details.ProfessorID = professorID
+	}
+
+	if schedule != nil {
+		s, err := domain.NewSchedule(schedule.Days, schedule.StartTime, schedule.EndTime, schedule.Room)
+		if err != nil {
+			return domain.SectionDetails{}, shared.ErrInvalidInputWith(err, err.Error())
+		}
+		details.Schedule = &s
+	}
+
+	return details, nil
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var (
+		appErr   *shared.AppError
+		conflict *domain.ScheduleConflictError
+	)
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.As(err, &conflict):
+		return shared.ErrConflictWith(err, domain.ErrScheduleConflict.Error()).WithDetails(conflict.Conflicts)
+	case errors.Is(err, domain.ErrSectionNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, shared.ErrConflict):
+		return shared.ErrConflictWith(err, "section code already used for that course and period")
+	case errors.Is(err, shared.ErrNotFound):
+		return shared.ErrInvalidInputWith(err, "referenced course, period or professor does not exist")
+	default:
+		return shared.ErrInternalWith(err, "cannot process course section")
+	}
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEmptyScheduleDays = errors.New("schedule needs at least one day")
+	ErrInvalidWeekday    = errors.New("invalid weekday, use Mon, Tue, Wed, Thu, Fri, Sat or Sun")
+	ErrDuplicatedWeekday = errors.New("schedule repeats a weekday")
+	ErrInvalidClockTime  = errors.New("invalid time, use the HH:MM format")
+	ErrEndBeforeStart    = errors.New("end time must be after start time")
+	ErrRoomRequired      = errors.New("room is required for in person and hybrid sections")
+)
+
+// Weekday is a day of the week stored by its three letter name
+type Weekday string
+
+const (
+	Monday    Weekday = "Mon"
+	Tuesday   Weekday = "Tue"
+	Wednesday Weekday = "Wed"
+	Thursday  Weekday = "Thu"
+	Friday    Weekday = "Fri"
+	Saturday  Weekday = "Sat"
+	Sunday    Weekday = "Sun"
+)
+
+// Weekdays lists the days of the week from Monday on
+var Weekdays = []Weekday{Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday}
+
+// ParseWeekday accepts the short or long english day name in any case
+func ParseWeekday(s string) (Weekday, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 3 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidWeekday, s)
+	}
+
+	for _, day := range Weekdays {
+		if strings.EqualFold(s[:3], string(day)) {
+			return day, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidWeekday, s)
+}
+
+// ClockTime is a time of the day with minute precision
+type ClockTime int
+
+// ParseClockTime parses a time in the 24 hour HH:MM format
+func ParseClockTime(s string) (ClockTime, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidClockTime, s)
+	}
+	return ClockTime(t.Hour()*60 + t.Minute()), nil
+}
+
+// String returns the time in the HH:MM format
+func (t ClockTime) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+func (t ClockTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *ClockTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := ParseClockTime(s)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// Schedule is the weekly meeting time of a section. The JSON shape matches
+// the one stored in course_sections.schedule.
+type Schedule struct {
+	Days      []Weekday `json:"days"`
+	StartTime ClockTime `json:"start_time"`
+	EndTime   ClockTime `json:"end_time"`
+	Room      string    `json:"room,omitempty"`
+}
+
+// NewSchedule creates a Schedule with validation
+func NewSchedule(days []string, startTime, endTime, room string) (Schedule, error) {
+	if len(days) == 0 {
+		return Schedule{}, ErrEmptyScheduleDays
+	}
+
+	seen := make(map[Weekday]bool, len(days))
+	parsed := make([]Weekday, 0, len(days))
+	for _, d := range days {
+		day, err := ParseWeekday(d)
+		if err != nil {
+			return Schedule{}, err
+		}
+		if seen[day] {
+			return Schedule{}, fmt.Errorf("%w: %s", ErrDuplicatedWeekday, day)
+		}
+		seen[day] = true
+		parsed = append(parsed, day)
+	}
+
+	start, err := ParseClockTime(startTime)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	end, err := ParseClockTime(endTime)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	if end <= start {
+		return Schedule{}, ErrEndBeforeStart
+	}
+
+	return Schedule{
+		Days:      parsed,
+		StartTime: start,
+		EndTime:   end,
+		Room:      strings.TrimSpace(room),
+	}, nil
+}
+
+// Overlaps checks if both schedules meet on a common day at the same time.
+// Back to back meetings, one ending when the other starts, don't overlap.
+func (s Schedule) Overlaps(other Schedule) bool {
+	if s.StartTime >= other.EndTime || other.StartTime >= s.EndTime {
+		return false
+	}
+
+	for _, day := range s.Days {
+		for _, otherDay := range other.Days {
+			if day == otherDay {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SameRoom checks if both schedules use the same room, names are compared
+// ignoring case and surrounding spaces
+func (s Schedule) SameRoom(other Schedule) bool {
+	return s.Room != "" && strings.EqualFold(strings.TrimSpace(s.Room), strings.TrimSpace(other.Room))
+}
@@ -0,0 +1,147 @@
+package domain_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/section/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+func schedule(t *testing.T, days []string, start, end, room string) domain.Schedule {
+	t.Helper()
+	s, err := domain.NewSchedule(days, start, end, room)
+	if err != nil {
+		t.Fatalf("invalid schedule: %v", err)
+	}
+	return s
+}
+
+func TestNewSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		days    []string
+		start   string
+		end     string
+		wantErr error
+	}{
+		{name: "short and long day names", days: []string{"mon", "Wednesday"}, start: "08:00", end: "09:30"},
+		{name: "no days", start: "08:00", end: "09:30", wantErr: domain.ErrEmptyScheduleDays},
+		{name: "unknown day", days: []string{"Mo"}, start: "08:00", end: "09:30", wantErr: domain.ErrInvalidWeekday},
+		{name: "repeated day", days: []string{"Mon", "monday"}, start: "08:00", end: "09:30", wantErr: domain.ErrDuplicatedWeekday},
+		{name: "invalid start", days: []string{"Mon"}, start: "8am", end: "09:30", wantErr: domain.ErrInvalidClockTime},
+		{name: "invalid end", days: []string{"Mon"}, start: "08:00", end: "24:00", wantErr: domain.ErrInvalidClockTime},
+		{name: "ends when it starts", days: []string{"Mon"}, start: "08:00", end: "08:00", wantErr: domain.ErrEndBeforeStart},
+		{name: "ends before it starts", days: []string{"Mon"}, start: "10:00", end: "09:30", wantErr: domain.ErrEndBeforeStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewSchedule(tt.days, tt.start, tt.end, " A-101 ")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	s := schedule(t, []string{"mon", "Wednesday"}, "08:00", "09:30", " A-101 ")
+	want := domain.Schedule{Days: []domain.Weekday{domain.Monday, domain.Wednesday}, StartTime: 8 * 60, EndTime: 9*60 + 30, Room: "A-101"}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("got %+v, want %+v", s, want)
+	}
+}
+
+func TestScheduleOverlaps(t *testing.T) {
+	base := schedule(t, []string{"Mon", "Wed"}, "08:00", "10:00", "A-101")
+
+	tests := []struct {
+		name  string
+		other domain.Schedule
+		want  bool
+	}{
+		{name: "same time", other: schedule(t, []string{"Mon"}, "08:00", "10:00", ""), want: true},
+		{name: "starts during", other: schedule(t, []string{"Wed"}, "09:59", "11:00", ""), want: true},
+		{name: "ends during", other: schedule(t, []string{"Wed"}, "07:00", "08:01", ""), want: true},
+		{name: "contains it", other: schedule(t, []string{"Mon"}, "07:00", "11:00", ""), want: true},
+		{name: "contained", other: schedule(t, []string{"Mon"}, "08:30", "09:00", ""), want: true},
+		{name: "starts when it ends", other: schedule(t, []string{"Mon"}, "10:00", "11:00", "")},
+		{name: "ends when it starts", other: schedule(t, []string{"Mon"}, "07:00", "08:00", "")},
+		{name: "other days", other: schedule(t, []string{"Tue", "Thu"}, "08:00", "10:00", "")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.Overlaps(tt.other); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if got := tt.other.Overlaps(base); got != tt.want {
+				t.Errorf("got %v the other way around, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindConflicts(t *testing.T) {
+	period := valueobject.NewID()
+	professor := valueobject.NewID()
+
+	section := func(code string, s domain.Schedule, professorID valueobject.ID, modality domain.Modality) *domain.Section {
+		return &domain.Section{
+			ID:          valueobject.NewID(),
+			PeriodID:    period,
+			CourseCode:  "MAT101",
+			SectionCode: code,
+			ProfessorID: professorID,
+			Schedule:    &s,
+			Modality:    modality,
+			IsActive:    true,
+		}
+	}
+
+	monday := schedule(t, []string{"Mon"}, "08:00", "10:00", "A-101")
+	saving := section("01", monday, professor, domain.ModalityInPerson)
+
+	otherPeriod := section("07", monday, professor, domain.ModalityInPerson)
+	otherPeriod.PeriodID = valueobject.NewID()
+	inactive := section("08", monday, professor, domain.ModalityInPerson)
+	inactive.IsActive = false
+	itself := *saving
+
+	tests := []struct {
+		name  string
+		other *domain.Section
+		want  []domain.ConflictReason
+	}{
+		{name: "same room", other: section("02", schedule(t, []string{"Mon"}, "09:00", "11:00", " a-101"), valueobject.NewID(), domain.ModalityHybrid), want: []domain.ConflictReason{domain.ConflictRoom}},
+		{name: "same professor", other: section("03", schedule(t, []string{"Mon"}, "09:00", "11:00", "B-202"), professor, domain.ModalityInPerson), want: []domain.ConflictReason{domain.ConflictProfessor}},
+		{name: "same room and professor", other: section("04", monday, professor, domain.ModalityInPerson), want: []domain.ConflictReason{domain.ConflictRoom, domain.ConflictProfessor}},
+		{name: "virtual sections have no room", other: section("05", monday, valueobject.NewID(), domain.ModalityVirtual)},
+		{name: "back to back", other: section("06", schedule(t, []string{"Mon"}, "10:00", "12:00", "A-101"), professor, domain.ModalityInPerson)},
+		{name: "other period", other: otherPeriod},
+		{name: "inactive", other: inactive},
+		{name: "itself", other: &itself},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts := domain.FindConflicts(saving, []*domain.Section{tt.other})
+			if tt.want == nil {
+				if len(conflicts) > 0 {
+					t.Errorf("got conflicts %+v, want none", conflicts)
+				}
+				return
+			}
+
+			if len(conflicts) != 1 {
+				t.Fatalf("got %d conflicts, want 1", len(conflicts))
+			}
+			if !reflect.DeepEqual(conflicts[0].Reasons, tt.want) {
+				t.Errorf("got reasons %v, want %v", conflicts[0].Reasons, tt.want)
+			}
+			if !conflicts[0].SectionID.Equals(tt.other.ID) {
+				t.Error("the conflict is not the other section")
+			}
+		})
+	}
+}
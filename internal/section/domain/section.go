@@ -0,0 +1,257 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrSectionNotFound    = errors.New("course section does not exist")
+	ErrEmptySectionCode   = errors.New("section code cannot be empty")
+	ErrInvalidCapacity    = errors.New("capacity must be greater than zero")
+	ErrInvalidModality    = errors.New("modality must be in_person, virtual or hybrid")
+	ErrScheduleConflict   = errors.New("schedule conflicts with other sections")
+	ErrSectionCodeTooLong = errors.New("section code cannot exceed 10 characters")
+)
+
+type SectionRepository interface {
+	// Create and Update must fail with a *ScheduleConflictError when the
+	// section double books a room or a professor, the check has to be atomic
+	// with the write
+	Create(ctx context.Context, s *Section) (err error)
+	Update(ctx context.Context, s *Section) (err error)
+	FindByID(ctx context.Context, id valueobject.ID) (*Section, error)
+	List(ctx context.Context, filter SectionFilter) ([]*Section, error)
+}
+
+// SectionFilter narrows the list of sections. Zero values are ignored.
+type SectionFilter struct {
+	PeriodID    valueobject.ID
+	CourseID    valueobject.ID
+	ProfessorID valueobject.ID
+}
+
+// Modality is how the classes of a section are delivered
+type Modality string
+
+const (
+	ModalityInPerson Modality = "in_person"
+	ModalityVirtual  Modality = "virtual"
+	ModalityHybrid   Modality = "hybrid"
+)
+
+// IsValid checks if the modality is valid
+func (m Modality) IsValid() bool {
+	switch m {
+	case ModalityInPerson, ModalityVirtual, ModalityHybrid:
+		return true
+	default:
+		return false
+	}
+}
+
+// Section is a course offered in an academic period
+type Section struct {
+	ID          valueobject.ID `json:"id"`
+	CourseID    valueobject.ID `json:"course_id"`
+	CourseCode  string         `json:"course_code,omitempty"`
+	PeriodID    valueobject.ID `json:"academic_period_id"`
+	SectionCode string         `json:"section_code"`
+	// ProfessorID is empty while no professor is assigned
+	ProfessorID valueobject.ID `json:"professor_id"`
+	// Schedule is nil for sections without fixed meetings
+	Schedule  *Schedule `json:"schedule"`
+	Capacity  int       `json:"capacity"`
+	Modality  Modality  `json:"modality"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SectionDetails groups the editable fields of a section
+type SectionDetails struct {
+	SectionCode string
+	ProfessorID valueobject.ID
+	Schedule    *Schedule
+	Capacity    int
+	Modality    Modality
+}
+
+// NewSection creates a new active Section with validation
+func NewSection(courseID, periodID valueobject.ID, details SectionDetails) (*Section, error) {
+	now := time.Now()
+	s := &Section{
+		ID:        valueobject.NewID(),
+		CourseID:  courseID,
+		PeriodID:  periodID,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.apply(details); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// SectionFromPersistence creates a Section instance from database records
+func SectionFromPersistence(
+	id valueobject.ID,
+	courseID valueobject.ID,
+	courseCode string,
+	periodID valueobject.ID,
+	details SectionDetails,
+	isActive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Section {
+	return &Section{
+		ID:          id,
+		CourseID:    courseID,
+		CourseCode:  courseCode,
+		PeriodID:    periodID,
+		SectionCode: details.SectionCode,
+		ProfessorID: details.ProfessorID,
+		Schedule:    details.Schedule,
+		Capacity:    details.Capacity,
+		Modality:    details.Modality,
+		IsActive:    isActive,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// UpdateDetails replaces the editable fields of the section
+func (s *Section) UpdateDetails(details SectionDetails) error {
+	if err := s.apply(details); err != nil {
+		return err
+	}
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Activate opens the section again
+func (s *Section) Activate() {
+	s.IsActive = true
+	s.UpdatedAt = time.Now()
+}
+
+// Deactivate cancels the section, it no longer takes part in conflict checks
+func (s *Section) Deactivate() {
+	s.IsActive = false
+	s.UpdatedAt = time.Now()
+}
+
+func (s *Section) apply(d SectionDetails) error {
+	code := strings.TrimSpace(d.SectionCode)
+	if code == "" {
+		return ErrEmptySectionCode
+	}
+	if len(code) > 10 {
+		return ErrSectionCodeTooLong
+	}
+
+	if d.Capacity <= 0 {
+		return ErrInvalidCapacity
+	}
+
+	if d.Modality == "" {
+		d.Modality = ModalityInPerson
+	}
+	if !d.Modality.IsValid() {
+		return ErrInvalidModality
+	}
+
+	if d.Schedule != nil && d.Schedule.Room == "" && d.Modality != ModalityVirtual {
+		return ErrRoomRequired
+	}
+
+	s.SectionCode = code
+	s.ProfessorID = d.ProfessorID
+	s.Schedule = d.Schedule
+	s.Capacity = d.Capacity
+	s.Modality = d.Modality
+	return nil
+}
+
+// ConflictReason tells which resource two sections compete for
+type ConflictReason string
+
+const (
+	ConflictRoom      ConflictReason = "room"
+	ConflictProfessor ConflictReason = "professor"
+)
+
+// ScheduleConflict describes another section that overlaps with the one
+// being saved
+type ScheduleConflict struct {
+	SectionID   valueobject.ID   `json:"section_id"`
+	CourseCode  string           `json:"course_code"`
+	SectionCode string           `json:"section_code"`
+	Reasons     []ConflictReason `json:"reasons"`
+	Schedule    Schedule         `json:"schedule"`
+}
+
+// ScheduleConflictError carries the sections that block a schedule
+type ScheduleConflictError struct {
+	Conflicts []ScheduleConflict
+}
+
+func (e *ScheduleConflictError) Error() string {
+	codes := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		codes = append(codes, c.CourseCode+"-"+c.SectionCode)
+	}
+	return fmt.Sprintf("%s: %s", ErrScheduleConflict, strings.Join(codes, ", "))
+}
+
+func (e *ScheduleConflictError) Is(target error) bool {
+	return target == ErrScheduleConflict
+}
+
+// FindConflicts returns the sections of others that use the room or the
+// professor of s at an overlapping time. Inactive sections, sections of
+// other periods and s itself are ignored.
+func FindConflicts(s *Section, others []*Section) []ScheduleConflict {
+	if !s.IsActive || s.Schedule == nil {
+		return nil
+	}
+
+	var conflicts []ScheduleConflict
+	for _, other := range others {
+		if other.ID.Equals(s.ID) || !other.IsActive || other.Schedule == nil || !other.PeriodID.Equals(s.PeriodID) {
+			continue
+		}
+
+		if !s.Schedule.Overlaps(*other.Schedule) {
+			continue
+		}
+
+		var reasons []ConflictReason
+		if s.Modality != ModalityVirtual && other.Modality != ModalityVirtual && s.Schedule.SameRoom(*other.Schedule) {
+			reasons = append(reasons, ConflictRoom)
+		}
+		if !s.ProfessorID.IsEmpty() && s.ProfessorID.Equals(other.ProfessorID) {
+			reasons = append(reasons, ConflictProfessor)
+		}
+
+		if len(reasons) > 0 {
+			conflicts = append(conflicts, ScheduleConflict{
+				SectionID:   other.ID,
+				CourseCode:  other.CourseCode,
+				SectionCode: other.SectionCode,
+				Reasons:     reasons,
+				Schedule:    *other.Schedule,
+			})
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,320 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/section/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+const sectionColumns = `
+	s.id,
+	s.course_id,
+	c.code,
+	s.academic_period_id,
+	s.section_code,
+	s.professor_id,
+	s.schedule,
+	COALESCE(s.capacity, 30),
+	COALESCE(s.modality, 'in_person'),
+	COALESCE(s.is_active, true),
+	COALESCE(s.created_at, NOW()),
+	COALESCE(s.updated_at, NOW())
+`
+
+type postgresSectionRepository struct {
+	pool *sql.DB
+}
+
+func NewSectionRepository(db *sql.DB) *postgresSectionRepository {
+	return &postgresSectionRepository{db}
+}
+
+func (r postgresSectionRepository) Create(ctx context.Context, s *domain.Section) error {
+	return r.withConflictCheck(ctx, s, func(tx *sql.Tx, schedule sql.NullString) error {
+		query := `
+			INSERT INTO course_sections (
+				id,
+				course_id,
+				academic_period_id,
+				section_code,
+				professor_id,
+				schedule,
+				capacity,
+				modality,
+				is_active,
+				created_at,
+				updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		`
+		_, err := tx.ExecContext(ctx, query,
+			s.ID.String(),
+			s.CourseID.String(),
+			s.PeriodID.String(),
+			s.SectionCode,
+			nullableID(s.ProfessorID),
+			schedule,
+			s.Capacity,
+			string(s.Modality),
+			s.IsActive,
+			s.CreatedAt,
+			s.UpdatedAt,
+		)
+		return err
+	})
+}
+
+func (r postgresSectionRepository) Update(ctx context.Context, s *domain.Section) error {
+	return r.withConflictCheck(ctx, s, func(tx *sql.Tx, schedule sql.NullString) error {
+		query := `
+			UPDATE course_sections SET
+				section_code = $2,
+				professor_id = $3,
+				schedule = $4,
+				capacity = $5,
+				modality = $6,
+				is_active = $7,
+				updated_at = $8
+			WHERE id = $1
+		`
+		result, err := tx.ExecContext(ctx, query,
+			s.ID.String(),
+			s.SectionCode,
+			nullableID(s.ProfessorID),
+			schedule,
+			s.Capacity,
+			string(s.Modality),
+			s.IsActive,
+			s.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return domain.ErrSectionNotFound
+		}
+		return nil
+	})
+}
+
+// withConflictCheck runs write inside a transaction that holds a lock on the
+// period of the section, so two sections can't book the same room or
+// professor at the same time
+func (r postgresSectionRepository) withConflictCheck(ctx context.Context, s *domain.Section, write func(tx *sql.Tx, schedule sql.NullString) error) error {
+	schedule, err := encodeSchedule(s.Schedule)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('course_sections:' || $1))`, s.PeriodID.String()); err != nil {
+		return err
+	}
+
+	if s.IsActive && s.Schedule != nil {
+		query := `
+			SELECT ` + sectionColumns + `
+			FROM course_sections s
+			JOIN courses c ON c.id = s.course_id
+			WHERE s.academic_period_id = $1
+			  AND s.id <> $2
+			  AND COALESCE(s.is_active, true)
+			  AND s.schedule IS NOT NULL
+		`
+		others, err := querySections(ctx, tx, query, s.PeriodID.String(), s.ID.String())
+		if err != nil {
+			return err
+		}
+
+		if conflicts := domain.FindConflicts(s, others); len(conflicts) > 0 {
+			return &domain.ScheduleConflictError{Conflicts: conflicts}
+		}
+	}
+
+	if err := write(tx, schedule); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r postgresSectionRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.Section, error) {
+	query := `
+		SELECT ` + sectionColumns + `
+		FROM course_sections s
+		JOIN courses c ON c.id = s.course_id
+		WHERE s.id = $1
+	`
+	section, err := scanSection(r.pool.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrSectionNotFound
+	}
+	return section, err
+}
+
+func (r postgresSectionRepository) List(ctx context.Context, filter domain.SectionFilter) ([]*domain.Section, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	if !filter.PeriodID.IsEmpty() {
+		args = append(args, filter.PeriodID.String())
+		conditions = append(conditions, fmt.Sprintf("s.academic_period_id = $%d", len(args)))
+	}
+
+	if !filter.CourseID.IsEmpty() {
+		args = append(args, filter.CourseID.String())
+		conditions = append(conditions, fmt.Sprintf("s.course_id = $%d", len(args)))
+	}
+
+	if !filter.ProfessorID.IsEmpty() {
+		args = append(args, filter.ProfessorID.String())
+		conditions = append(conditions, fmt.Sprintf("s.professor_id = $%d", len(args)))
+	}
+
+	query := `
+		SELECT ` + sectionColumns + `
+		FROM course_sections s
+		JOIN courses c ON c.id = s.course_id
+	`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY c.code, s.section_code`
+
+	return querySections(ctx, r.pool, query, args...)
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func querySections(ctx context.Context, q querier, query string, args ...any) ([]*domain.Section, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sections []*domain.Section
+	for rows.Next() {
+		section, err := scanSection(rows)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, section)
+	}
+
+	return sections, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSection(row scanner) (*domain.Section, error) {
+	var (
+		id, courseID, periodID valueobject.ID
+		courseCode             string
+		schedule               []byte
+		details                domain.SectionDetails
+		modality               string
+		isActive               bool
+		createdAt, updatedAt   time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&courseID,
+		&courseCode,
+		&periodID,
+		&details.SectionCode,
+		&details.ProfessorID,
+		&schedule,
+		&details.Capacity,
+		&modality,
+		&isActive,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	details.Modality = domain.Modality(modality)
+	details.Schedule = decodeSchedule(schedule)
+
+	return domain.SectionFromPersistence(
+		id,
+		courseID,
+		courseCode,
+		periodID,
+		details,
+		isActive,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// storedSchedule is the raw JSONB shape, kept loose to read legacy rows
+type storedSchedule struct {
+	Days      []string `json:"days"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+	Room      string   `json:"room"`
+}
+
+// encodeSchedule returns the JSON as text, lib/pq would send a []byte as bytea
+func encodeSchedule(s *domain.Schedule) (sql.NullString, error) {
+	if s == nil {
+		return sql.NullString{}, nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// decodeSchedule returns nil for empty or malformed legacy schedules, such
+// sections are treated as unscheduled until they are edited
+func decodeSchedule(data []byte) *domain.Schedule {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var stored storedSchedule
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil
+	}
+
+	schedule, err := domain.NewSchedule(stored.Days, stored.StartTime, stored.EndTime, stored.Room)
+	if err != nil {
+		return nil
+	}
+	return &schedule
+}
+
+func nullableID(id valueobject.ID) sql.NullString {
+	if id.IsEmpty() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: id.String(), Valid: true}
+}
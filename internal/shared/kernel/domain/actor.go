@@ -0,0 +1,43 @@
+package domain
+
+import "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+
+// Role represents the kind of user performing an action
+type Role string
+
+const (
+	RoleStudent   Role = "student"
+	RoleProfessor Role = "professor"
+	RoleAdmin     Role = "admin"
+)
+
+// IsValid checks if the role is one of the known user types
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleStudent, RoleProfessor, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Actor identifies who is performing a use case
+type Actor struct {
+	ID   valueobject.ID
+	Role Role
+}
+
+// IsAdmin checks if the actor is an administrator
+func (a Actor) IsAdmin() bool {
+	return a.Role == RoleAdmin
+}
+
+// IsProfessor checks if the actor is a professor
+func (a Actor) IsProfessor() bool {
+	return a.Role == RoleProfessor
+}
+
+// IsStudent checks if the actor is a student
+func (a Actor) IsStudent() bool {
+	return a.Role == RoleStudent
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// MaxAttachmentSize is the largest file accepted as an attachment, 25 MB
+const MaxAttachmentSize = 25 << 20
+
+var (
+	ErrEmptyAttachmentName = errors.New("attachment file name cannot be empty")
+	ErrAttachmentTooLarge  = errors.New("attachment exceeds the 25 MB limit")
+	ErrEmptyAttachment     = errors.New("attachment file is empty")
+	ErrAttachmentNotFound  = errors.New("attachment does not exist")
+)
+
+// Attachment is a file uploaded to the storage and linked to an entity, the
+// entity keeps the list of its attachments
+type Attachment struct {
+	ID          valueobject.ID `json:"id"`
+	Name        string         `json:"name"`
+	URL         string         `json:"url"`
+	ContentType string         `json:"content_type"`
+	Size        int64          `json:"size"`
+	UploadedAt  time.Time      `json:"uploaded_at"`
+}
+
+// NewAttachment validates the uploaded file, the URL is set once it is stored
+func NewAttachment(name, contentType string, size int64, now time.Time) (Attachment, error) {
+	// only the base name is kept, clients may send full paths
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return Attachment{}, ErrEmptyAttachmentName
+	}
+
+	if size <= 0 {
+		return Attachment{}, ErrEmptyAttachment
+	}
+
+	if size > MaxAttachmentSize {
+		return Attachment{}, ErrAttachmentTooLarge
+	}
+
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return Attachment{
+		ID:          valueobject.NewID(),
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		UploadedAt:  now,
+	}, nil
+}
+
+// StoredName is the file name of the object in the storage, the id prefix
+// keeps apart uploads with the same name
+func (a Attachment) StoredName() string {
+	return a.ID.String() + "-" + a.Name
+}
+
+// Attachments is the list of files of an entity
+type Attachments []Attachment
+
+// Find returns the attachment with the given id
+func (list Attachments) Find(id valueobject.ID) (Attachment, bool) {
+	for _, a := range list {
+		if a.ID.Equals(id) {
+			return a, true
+		}
+	}
+	return Attachment{}, false
+}
+
+// Without returns the list minus the attachment with the given id
+func (list Attachments) Without(id valueobject.ID) Attachments {
+	out := make(Attachments, 0, len(list))
+	for _, a := range list {
+		if !a.ID.Equals(id) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
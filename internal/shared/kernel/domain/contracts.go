@@ -1,6 +1,21 @@
 package domain
 
+import (
+	"context"
+	"io"
+)
+
+// Storage keeps uploaded files, objects are addressed by a folder, the id
+// of the entity they belong to and their file name
 type Storage interface {
-	Save()
+	// Save stores the content and returns the URL it is served from
+	Save(ctx context.Context, folderName, id, filename string, content io.Reader) (url string, err error)
+	Delete(ctx context.Context, folderName, id, filename string) error
 	BuildObjectURL(folderName, id, filename string) (url string)
 }
+
+// Transactor runs fn in a single transaction, the repositories called with
+// the context fn receives take part in it
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -35,6 +35,8 @@ type AppError struct {
 	Code    ErrorCode
 	Message string
 	Err     error
+	// Details is optional data sent to the client along with the message
+	Details any
 }
 
 func (e *AppError) Error() string {
@@ -55,6 +57,12 @@ func (e *AppError) Is(target error) bool {
 	return errors.Is(e.Err, target)
 }
 
+// WithDetails attaches data that helps the client resolve the error
+func (e *AppError) WithDetails(details any) *AppError {
+	e.Details = details
+	return e
+}
+
 func NewAppError(code ErrorCode, message string, err error) *AppError {
 	return &AppError{Code: code, Message: message, Err: err}
 }
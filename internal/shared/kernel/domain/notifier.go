@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+// Notification is a message addressed to a single user
+type Notification struct {
+	UserID  valueobject.ID
+	Subject string
+	Body    string
+}
+
+// Notifier delivers notifications to users through whatever channel the
+// deployment provides
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
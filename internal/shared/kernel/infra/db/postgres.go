@@ -11,6 +11,8 @@ func ExchangePGError(e *pq.Error) error {
 	switch {
 	case IsUniqueConstraintViolation(e):
 		return domain.ErrConflict
+	case IsForeignKeyViolation(e):
+		return domain.ErrNotFound
 	default:
 		return e
 	}
@@ -28,3 +30,8 @@ func IsPgError(e error) (bool, *pq.Error) {
 func IsUniqueConstraintViolation(err *pq.Error) bool {
 	return strings.Contains(err.Code.Name(), "unique")
 }
+
+// Integrity Constraint Violation, the referenced row does not exist
+func IsForeignKeyViolation(err *pq.Error) bool {
+	return err.Code.Name() == "foreign_key_violation"
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type txKey struct{}
+
+// Executor is what repositories run their queries with, either the pool or
+// the transaction carried by the context
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Conn returns the transaction started by a Transactor when the context has
+// one, the pool otherwise
+func Conn(ctx context.Context, pool *sql.DB) Executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return pool
+}
+
+type postgresTransactor struct {
+	pool *sql.DB
+}
+
+func NewTransactor(pool *sql.DB) *postgresTransactor {
+	return &postgresTransactor{pool}
+}
+
+// WithinTx commits when fn succeeds and rolls back otherwise, nested calls
+// join the transaction already started
+func (t postgresTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,56 @@
+package httpx
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/gofiber/fiber/v3"
+)
+
+// Headers forwarded by the gateway once the caller has been authenticated.
+const (
+	HeaderActorID   = "X-User-ID"
+	HeaderActorRole = "X-User-Role"
+)
+
+type actorKey struct{}
+
+// Authenticate reads the caller identity from the request headers and stores
+// it in the context locals. Requests without a valid identity are rejected.
+func Authenticate() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		id, err := valueobject.IDFromString(c.Get(HeaderActorID))
+		if err != nil {
+			return c.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": domain.ErrUnauthorized.Error()})
+		}
+
+		role := domain.Role(c.Get(HeaderActorRole))
+		if !role.IsValid() {
+			return c.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": domain.ErrUnauthorized.Error()})
+		}
+
+		fiber.Locals(c, actorKey{}, domain.Actor{ID: id, Role: role})
+		return c.Next()
+	}
+}
+
+// RequireRoles only lets the request through when the authenticated actor has
+// one of the given roles. It must be registered after Authenticate.
+func RequireRoles(roles ...domain.Role) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		actor := Actor(c)
+		for _, role := range roles {
+			if actor.Role == role {
+				return c.Next()
+			}
+		}
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": domain.ErrForbidden.Error()})
+	}
+}
+
+// Actor returns the actor stored by Authenticate, or the zero value when the
+// route is not authenticated.
+func Actor(c fiber.Ctx) domain.Actor {
+	return fiber.Locals[domain.Actor](c, actorKey{})
+}
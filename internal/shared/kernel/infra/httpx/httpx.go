@@ -1,21 +1,55 @@
 package httpx
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/gofiber/fiber/v3"
 )
 
 func GetStatusCode(err *domain.AppError) int {
 	switch err.Code {
 
-	case domain.CodeConflict:
+	case domain.CodeConflict, domain.CodeAlreadyExists:
 		return http.StatusConflict
 
 	case domain.CodeInvalidInput:
 		return http.StatusBadRequest
 
+	case domain.CodeNotFound:
+		return http.StatusNotFound
+
+	case domain.CodeUnauthorized:
+		return http.StatusUnauthorized
+
+	case domain.CodeForbidden:
+		return http.StatusForbidden
+
+	case domain.CodeNotImplemented:
+		return http.StatusNotImplemented
+
+	case domain.CodeTimeout:
+		return http.StatusGatewayTimeout
+
 	default:
 		return http.StatusInternalServerError
 	}
 }
+
+// ErrorResponse writes err as a JSON error body using the status code that
+// matches its AppError code. Unknown errors are reported as internal errors
+// so implementation details don't leak to clients. Details, when present,
+// are sent under the "details" key.
+func ErrorResponse(c fiber.Ctx, err error) error {
+	var appErr *domain.AppError
+	if !errors.As(err, &appErr) {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": domain.ErrInternal.Error()})
+	}
+
+	body := fiber.Map{"error": appErr.Message}
+	if appErr.Details != nil {
+		body["details"] = appErr.Details
+	}
+	return c.Status(GetStatusCode(appErr)).JSON(body)
+}
@@ -0,0 +1,21 @@
+package notify
+
+import (
+	"context"
+	"log"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+)
+
+// logNotifier writes notifications to the standard logger, it is the
+// default until a mail or push provider is configured
+type logNotifier struct{}
+
+func NewLogNotifier() *logNotifier {
+	return &logNotifier{}
+}
+
+func (logNotifier) Notify(_ context.Context, n domain.Notification) error {
+	log.Printf("notification to %s: %s - %s", n.UserID.String(), n.Subject, n.Body)
+	return nil
+}
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job is a unit of background work, it receives the time of the tick
+type Job func(ctx context.Context, now time.Time) error
+
+type entry struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+// Scheduler runs registered jobs periodically until it is stopped. Each job
+// runs once right after Start and then on every interval, a job never
+// overlaps with itself.
+type Scheduler struct {
+	entries []entry
+	now     func() time.Time
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{now: time.Now}
+}
+
+// Every registers a job, it must be called before Start
+func (s *Scheduler) Every(name string, interval time.Duration, job Job) {
+	s.entries = append(s.entries, entry{name: name, interval: interval, job: job})
+}
+
+// Start launches a goroutine per job and returns immediately
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, e := range s.entries {
+		s.wg.Add(1)
+		go s.run(ctx, e)
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, e entry) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.job(ctx, s.now()); err != nil && ctx.Err() == nil {
+			log.Printf("scheduled job %s failed: %s", e.name, err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
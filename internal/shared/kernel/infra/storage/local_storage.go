@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// localStorage keeps the files in a directory of the server, it is meant for
+// development and single node deployments until a bucket is configured
+type localStorage struct {
+	root    string
+	baseURL string
+}
+
+// NewLocalStorage stores files under root, baseURL is the public prefix the
+// root directory is served from
+func NewLocalStorage(root, baseURL string) *localStorage {
+	return &localStorage{root: root, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (s localStorage) Save(ctx context.Context, folderName, id, filename string, content io.Reader) (string, error) {
+	path := s.path(folderName, id, filename)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		os.Remove(path)
+		return "", err
+	}
+
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+
+	return s.BuildObjectURL(folderName, id, filename), nil
+}
+
+func (s localStorage) Delete(_ context.Context, folderName, id, filename string) error {
+	err := os.Remove(s.path(folderName, id, filename))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (s localStorage) BuildObjectURL(folderName, id, filename string) string {
+	return s.baseURL + "/" + url.PathEscape(folderName) + "/" + url.PathEscape(id) + "/" + url.PathEscape(filename)
+}
+
+// path keeps every segment inside root, names can't climb out of it
+func (s localStorage) path(folderName, id, filename string) string {
+	return filepath.Join(s.root, filepath.Base(folderName), filepath.Base(id), filepath.Base(filename))
+}
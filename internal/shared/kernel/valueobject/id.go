@@ -93,3 +93,33 @@ func (id *ID) UnmarshalJSON(data []byte) error {
 	*id = parsed
 	return nil
 }
+
+// Scan implements sql.Scanner interface, NULL and empty values read as the
+// empty ID
+func (id *ID) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*id = ID{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidID, src)
+	}
+
+	if s == "" {
+		*id = ID{}
+		return nil
+	}
+
+	parsed, err := IDFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*id = parsed
+	return nil
+}
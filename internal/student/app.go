@@ -0,0 +1,78 @@
+package student
+
+import (
+	"database/sql"
+
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/application"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/infra"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/infra/persistence"
+	"github.com/gofiber/fiber/v3"
+)
+
+// Config holds the student module settings
+type Config struct {
+	// DeactivateOnDropout disables the login of students that drop out
+	DeactivateOnDropout bool
+}
+
+type Module struct {
+	name     string
+	engine   *fiber.App
+	db       *sql.DB
+	config   Config
+	accounts application.AccountManager
+	group    fiber.Router
+}
+
+func NewModule(name string, engine *fiber.App, db *sql.DB, config Config, accounts application.AccountManager) Module {
+	group := engine.Group(name, httpx.Authenticate())
+	return Module{name: name, engine: engine, db: db, config: config, accounts: accounts, group: group}
+}
+
+// Routes is the authenticated group of the student routes, other modules
+// serve what they show from the student side on it
+func (mod Module) Routes() fiber.Router {
+	return mod.group
+}
+
+// Interactor builds the student use cases so other modules can create
+// profiles for the users they register
+func (mod Module) Interactor() application.StudentInteractor {
+	return application.NewStudentInteractor(
+		persistence.NewStudentRepository(mod.db),
+		persistence.NewStudentNumberGenerator(mod.db),
+		persistence.NewHoldRepository(mod.db),
+	)
+}
+
+func (mod Module) ConfigureEnpoints() {
+	group := mod.group
+
+	repository := persistence.NewStudentRepository(mod.db)
+
+	h := infra.NewStudentHandler(mod.Interactor())
+	status := infra.NewStatusHandler(application.NewStatusInteractor(
+		repository,
+		mod.accounts,
+		application.StatusPolicy{DeactivateOnDropout: mod.config.DeactivateOnDropout},
+		db.NewTransactor(mod.db),
+	))
+	holds := infra.NewHoldHandler(application.NewHoldInteractor(repository, persistence.NewHoldRepository(mod.db)))
+
+	admin := httpx.RequireRoles(shared.RoleAdmin)
+
+	group.Post("", admin, h.CreateStudent)
+	group.Get("", admin, h.ListStudents)
+	group.Get("/:id", h.GetStudent)
+	group.Patch("/:id", admin, h.UpdateStudent)
+
+	group.Post("/:id/status", admin, status.ChangeStatus)
+	group.Get("/:id/status-history", status.GetHistory)
+
+	group.Get("/:id/holds", holds.ListHolds)
+	group.Post("/:id/holds", admin, holds.PlaceHold)
+	group.Delete("/:id/holds/:holdId", admin, holds.ReleaseHold)
+}
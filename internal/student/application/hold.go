@@ -0,0 +1,158 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+type (
+	PlaceHoldInput struct {
+		HoldType string `json:"hold_type" validate:"required,oneof=financial disciplinary advising"`
+		Reason   string `json:"reason" validate:"required,max=1000"`
+		// BlocksEnrollment defaults to true, informative holds set it to false
+		BlocksEnrollment *bool `json:"blocks_enrollment"`
+		// ExpiresOn is the last day the hold applies, empty keeps it until released
+		ExpiresOn string `json:"expires_on" validate:"omitempty,datetime=2006-01-02"`
+	}
+
+	ListHoldsInput struct {
+		// All includes the released and expired holds
+		All bool `query:"all"`
+	}
+)
+
+type HoldInteractor interface {
+	Place(ctx context.Context, actor shared.Actor, studentID string, in PlaceHoldInput) (domain.Hold, error)
+	Release(ctx context.Context, actor shared.Actor, studentID, holdID string) (domain.Hold, error)
+	List(ctx context.Context, studentID string, in ListHoldsInput) ([]domain.Hold, error)
+}
+
+type holdInteractor struct {
+	students   domain.StudentRepository
+	repository domain.HoldRepository
+	now        func() time.Time
+}
+
+func NewHoldInteractor(s domain.StudentRepository, r domain.HoldRepository) *holdInteractor {
+	return &holdInteractor{s, r, time.Now}
+}
+
+func (interactor holdInteractor) Place(ctx context.Context, actor shared.Actor, studentID string, in PlaceHoldInput) (domain.Hold, error) {
+	student, err := interactor.student(ctx, studentID)
+	if err != nil {
+		return domain.Hold{}, err
+	}
+
+	now := interactor.now()
+
+	var expiresAt *time.Time
+	if in.ExpiresOn != "" {
+		date, err := time.Parse(dateLayout, in.ExpiresOn)
+		if err != nil {
+			return domain.Hold{}, shared.ErrInvalidInputWith(err, "invalid expiration date")
+		}
+		// the hold applies during the whole last day
+		end := date.AddDate(0, 0, 1)
+		expiresAt = &end
+	}
+
+	blocks := true
+	if in.BlocksEnrollment != nil {
+		blocks = *in.BlocksEnrollment
+	}
+
+	hold, err := domain.NewHold(student, domain.HoldType(in.HoldType), in.Reason, blocks, actor.ID, expiresAt, now)
+	if err != nil {
+		return domain.Hold{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Place(ctx, hold); err != nil {
+		return domain.Hold{}, holdError(err)
+	}
+
+	return interactor.find(ctx, student, hold.ID)
+}
+
+func (interactor holdInteractor) Release(ctx context.Context, actor shared.Actor, studentID, holdID string) (domain.Hold, error) {
+	student, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return domain.Hold{}, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	id, err := valueobject.IDFromString(holdID)
+	if err != nil {
+		return domain.Hold{}, shared.ErrInvalidInputWith(err, "invalid hold id")
+	}
+
+	hold, err := interactor.repository.FindByID(ctx, student, id)
+	if err != nil {
+		return domain.Hold{}, holdError(err)
+	}
+
+	if err := hold.Release(actor.ID, interactor.now()); err != nil {
+		return domain.Hold{}, holdError(err)
+	}
+
+	if err := interactor.repository.Release(ctx, hold); err != nil {
+		return domain.Hold{}, holdError(err)
+	}
+
+	return interactor.find(ctx, student, hold.ID)
+}
+
+func (interactor holdInteractor) List(ctx context.Context, studentID string, in ListHoldsInput) ([]domain.Hold, error) {
+	student, err := interactor.student(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	holds, err := interactor.repository.ListByStudent(ctx, student, interactor.now(), in.All)
+	if err != nil {
+		return nil, holdError(err)
+	}
+
+	if holds == nil {
+		holds = []domain.Hold{}
+	}
+
+	return holds, nil
+}
+
+// student checks that the student profile exists
+func (interactor holdInteractor) student(ctx context.Context, studentID string) (valueobject.ID, error) {
+	id, err := valueobject.IDFromString(studentID)
+	if err != nil {
+		return valueobject.ID{}, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	if _, err := interactor.students.FindByID(ctx, id); err != nil {
+		return valueobject.ID{}, mapError(err)
+	}
+
+	return id, nil
+}
+
+// find reads the hold back with the name of who placed it
+func (interactor holdInteractor) find(ctx context.Context, studentID, id valueobject.ID) (domain.Hold, error) {
+	hold, err := interactor.repository.FindByID(ctx, studentID, id)
+	if err != nil {
+		return domain.Hold{}, holdError(err)
+	}
+	return *hold, nil
+}
+
+func holdError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrHoldNotFound):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrHoldAlreadyClosed):
+		return shared.ErrConflictWith(err, err.Error())
+	default:
+		return mapError(err)
+	}
+}
@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+type (
+	ChangeStatusInput struct {
+		Status        string `json:"status" validate:"required,oneof=active graduated suspended dropout"`
+		Reason        string `json:"reason" validate:"required,max=1000"`
+		EffectiveDate string `json:"effective_date" validate:"required,datetime=2006-01-02"`
+	}
+
+	StatusChangeOutput struct {
+		domain.StatusChange
+		AccountReactivated bool `json:"account_reactivated"`
+	}
+)
+
+// AccountManager turns on and off the login of the user behind a student
+// profile, it must join the transaction carried by the context
+type AccountManager interface {
+	// Deactivate tells whether the login was on and got turned off
+	Deactivate(ctx context.Context, userID string) (bool, error)
+	Reactivate(ctx context.Context, userID string) error
+}
+
+// StatusPolicy holds the configurable side effects of status transitions
+type StatusPolicy struct {
+	// DeactivateOnDropout disables the student login when they drop out
+	DeactivateOnDropout bool
+}
+
+type StatusInteractor interface {
+	ChangeStatus(ctx context.Context, actor shared.Actor, id string, in ChangeStatusInput) (StatusChangeOutput, error)
+	History(ctx context.Context, id string) ([]domain.StatusChange, error)
+}
+
+type statusInteractor struct {
+	repository domain.StudentRepository
+	accounts   AccountManager
+	policy     StatusPolicy
+	tx         shared.Transactor
+}
+
+func NewStatusInteractor(r domain.StudentRepository, a AccountManager, p StatusPolicy, tx shared.Transactor) *statusInteractor {
+	return &statusInteractor{r, a, p, tx}
+}
+
+func (interactor statusInteractor) ChangeStatus(ctx context.Context, actor shared.Actor, id string, in ChangeStatusInput) (StatusChangeOutput, error) {
+	studentID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return StatusChangeOutput{}, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	effectiveDate, err := time.Parse(dateLayout, in.EffectiveDate)
+	if err != nil {
+		return StatusChangeOutput{}, shared.ErrInvalidInputWith(err, "invalid effective date")
+	}
+
+	student, err := interactor.repository.FindByID(ctx, studentID)
+	if err != nil {
+		return StatusChangeOutput{}, mapError(err)
+	}
+
+	change, err := student.ChangeStatus(domain.Status(in.Status), in.Reason, effectiveDate, actor.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTransition) {
+			return StatusChangeOutput{}, shared.ErrConflictWith(err, err.Error())
+		}
+		return StatusChangeOutput{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	out := StatusChangeOutput{}
+
+	// the status and the login change together, a failure on either leaves
+	// both as they were
+	err = interactor.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if change.ToStatus == domain.StatusDropout && interactor.policy.DeactivateOnDropout && interactor.accounts != nil {
+			deactivated, err := interactor.accounts.Deactivate(ctx, student.ID.String())
+			if err != nil {
+				return shared.ErrInternalWith(err, "the student account could not be deactivated")
+			}
+			change.AccountDeactivated = deactivated
+		}
+
+		// readmitted students only get back the login their drop out took
+		if change.FromStatus == domain.StatusDropout && change.ToStatus == domain.StatusActive && interactor.accounts != nil {
+			deactivated, err := interactor.deactivatedOnDropout(ctx, student.ID)
+			if err != nil {
+				return err
+			}
+			if deactivated {
+				if err := interactor.accounts.Reactivate(ctx, student.ID.String()); err != nil {
+					return shared.ErrInternalWith(err, "the student account could not be reactivated")
+				}
+				out.AccountReactivated = true
+			}
+		}
+
+		return interactor.repository.SaveStatusChange(ctx, student, change)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTransition) {
+			return StatusChangeOutput{}, shared.ErrConflictWith(err, err.Error())
+		}
+		return StatusChangeOutput{}, mapError(err)
+	}
+
+	out.StatusChange = change
+	return out, nil
+}
+
+// deactivatedOnDropout checks if the last drop out of the student turned off
+// their login
+func (interactor statusInteractor) deactivatedOnDropout(ctx context.Context, studentID valueobject.ID) (bool, error) {
+	history, err := interactor.repository.StatusHistory(ctx, studentID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ToStatus == domain.StatusDropout {
+			return history[i].AccountDeactivated, nil
+		}
+	}
+	return false, nil
+}
+
+func (interactor statusInteractor) History(ctx context.Context, id string) ([]domain.StatusChange, error) {
+	studentID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	if _, err := interactor.repository.FindByID(ctx, studentID); err != nil {
+		return nil, mapError(err)
+	}
+
+	history, err := interactor.repository.StatusHistory(ctx, studentID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if history == nil {
+		history = []domain.StatusChange{}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,224 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	shared "github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/domain"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+const dateLayout = time.DateOnly
+
+type (
+	CreateStudentInput struct {
+		UserID         string `json:"user_id" validate:"required,uuid"`
+		DegreeID       string `json:"degree_id" validate:"required,uuid"`
+		EnrollmentDate string `json:"enrollment_date" validate:"omitempty,datetime=2006-01-02"`
+	}
+
+	UpdateStudentInput struct {
+		DegreeID       string  `json:"degree_id" validate:"omitempty,uuid"`
+		EnrollmentDate string  `json:"enrollment_date" validate:"omitempty,datetime=2006-01-02"`
+		GraduationDate *string `json:"graduation_date" validate:"omitempty,datetime=2006-01-02"`
+	}
+
+	ListStudentsInput struct {
+		Status   string `query:"status" validate:"omitempty,oneof=active graduated suspended dropout"`
+		DegreeID string `query:"degree_id" validate:"omitempty,uuid"`
+		Limit    int    `query:"limit" validate:"omitempty,min=1,max=200"`
+		Offset   int    `query:"offset" validate:"omitempty,min=0"`
+	}
+
+	StudentOutput struct {
+		*domain.Profile
+		// ActiveHolds are only loaded when reading a single profile
+		ActiveHolds []domain.Hold `json:"active_holds,omitempty"`
+	}
+)
+
+type StudentInteractor interface {
+	Create(ctx context.Context, in CreateStudentInput) (StudentOutput, error)
+	CreateProfile(ctx context.Context, userID, degreeID string) error
+	Get(ctx context.Context, id string) (StudentOutput, error)
+	List(ctx context.Context, in ListStudentsInput) ([]StudentOutput, error)
+	Update(ctx context.Context, id string, in UpdateStudentInput) (StudentOutput, error)
+}
+
+type studentInteractor struct {
+	repository domain.StudentRepository
+	numbers    domain.StudentNumberGenerator
+	holds      domain.HoldRepository
+}
+
+func NewStudentInteractor(r domain.StudentRepository, g domain.StudentNumberGenerator, h domain.HoldRepository) *studentInteractor {
+	return &studentInteractor{r, g, h}
+}
+
+func (interactor studentInteractor) Create(ctx context.Context, in CreateStudentInput) (StudentOutput, error) {
+	student, err := interactor.create(ctx, in)
+	if err != nil {
+		return StudentOutput{}, err
+	}
+
+	return interactor.Get(ctx, student.ID.String())
+}
+
+// CreateProfile creates the student profile of a freshly registered user, it
+// runs in the transaction of the context the user is created in
+func (interactor studentInteractor) CreateProfile(ctx context.Context, userID, degreeID string) error {
+	_, err := interactor.create(ctx, CreateStudentInput{UserID: userID, DegreeID: degreeID})
+	return err
+}
+
+func (interactor studentInteractor) create(ctx context.Context, in CreateStudentInput) (*domain.Student, error) {
+	userID, err := valueobject.IDFromString(in.UserID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid user id")
+	}
+
+	degreeID, err := valueobject.IDFromString(in.DegreeID)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, "invalid degree id")
+	}
+
+	enrollmentDate := time.Now()
+	if in.EnrollmentDate != "" {
+		if enrollmentDate, err = time.Parse(dateLayout, in.EnrollmentDate); err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid enrollment date")
+		}
+	}
+
+	number, err := interactor.numbers.Next(ctx, degreeID, enrollmentDate.Year())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	student, err := domain.NewStudent(userID, number, degreeID, enrollmentDate)
+	if err != nil {
+		return nil, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Create(ctx, student); err != nil {
+		return nil, mapError(err)
+	}
+
+	return student, nil
+}
+
+func (interactor studentInteractor) Get(ctx context.Context, id string) (StudentOutput, error) {
+	studentID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return StudentOutput{}, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	profile, err := interactor.repository.FindProfile(ctx, studentID)
+	if err != nil {
+		return StudentOutput{}, mapError(err)
+	}
+
+	holds, err := interactor.holds.ListByStudent(ctx, studentID, time.Now(), false)
+	if err != nil {
+		return StudentOutput{}, mapError(err)
+	}
+
+	return StudentOutput{Profile: profile, ActiveHolds: holds}, nil
+}
+
+func (interactor studentInteractor) List(ctx context.Context, in ListStudentsInput) ([]StudentOutput, error) {
+	filter := domain.ProfileFilter{
+		Status: domain.Status(in.Status),
+		Limit:  in.Limit,
+		Offset: in.Offset,
+	}
+
+	if in.DegreeID != "" {
+		degreeID, err := valueobject.IDFromString(in.DegreeID)
+		if err != nil {
+			return nil, shared.ErrInvalidInputWith(err, "invalid degree id")
+		}
+		filter.DegreeID = degreeID
+	}
+
+	profiles, err := interactor.repository.ListProfiles(ctx, filter)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]StudentOutput, 0, len(profiles))
+	for _, profile := range profiles {
+		out = append(out, StudentOutput{Profile: profile})
+	}
+
+	return out, nil
+}
+
+func (interactor studentInteractor) Update(ctx context.Context, id string, in UpdateStudentInput) (StudentOutput, error) {
+	studentID, err := valueobject.IDFromString(id)
+	if err != nil {
+		return StudentOutput{}, shared.ErrInvalidInputWith(err, "invalid student id")
+	}
+
+	student, err := interactor.repository.FindByID(ctx, studentID)
+	if err != nil {
+		return StudentOutput{}, mapError(err)
+	}
+
+	if in.DegreeID != "" {
+		degreeID, err := valueobject.IDFromString(in.DegreeID)
+		if err != nil {
+			return StudentOutput{}, shared.ErrInvalidInputWith(err, "invalid degree id")
+		}
+		if err := student.ChangeDegree(degreeID); err != nil {
+			return StudentOutput{}, shared.ErrInvalidInputWith(err, err.Error())
+		}
+	}
+
+	enrollmentDate := student.EnrollmentDate
+	if in.EnrollmentDate != "" {
+		if enrollmentDate, err = time.Parse(dateLayout, in.EnrollmentDate); err != nil {
+			return StudentOutput{}, shared.ErrInvalidInputWith(err, "invalid enrollment date")
+		}
+	}
+
+	graduationDate := student.GraduationDate
+	if in.GraduationDate != nil {
+		graduationDate = nil
+		if *in.GraduationDate != "" {
+			date, err := time.Parse(dateLayout, *in.GraduationDate)
+			if err != nil {
+				return StudentOutput{}, shared.ErrInvalidInputWith(err, "invalid graduation date")
+			}
+			graduationDate = &date
+		}
+	}
+
+	if err := student.UpdateDates(enrollmentDate, graduationDate); err != nil {
+		return StudentOutput{}, shared.ErrInvalidInputWith(err, err.Error())
+	}
+
+	if err := interactor.repository.Update(ctx, student); err != nil {
+		return StudentOutput{}, mapError(err)
+	}
+
+	return interactor.Get(ctx, student.ID.String())
+}
+
+// mapError translates domain and persistence errors into application errors
+func mapError(err error) error {
+	var appErr *shared.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, domain.ErrStudentProfileNotExists):
+		return shared.ErrNotFoundWith(err, err.Error())
+	case errors.Is(err, domain.ErrStudentUserNotFound), errors.Is(err, domain.ErrDegreeNotFound):
+		return shared.ErrInvalidInputWith(err, err.Error())
+	case errors.Is(err, shared.ErrConflict):
+		return shared.ErrConflictWith(err, "student profile already exists")
+	default:
+		return shared.ErrInternalWith(err, "cannot process student profile")
+	}
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrHoldNotFound      = errors.New("hold does not exist")
+	ErrInvalidHoldType   = errors.New("invalid hold type")
+	ErrEmptyHoldReason   = errors.New("a reason is required to place a hold")
+	ErrHoldExpiryInPast  = errors.New("hold expiration must be in the future")
+	ErrHoldAlreadyClosed = errors.New("hold was already released or expired")
+)
+
+type HoldRepository interface {
+	Place(ctx context.Context, h *Hold) (err error)
+	// Release stores the release of an active hold
+	Release(ctx context.Context, h *Hold) (err error)
+	FindByID(ctx context.Context, studentID, id valueobject.ID) (*Hold, error)
+	// ListByStudent returns the holds of the student, only the ones active
+	// at now unless all is set
+	ListByStudent(ctx context.Context, studentID valueobject.ID, now time.Time, all bool) ([]Hold, error)
+}
+
+// HoldType is the office that placed the hold
+type HoldType string
+
+const (
+	HoldFinancial    HoldType = "financial"
+	HoldDisciplinary HoldType = "disciplinary"
+	HoldAdvising     HoldType = "advising"
+)
+
+// IsValid checks if the hold type is valid
+func (t HoldType) IsValid() bool {
+	switch t {
+	case HoldFinancial, HoldDisciplinary, HoldAdvising:
+		return true
+	default:
+		return false
+	}
+}
+
+// Hold is a registration hold placed on a student. Blocking holds keep the
+// student from enrolling while they are active, that is until they are
+// released or expire.
+type Hold struct {
+	ID               valueobject.ID `json:"id"`
+	StudentID        valueobject.ID `json:"student_id"`
+	Type             HoldType       `json:"hold_type"`
+	Reason           string         `json:"reason"`
+	BlocksEnrollment bool           `json:"blocks_enrollment"`
+	PlacedBy         valueobject.ID `json:"placed_by"`
+	// PlacedByName is the full name of the user that placed the hold, it's
+	// only filled when reading
+	PlacedByName string `json:"placed_by_name,omitempty"`
+	// ExpiresAt is nil for holds that stay until released
+	ExpiresAt  *time.Time      `json:"expires_at,omitempty"`
+	ReleasedAt *time.Time      `json:"released_at,omitempty"`
+	ReleasedBy *valueobject.ID `json:"released_by,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// NewHold creates a new active Hold with validation
+func NewHold(
+	studentID valueobject.ID,
+	holdType HoldType,
+	reason string,
+	blocksEnrollment bool,
+	placedBy valueobject.ID,
+	expiresAt *time.Time,
+	now time.Time,
+) (*Hold, error) {
+	if err := studentID.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !holdType.IsValid() {
+		return nil, ErrInvalidHoldType
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrEmptyHoldReason
+	}
+
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrHoldExpiryInPast
+	}
+
+	return &Hold{
+		ID:               valueobject.NewID(),
+		StudentID:        studentID,
+		Type:             holdType,
+		Reason:           reason,
+		BlocksEnrollment: blocksEnrollment,
+		PlacedBy:         placedBy,
+		ExpiresAt:        expiresAt,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}, nil
+}
+
+// HoldFromPersistence creates a Hold instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func HoldFromPersistence(
+	id valueobject.ID,
+	studentID valueobject.ID,
+	holdType HoldType,
+	reason string,
+	blocksEnrollment bool,
+	placedBy valueobject.ID,
+	placedByName string,
+	expiresAt *time.Time,
+	releasedAt *time.Time,
+	releasedBy *valueobject.ID,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Hold {
+	return Hold{
+		ID:               id,
+		StudentID:        studentID,
+		Type:             holdType,
+		Reason:           reason,
+		BlocksEnrollment: blocksEnrollment,
+		PlacedBy:         placedBy,
+		PlacedByName:     placedByName,
+		ExpiresAt:        expiresAt,
+		ReleasedAt:       releasedAt,
+		ReleasedBy:       releasedBy,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+	}
+}
+
+// IsActive checks if the hold is neither released nor expired at now
+func (h Hold) IsActive(now time.Time) bool {
+	return h.ReleasedAt == nil && (h.ExpiresAt == nil || h.ExpiresAt.After(now))
+}
+
+// Release lifts the hold
+func (h *Hold) Release(releasedBy valueobject.ID, now time.Time) error {
+	if !h.IsActive(now) {
+		return ErrHoldAlreadyClosed
+	}
+
+	h.ReleasedAt = &now
+	h.ReleasedBy = &releasedBy
+	h.UpdatedAt = now
+	return nil
+}
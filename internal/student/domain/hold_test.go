@@ -0,0 +1,112 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+func TestNewHold(t *testing.T) {
+	now := time.Date(2025, time.August, 4, 9, 0, 0, 0, time.UTC)
+	later, past := now.Add(time.Minute), now
+
+	tests := []struct {
+		name      string
+		studentID valueobject.ID
+		holdType  domain.HoldType
+		reason    string
+		expiresAt *time.Time
+		wantErr   error
+	}{
+		{name: "until released", studentID: valueobject.NewID(), holdType: domain.HoldFinancial, reason: " unpaid fees "},
+		{name: "until it expires", studentID: valueobject.NewID(), holdType: domain.HoldAdvising, reason: "meet your advisor", expiresAt: &later},
+		{name: "unknown type", studentID: valueobject.NewID(), holdType: domain.HoldType("library"), reason: "overdue books", wantErr: domain.ErrInvalidHoldType},
+		{name: "blank reason", studentID: valueobject.NewID(), holdType: domain.HoldDisciplinary, reason: "  ", wantErr: domain.ErrEmptyHoldReason},
+		{name: "expires right away", studentID: valueobject.NewID(), holdType: domain.HoldFinancial, reason: "unpaid fees", expiresAt: &past, wantErr: domain.ErrHoldExpiryInPast},
+		{name: "no student", holdType: domain.HoldFinancial, reason: "unpaid fees", wantErr: valueobject.ErrEmptyID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := domain.NewHold(tt.studentID, tt.holdType, tt.reason, true, valueobject.NewID(), tt.expiresAt, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if !h.IsActive(now) || h.ReleasedAt != nil {
+				t.Errorf("got %+v, want an active hold", h)
+			}
+			if h.Reason != strings.TrimSpace(tt.reason) {
+				t.Errorf("got reason %q, want it trimmed", h.Reason)
+			}
+		})
+	}
+}
+
+func TestHoldIsActive(t *testing.T) {
+	now := time.Date(2025, time.August, 4, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	released := now.Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		hold domain.Hold
+		at   time.Time
+		want bool
+	}{
+		{name: "until released", hold: domain.Hold{}, at: now.AddDate(5, 0, 0), want: true},
+		{name: "before it expires", hold: domain.Hold{ExpiresAt: &expires}, at: expires.Add(-time.Second), want: true},
+		{name: "when it expires", hold: domain.Hold{ExpiresAt: &expires}, at: expires},
+		{name: "released", hold: domain.Hold{ReleasedAt: &released}, at: now},
+		{name: "released before it expires", hold: domain.Hold{ExpiresAt: &expires, ReleasedAt: &released}, at: now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hold.IsActive(tt.at); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHoldRelease(t *testing.T) {
+	now := time.Date(2025, time.August, 4, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	admin := valueobject.NewID()
+
+	h, err := domain.NewHold(valueobject.NewID(), domain.HoldFinancial, "unpaid fees", true, valueobject.NewID(), &expires, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	released := now.Add(10 * time.Minute)
+	if err := h.Release(admin, released); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.IsActive(released) || !h.ReleasedAt.Equal(released) || !h.ReleasedBy.Equals(admin) || !h.UpdatedAt.Equal(released) {
+		t.Errorf("got %+v, want it released by the admin", h)
+	}
+
+	if err := h.Release(admin, released.Add(time.Minute)); !errors.Is(err, domain.ErrHoldAlreadyClosed) {
+		t.Errorf("got error %v releasing twice, want %v", err, domain.ErrHoldAlreadyClosed)
+	}
+
+	expired, err := domain.NewHold(valueobject.NewID(), domain.HoldAdvising, "meet your advisor", true, valueobject.NewID(), &expires, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := expired.Release(admin, expires); !errors.Is(err, domain.ErrHoldAlreadyClosed) {
+		t.Errorf("got error %v releasing an expired hold, want %v", err, domain.ErrHoldAlreadyClosed)
+	}
+	if expired.ReleasedAt != nil {
+		t.Error("an expired hold was released")
+	}
+}
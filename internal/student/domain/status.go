@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrInvalidTransition    = errors.New("invalid status transition")
+	ErrEmptyReason          = errors.New("a reason is required to change the status")
+	ErrEmptyEffectiveDate   = errors.New("effective date cannot be empty")
+	ErrEffectiveBeforeStart = errors.New("effective date cannot be before the enrollment date")
+)
+
+// Status represents the academic situation of a student
+type Status string
+
+const (
+	StatusActive    Status = "active"
+	StatusGraduated Status = "graduated"
+	StatusSuspended Status = "suspended"
+	StatusDropout   Status = "dropout"
+)
+
+// transitions lists the statuses reachable from each status. Graduated is
+// terminal, dropouts can only come back through a readmission to active.
+var transitions = map[Status][]Status{
+	StatusActive:    {StatusSuspended, StatusGraduated, StatusDropout},
+	StatusSuspended: {StatusActive, StatusDropout},
+	StatusDropout:   {StatusActive},
+	StatusGraduated: {},
+}
+
+// IsValid checks if the status is valid
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusActive, StatusGraduated, StatusSuspended, StatusDropout:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of Status
+func (s Status) String() string {
+	return string(s)
+}
+
+// CanTransitionTo checks if the state machine allows moving to next
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal checks if no further transitions are possible
+func (s Status) IsTerminal() bool {
+	return len(transitions[s]) == 0
+}
+
+// StatusChange records a single transition of the student status
+type StatusChange struct {
+	ID            valueobject.ID `json:"id"`
+	StudentID     valueobject.ID `json:"student_id"`
+	FromStatus    Status         `json:"from_status"`
+	ToStatus      Status         `json:"to_status"`
+	Reason        string         `json:"reason"`
+	EffectiveDate time.Time      `json:"effective_date"`
+	ChangedBy     valueobject.ID `json:"changed_by"`
+	// AccountDeactivated is set when the change turned off the student login
+	AccountDeactivated bool      `json:"account_deactivated"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// StatusChangeFromPersistence creates a StatusChange instance from database records
+func StatusChangeFromPersistence(
+	id valueobject.ID,
+	studentID valueobject.ID,
+	from Status,
+	to Status,
+	reason string,
+	effectiveDate time.Time,
+	changedBy valueobject.ID,
+	accountDeactivated bool,
+	createdAt time.Time,
+) StatusChange {
+	return StatusChange{
+		ID:                 id,
+		StudentID:          studentID,
+		FromStatus:         from,
+		ToStatus:           to,
+		Reason:             reason,
+		EffectiveDate:      effectiveDate,
+		ChangedBy:          changedBy,
+		AccountDeactivated: accountDeactivated,
+		CreatedAt:          createdAt,
+	}
+}
+
+// ChangeStatus moves the student to the next status following the state
+// machine and returns the history entry that must be stored with it
+func (s *Student) ChangeStatus(next Status, reason string, effectiveDate time.Time, changedBy valueobject.ID) (StatusChange, error) {
+	if !next.IsValid() {
+		return StatusChange{}, ErrInvalidStatus
+	}
+
+	if !s.Status.CanTransitionTo(next) {
+		return StatusChange{}, fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, s.Status, next)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return StatusChange{}, ErrEmptyReason
+	}
+
+	if effectiveDate.IsZero() {
+		return StatusChange{}, ErrEmptyEffectiveDate
+	}
+
+	effectiveDate = truncateToDate(effectiveDate)
+	if effectiveDate.Before(s.EnrollmentDate) {
+		return StatusChange{}, ErrEffectiveBeforeStart
+	}
+
+	now := time.Now()
+
+	change := StatusChange{
+		ID:            valueobject.NewID(),
+		StudentID:     s.ID,
+		FromStatus:    s.Status,
+		ToStatus:      next,
+		Reason:        reason,
+		EffectiveDate: effectiveDate,
+		ChangedBy:     changedBy,
+		CreatedAt:     now,
+	}
+
+	s.Status = next
+	if next == StatusGraduated {
+		s.GraduationDate = &effectiveDate
+	}
+	s.UpdatedAt = now
+
+	return change, nil
+}
@@ -0,0 +1,117 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+func TestStatusCanTransitionTo(t *testing.T) {
+	statuses := []domain.Status{domain.StatusActive, domain.StatusSuspended, domain.StatusDropout, domain.StatusGraduated}
+
+	allowed := map[domain.Status][]domain.Status{
+		domain.StatusActive:    {domain.StatusSuspended, domain.StatusGraduated, domain.StatusDropout},
+		domain.StatusSuspended: {domain.StatusActive, domain.StatusDropout},
+		domain.StatusDropout:   {domain.StatusActive},
+		domain.StatusGraduated: {},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := false
+			for _, s := range allowed[from] {
+				want = want || s == to
+			}
+
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("%s -> %s: got %v, want %v", from, to, got, want)
+			}
+		}
+	}
+
+	if !domain.StatusGraduated.IsTerminal() {
+		t.Error("graduated must be terminal")
+	}
+	if domain.StatusDropout.IsTerminal() {
+		t.Error("dropouts can be readmitted, dropout must not be terminal")
+	}
+}
+
+func TestStudentChangeStatus(t *testing.T) {
+	enrolled := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	effective := time.Date(2025, time.July, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		from      domain.Status
+		to        domain.Status
+		reason    string
+		effective time.Time
+		wantErr   error
+	}{
+		{name: "suspends an active student", from: domain.StatusActive, to: domain.StatusSuspended, reason: "unpaid fees", effective: effective},
+		{name: "readmits a dropout", from: domain.StatusDropout, to: domain.StatusActive, reason: "readmission", effective: effective},
+		{name: "graduates an active student", from: domain.StatusActive, to: domain.StatusGraduated, reason: "completed the degree", effective: effective},
+		{name: "refuses leaving graduated", from: domain.StatusGraduated, to: domain.StatusActive, reason: "mistake", effective: effective, wantErr: domain.ErrInvalidTransition},
+		{name: "refuses a dropout being suspended", from: domain.StatusDropout, to: domain.StatusSuspended, reason: "misconduct", effective: effective, wantErr: domain.ErrInvalidTransition},
+		{name: "refuses staying in the same status", from: domain.StatusActive, to: domain.StatusActive, reason: "none", effective: effective, wantErr: domain.ErrInvalidTransition},
+		{name: "refuses unknown statuses", from: domain.StatusActive, to: domain.Status("expelled"), reason: "misconduct", effective: effective, wantErr: domain.ErrInvalidStatus},
+		{name: "requires a reason", from: domain.StatusActive, to: domain.StatusSuspended, reason: "   ", effective: effective, wantErr: domain.ErrEmptyReason},
+		{name: "requires an effective date", from: domain.StatusActive, to: domain.StatusSuspended, reason: "unpaid fees", wantErr: domain.ErrEmptyEffectiveDate},
+		{name: "refuses dates before the enrollment", from: domain.StatusActive, to: domain.StatusSuspended, reason: "unpaid fees", effective: enrolled.AddDate(0, 0, -1), wantErr: domain.ErrEffectiveBeforeStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			student := domain.StudentFromPersistence(
+				valueobject.NewID(),
+				domain.StudentNumberFromPersistence("2024-CS-000001"),
+				valueobject.NewID(),
+				valueobject.ID{},
+				enrolled,
+				nil,
+				tt.from,
+				enrolled,
+				enrolled,
+			)
+			admin := valueobject.NewID()
+
+			change, err := student.ChangeStatus(tt.to, tt.reason, tt.effective, admin)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+				if student.Status != tt.from {
+					t.Errorf("status changed to %s on error", student.Status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if student.Status != tt.to {
+				t.Errorf("got status %s, want %s", student.Status, tt.to)
+			}
+			if change.FromStatus != tt.from || change.ToStatus != tt.to {
+				t.Errorf("got change %s -> %s, want %s -> %s", change.FromStatus, change.ToStatus, tt.from, tt.to)
+			}
+			if !change.ChangedBy.Equals(admin) || !change.StudentID.Equals(student.ID) {
+				t.Error("the change does not record the student and who made it")
+			}
+
+			day := time.Date(2025, time.July, 15, 0, 0, 0, 0, time.UTC)
+			if !change.EffectiveDate.Equal(day) {
+				t.Errorf("got effective date %s, want it truncated to %s", change.EffectiveDate, day)
+			}
+
+			graduated := student.GraduationDate != nil && student.GraduationDate.Equal(day)
+			if graduated != (tt.to == domain.StatusGraduated) {
+				t.Errorf("got graduation date %v for status %s", student.GraduationDate, tt.to)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+)
+
+var (
+	ErrEmptyStudentNumber      = errors.New("student number cannot be empty")
+	ErrEmptyEnrollmentDate     = errors.New("enrollment date cannot be empty")
+	ErrGraduationBeforeEnroll  = errors.New("graduation date cannot be before the enrollment date")
+	ErrInvalidStatus           = errors.New("invalid student status")
+	ErrStudentUserNotFound     = errors.New("user does not exist or is not a student")
+	ErrDegreeNotFound          = errors.New("degree does not exist")
+	ErrStudentProfileNotExists = errors.New("student profile does not exist")
+)
+
+type StudentRepository interface {
+	Create(ctx context.Context, s *Student) (err error)
+	Update(ctx context.Context, s *Student) (err error)
+	FindByID(ctx context.Context, id valueobject.ID) (*Student, error)
+	FindProfile(ctx context.Context, id valueobject.ID) (*Profile, error)
+	ListProfiles(ctx context.Context, filter ProfileFilter) ([]*Profile, error)
+	// SaveStatusChange stores the new status of the student together with its history entry
+	SaveStatusChange(ctx context.Context, s *Student, change StatusChange) (err error)
+	StatusHistory(ctx context.Context, id valueobject.ID) ([]StatusChange, error)
+}
+
+// StudentNumberGenerator hands out institutional student numbers. Implementations
+// must never return the same number twice, even under concurrent callers.
+type StudentNumberGenerator interface {
+	Next(ctx context.Context, degreeID valueobject.ID, year int) (StudentNumber, error)
+}
+
+// Student is the academic profile attached to every user of type student.
+// It shares its ID with the user it belongs to.
+type Student struct {
+	ID            valueobject.ID `json:"id"`
+	StudentNumber StudentNumber  `json:"student_number"`
+	DegreeID      valueobject.ID `json:"degree_id"`
+	// CurriculumID is the plan version the student follows, it's kept when
+	// new versions of the degree curriculum are published
+	CurriculumID   valueobject.ID `json:"curriculum_id"`
+	EnrollmentDate time.Time      `json:"enrollment_date"`
+	GraduationDate *time.Time     `json:"graduation_date,omitempty"`
+	Status         Status         `json:"current_status"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// Profile is a read model that joins the student with its user and degree data
+type Profile struct {
+	*Student
+	Email      string `json:"email"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	DegreeCode string `json:"degree_code"`
+	DegreeName string `json:"degree_name"`
+	// CurriculumVersion is zero when the degree had no published curriculum
+	CurriculumVersion int `json:"curriculum_version"`
+}
+
+// ProfileFilter narrows the list of profiles. Zero values are ignored.
+type ProfileFilter struct {
+	Status   Status
+	DegreeID valueobject.ID
+	Limit    int
+	Offset   int
+}
+
+// NewStudent creates a new active Student with validation
+func NewStudent(
+	userID valueobject.ID,
+	number StudentNumber,
+	degreeID valueobject.ID,
+	enrollmentDate time.Time,
+) (*Student, error) {
+	if err := userID.Validate(); err != nil {
+		return nil, err
+	}
+
+	if number.IsEmpty() {
+		return nil, ErrEmptyStudentNumber
+	}
+
+	if err := degreeID.Validate(); err != nil {
+		return nil, err
+	}
+
+	if enrollmentDate.IsZero() {
+		return nil, ErrEmptyEnrollmentDate
+	}
+
+	now := time.Now()
+
+	student := &Student{
+		ID:             userID,
+		StudentNumber:  number,
+		DegreeID:       degreeID,
+		EnrollmentDate: truncateToDate(enrollmentDate),
+		Status:         StatusActive,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	return student, nil
+}
+
+// StudentFromPersistence creates a Student instance from database records
+// This method assumes data from database is already validated and doesn't perform additional validation
+func StudentFromPersistence(
+	id valueobject.ID,
+	number StudentNumber,
+	degreeID valueobject.ID,
+	curriculumID valueobject.ID,
+	enrollmentDate time.Time,
+	graduationDate *time.Time,
+	status Status,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Student {
+	return &Student{
+		ID:             id,
+		StudentNumber:  number,
+		DegreeID:       degreeID,
+		CurriculumID:   curriculumID,
+		EnrollmentDate: enrollmentDate,
+		GraduationDate: graduationDate,
+		Status:         status,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+// Business Logic Methods
+
+// ChangeDegree moves the student to another degree program, the plan of the
+// new degree is assigned when the change is stored
+func (s *Student) ChangeDegree(degreeID valueobject.ID) error {
+	if err := degreeID.Validate(); err != nil {
+		return err
+	}
+
+	if !s.DegreeID.Equals(degreeID) {
+		s.CurriculumID = valueobject.ID{}
+	}
+	s.DegreeID = degreeID
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// UpdateDates corrects the enrollment and expected graduation dates
+func (s *Student) UpdateDates(enrollmentDate time.Time, graduationDate *time.Time) error {
+	if enrollmentDate.IsZero() {
+		return ErrEmptyEnrollmentDate
+	}
+
+	enrollmentDate = truncateToDate(enrollmentDate)
+	if graduationDate != nil {
+		date := truncateToDate(*graduationDate)
+		if date.Before(enrollmentDate) {
+			return ErrGraduationBeforeEnroll
+		}
+		graduationDate = &date
+	}
+
+	s.EnrollmentDate = enrollmentDate
+	s.GraduationDate = graduationDate
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// IsActive checks if the student is currently active
+func (s *Student) IsActive() bool {
+	return s.Status == StatusActive
+}
+
+// Private helper functions
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
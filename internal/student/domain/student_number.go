@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrInvalidStudentNumber = errors.New("invalid student number")
+	ErrInvalidDepartment    = errors.New("department code cannot be empty")
+	ErrInvalidSequence      = errors.New("student number sequence must be positive")
+)
+
+// StudentNumber is the institutional identifier printed on student cards.
+// It is composed of the enrollment year, the department code of the degree
+// and a database sequence, e.g. 2024CS000042.
+type StudentNumber struct {
+	value string
+}
+
+var studentNumberRegex = regexp.MustCompile(`^[0-9]{4}[A-Z]{1,20}[0-9]{6,}$`)
+
+// NewStudentNumber builds a StudentNumber from its parts
+func NewStudentNumber(year int, departmentCode string, sequence int64) (StudentNumber, error) {
+	if year < 1000 || year > 9999 {
+		return StudentNumber{}, fmt.Errorf("%w: year %d out of range", ErrInvalidStudentNumber, year)
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(departmentCode))
+	if code == "" {
+		return StudentNumber{}, ErrInvalidDepartment
+	}
+
+	if sequence <= 0 {
+		return StudentNumber{}, ErrInvalidSequence
+	}
+
+	return ParseStudentNumber(fmt.Sprintf("%04d%s%06d", year, code, sequence))
+}
+
+// ParseStudentNumber validates an existing student number
+func ParseStudentNumber(s string) (StudentNumber, error) {
+	if !studentNumberRegex.MatchString(s) {
+		return StudentNumber{}, fmt.Errorf("%w: %q", ErrInvalidStudentNumber, s)
+	}
+	return StudentNumber{value: s}, nil
+}
+
+func (n StudentNumber) String() string { return n.value }
+
+func (n StudentNumber) IsEmpty() bool { return n.value == "" }
+
+func (n StudentNumber) MarshalJSON() ([]byte, error) {
+	if n.IsEmpty() {
+		return []byte(`null`), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// StudentNumberFromPersistence wraps a stored student number without validation,
+// legacy imports may not follow the current format
+func StudentNumberFromPersistence(s string) StudentNumber {
+	return StudentNumber{value: s}
+}
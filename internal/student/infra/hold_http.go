@@ -0,0 +1,61 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/application"
+	"github.com/gofiber/fiber/v3"
+)
+
+type holdHandler struct {
+	interactor application.HoldInteractor
+}
+
+func NewHoldHandler(uc application.HoldInteractor) *holdHandler {
+	return &holdHandler{uc}
+}
+
+func (h holdHandler) PlaceHold(c fiber.Ctx) error {
+	var req application.PlaceHoldInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Place(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h holdHandler) ReleaseHold(c fiber.Ctx) error {
+	data, err := h.interactor.Release(c.Context(), httpx.Actor(c), c.Params("id"), c.Params("holdId"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h holdHandler) ListHolds(c fiber.Ctx) error {
+	actor := httpx.Actor(c)
+	if actor.IsStudent() && actor.ID.String() != c.Params("id") {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "students can only view their own holds"})
+	}
+
+	var req application.ListHoldsInput
+
+	if err := c.Bind().Query(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.List(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
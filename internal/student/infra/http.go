@@ -0,0 +1,76 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/application"
+	"github.com/gofiber/fiber/v3"
+)
+
+type studentHandler struct {
+	interactor application.StudentInteractor
+}
+
+func NewStudentHandler(uc application.StudentInteractor) *studentHandler {
+	return &studentHandler{uc}
+}
+
+func (h studentHandler) CreateStudent(c fiber.Ctx) error {
+	var req application.CreateStudentInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Create(c.Context(), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h studentHandler) GetStudent(c fiber.Ctx) error {
+	actor := httpx.Actor(c)
+	if actor.IsStudent() && actor.ID.String() != c.Params("id") {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "students can only view their own profile"})
+	}
+
+	data, err := h.interactor.Get(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h studentHandler) ListStudents(c fiber.Ctx) error {
+	var req application.ListStudentsInput
+
+	if err := c.Bind().Query(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.List(c.Context(), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
+
+func (h studentHandler) UpdateStudent(c fiber.Ctx) error {
+	var req application.UpdateStudentInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.Update(c.Context(), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
@@ -0,0 +1,192 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+const holdColumns = `
+	h.id,
+	h.student_id,
+	h.hold_type,
+	h.reason,
+	h.blocks_enrollment,
+	h.placed_by,
+	COALESCE(u.first_name || ' ' || u.last_name, ''),
+	h.expires_at,
+	h.released_at,
+	h.released_by,
+	h.created_at,
+	h.updated_at
+`
+
+const holdJoins = `
+	FROM student_holds h
+	LEFT JOIN users u ON u.id = h.placed_by
+`
+
+type postgresHoldRepository struct {
+	pool *sql.DB
+}
+
+func NewHoldRepository(db *sql.DB) *postgresHoldRepository {
+	return &postgresHoldRepository{db}
+}
+
+func (r postgresHoldRepository) Place(ctx context.Context, h *domain.Hold) error {
+	query := `
+		INSERT INTO student_holds (
+			id,
+			student_id,
+			hold_type,
+			reason,
+			blocks_enrollment,
+			placed_by,
+			expires_at,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	if _, err := r.pool.ExecContext(ctx, query,
+		h.ID.String(),
+		h.StudentID.String(),
+		string(h.Type),
+		h.Reason,
+		h.BlocksEnrollment,
+		nullableID(h.PlacedBy),
+		h.ExpiresAt,
+		h.CreatedAt,
+		h.UpdatedAt,
+	); err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r postgresHoldRepository) Release(ctx context.Context, h *domain.Hold) error {
+	// a concurrent release leaves nothing to update
+	query := `
+		UPDATE student_holds SET
+			released_at = $2,
+			released_by = $3,
+			updated_at = $4
+		WHERE id = $1 AND released_at IS NULL
+	`
+	result, err := r.pool.ExecContext(ctx, query,
+		h.ID.String(),
+		h.ReleasedAt,
+		nullableID(*h.ReleasedBy),
+		h.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrHoldAlreadyClosed
+	}
+	return nil
+}
+
+func (r postgresHoldRepository) FindByID(ctx context.Context, studentID, id valueobject.ID) (*domain.Hold, error) {
+	query := `SELECT ` + holdColumns + holdJoins + ` WHERE h.id = $1 AND h.student_id = $2`
+
+	hold, err := scanHold(r.pool.QueryRowContext(ctx, query, id.String(), studentID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrHoldNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &hold, nil
+}
+
+func (r postgresHoldRepository) ListByStudent(ctx context.Context, studentID valueobject.ID, now time.Time, all bool) ([]domain.Hold, error) {
+	query := `
+		SELECT ` + holdColumns + holdJoins + `
+		WHERE h.student_id = $1
+		  AND ($3 OR (h.released_at IS NULL AND (h.expires_at IS NULL OR h.expires_at > $2)))
+		ORDER BY h.created_at DESC
+	`
+	rows, err := r.pool.QueryContext(ctx, query, studentID.String(), now, all)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holds []domain.Hold
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, rows.Err()
+}
+
+func scanHold(row scanner) (domain.Hold, error) {
+	var (
+		id, studentID         valueobject.ID
+		placedBy, releasedBy  valueobject.ID
+		holdType, reason      string
+		placedByName          string
+		blocksEnrollment      bool
+		expiresAt, releasedAt sql.NullTime
+		createdAt, updatedAt  time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&studentID,
+		&holdType,
+		&reason,
+		&blocksEnrollment,
+		&placedBy,
+		&placedByName,
+		&expiresAt,
+		&releasedAt,
+		&releasedBy,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return domain.Hold{}, err
+	}
+
+	var released *valueobject.ID
+	if !releasedBy.IsEmpty() {
+		released = &releasedBy
+	}
+
+	return domain.HoldFromPersistence(
+		id,
+		studentID,
+		domain.HoldType(holdType),
+		reason,
+		blocksEnrollment,
+		placedBy,
+		placedByName,
+		nullableTime(expiresAt),
+		nullableTime(releasedAt),
+		released,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+func nullableTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+// postgresStudentNumberGenerator relies on a Postgres sequence, nextval is
+// never rolled back so two callers can't receive the same suffix.
+type postgresStudentNumberGenerator struct {
+	pool *sql.DB
+}
+
+func NewStudentNumberGenerator(db *sql.DB) *postgresStudentNumberGenerator {
+	return &postgresStudentNumberGenerator{db}
+}
+
+func (g postgresStudentNumberGenerator) Next(ctx context.Context, degreeID valueobject.ID, year int) (domain.StudentNumber, error) {
+	query := `
+		SELECT dep.code, nextval('student_number_seq')
+		FROM degrees d
+		JOIN departments dep ON dep.id = d.department_id
+		WHERE d.id = $1
+	`
+	var (
+		departmentCode string
+		sequence       int64
+	)
+
+	err := db.Conn(ctx, g.pool).QueryRowContext(ctx, query, degreeID.String()).Scan(&departmentCode, &sequence)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.StudentNumber{}, domain.ErrDegreeNotFound
+	}
+	if err != nil {
+		return domain.StudentNumber{}, err
+	}
+
+	return domain.NewStudentNumber(year, departmentCode, sequence)
+}
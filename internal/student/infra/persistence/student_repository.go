@@ -0,0 +1,367 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/db"
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/valueobject"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/domain"
+)
+
+const profileColumns = `
+	s.id,
+	COALESCE(s.student_id, ''),
+	s.degree_id,
+	s.curriculum_id,
+	COALESCE(cu.version, 0),
+	s.enrollment_date,
+	s.graduation_date,
+	s.current_status,
+	COALESCE(s.created_at, NOW()),
+	COALESCE(s.updated_at, NOW()),
+	u.email,
+	u.first_name,
+	u.last_name,
+	COALESCE(d.code, ''),
+	COALESCE(d.name, '')
+`
+
+const profileJoins = `
+	FROM students s
+	JOIN users u ON u.id = s.id
+	LEFT JOIN degrees d ON d.id = s.degree_id
+	LEFT JOIN curricula cu ON cu.id = s.curriculum_id
+`
+
+// latestCurriculum selects the latest published curriculum of the degree bound to param
+func latestCurriculum(param string) string {
+	return `
+		SELECT cu.id FROM curricula cu
+		WHERE cu.degree_id = ` + param + `::uuid AND cu.status = 'published'
+		ORDER BY cu.version DESC
+		LIMIT 1
+	`
+}
+
+type postgresStudentRepository struct {
+	pool *sql.DB
+}
+
+func NewStudentRepository(db *sql.DB) *postgresStudentRepository {
+	return &postgresStudentRepository{db}
+}
+
+func (r postgresStudentRepository) Create(ctx context.Context, s *domain.Student) error {
+	// the profile is only created when the user exists and is a student,
+	// it starts on the latest published curriculum of the degree
+	query := `
+		INSERT INTO students (
+			id,
+			student_id,
+			degree_id,
+			curriculum_id,
+			enrollment_date,
+			graduation_date,
+			current_status,
+			created_at,
+			updated_at
+		)
+		SELECT $1, $2, $3, (` + latestCurriculum("$3") + `), $4, $5, $6, $7, $8
+		FROM users
+		WHERE id = $1 AND user_type = 'student'
+	`
+	result, err := db.Conn(ctx, r.pool).ExecContext(ctx, query,
+		s.ID.String(),
+		s.StudentNumber.String(),
+		s.DegreeID.String(),
+		s.EnrollmentDate,
+		s.GraduationDate,
+		s.Status.String(),
+		s.CreatedAt,
+		s.UpdatedAt,
+	)
+	if err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrStudentUserNotFound
+	}
+	return nil
+}
+
+// Update stores the profile data, the status is only changed through SaveStatusChange
+func (r postgresStudentRepository) Update(ctx context.Context, s *domain.Student) error {
+	// students moving to another degree start on its latest published curriculum
+	query := `
+		UPDATE students SET
+			curriculum_id = CASE
+				WHEN degree_id IS DISTINCT FROM $2::uuid THEN (` + latestCurriculum("$2") + `)
+				ELSE curriculum_id
+			END,
+			degree_id = $2,
+			enrollment_date = $3,
+			graduation_date = $4,
+			updated_at = $5
+		WHERE id = $1
+	`
+	result, err := r.pool.ExecContext(ctx, query,
+		s.ID.String(),
+		nullableID(s.DegreeID),
+		s.EnrollmentDate,
+		s.GraduationDate,
+		s.UpdatedAt,
+	)
+	if err != nil {
+		if ok, pgerr := db.IsPgError(err); ok {
+			return db.ExchangePGError(pgerr)
+		}
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return domain.ErrStudentProfileNotExists
+	}
+	return nil
+}
+
+func (r postgresStudentRepository) FindByID(ctx context.Context, id valueobject.ID) (*domain.Student, error) {
+	profile, err := r.FindProfile(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return profile.Student, nil
+}
+
+func (r postgresStudentRepository) FindProfile(ctx context.Context, id valueobject.ID) (*domain.Profile, error) {
+	query := `SELECT ` + profileColumns + profileJoins + ` WHERE s.id = $1`
+
+	profile, err := scanProfile(r.pool.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrStudentProfileNotExists
+	}
+	return profile, err
+}
+
+func (r postgresStudentRepository) ListProfiles(ctx context.Context, filter domain.ProfileFilter) ([]*domain.Profile, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	if filter.Status != "" {
+		args = append(args, filter.Status.String())
+		conditions = append(conditions, fmt.Sprintf("s.current_status = $%d", len(args)))
+	}
+
+	if !filter.DegreeID.IsEmpty() {
+		args = append(args, filter.DegreeID.String())
+		conditions = append(conditions, fmt.Sprintf("s.degree_id = $%d", len(args)))
+	}
+
+	query := `SELECT ` + profileColumns + profileJoins
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit, filter.Offset)
+	query += fmt.Sprintf(` ORDER BY u.last_name, u.first_name LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	rows, err := r.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*domain.Profile
+	for rows.Next() {
+		profile, err := scanProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, rows.Err()
+}
+
+// SaveStatusChange joins the transaction of the caller, if any, so the side
+// effects of the change are stored or rolled back with it
+func (r postgresStudentRepository) SaveStatusChange(ctx context.Context, s *domain.Student, change domain.StatusChange) error {
+	return db.NewTransactor(r.pool).WithinTx(ctx, func(ctx context.Context) error {
+		conn := db.Conn(ctx, r.pool)
+
+		// the current status is part of the condition so two concurrent
+		// transitions from the same status can't both succeed
+		update := `
+			UPDATE students SET
+				current_status = $2,
+				graduation_date = $3,
+				updated_at = $4
+			WHERE id = $1 AND current_status = $5
+		`
+		result, err := conn.ExecContext(ctx, update,
+			s.ID.String(),
+			change.ToStatus.String(),
+			s.GraduationDate,
+			s.UpdatedAt,
+			change.FromStatus.String(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return fmt.Errorf("%w: status changed concurrently", domain.ErrInvalidTransition)
+		}
+
+		insert := `
+			INSERT INTO student_status_history (
+				id,
+				student_id,
+				from_status,
+				to_status,
+				reason,
+				effective_date,
+				changed_by,
+				account_deactivated,
+				created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`
+		if _, err := conn.ExecContext(ctx, insert,
+			change.ID.String(),
+			change.StudentID.String(),
+			change.FromStatus.String(),
+			change.ToStatus.String(),
+			change.Reason,
+			change.EffectiveDate,
+			nullableID(change.ChangedBy),
+			change.AccountDeactivated,
+			change.CreatedAt,
+		); err != nil {
+			if ok, pgerr := db.IsPgError(err); ok {
+				return db.ExchangePGError(pgerr)
+			}
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r postgresStudentRepository) StatusHistory(ctx context.Context, id valueobject.ID) ([]domain.StatusChange, error) {
+	query := `
+		SELECT id, student_id, from_status, to_status, reason, effective_date, changed_by, account_deactivated, created_at
+		FROM student_status_history
+		WHERE student_id = $1
+		ORDER BY created_at
+	`
+	rows, err := db.Conn(ctx, r.pool).QueryContext(ctx, query, id.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []domain.StatusChange
+	for rows.Next() {
+		var (
+			changeID, studentID, changedBy valueobject.ID
+			from, to, reason               string
+			accountDeactivated             bool
+			effectiveDate, createdAt       time.Time
+		)
+		if err := rows.Scan(&changeID, &studentID, &from, &to, &reason, &effectiveDate, &changedBy, &accountDeactivated, &createdAt); err != nil {
+			return nil, err
+		}
+		history = append(history, domain.StatusChangeFromPersistence(
+			changeID,
+			studentID,
+			domain.Status(from),
+			domain.Status(to),
+			reason,
+			effectiveDate,
+			changedBy,
+			accountDeactivated,
+			createdAt,
+		))
+	}
+
+	return history, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanProfile(row scanner) (*domain.Profile, error) {
+	var (
+		id             valueobject.ID
+		number         string
+		degreeID       valueobject.ID
+		curriculumID   valueobject.ID
+		enrollmentDate time.Time
+		graduationDate sql.NullTime
+		status         string
+		createdAt      time.Time
+		updatedAt      time.Time
+		profile        domain.Profile
+	)
+
+	if err := row.Scan(
+		&id,
+		&number,
+		&degreeID,
+		&curriculumID,
+		&profile.CurriculumVersion,
+		&enrollmentDate,
+		&graduationDate,
+		&status,
+		&createdAt,
+		&updatedAt,
+		&profile.Email,
+		&profile.FirstName,
+		&profile.LastName,
+		&profile.DegreeCode,
+		&profile.DegreeName,
+	); err != nil {
+		return nil, err
+	}
+
+	var graduation *time.Time
+	if graduationDate.Valid {
+		graduation = &graduationDate.Time
+	}
+
+	profile.Student = domain.StudentFromPersistence(
+		id,
+		domain.StudentNumberFromPersistence(number),
+		degreeID,
+		curriculumID,
+		enrollmentDate,
+		graduation,
+		domain.Status(status),
+		createdAt,
+		updatedAt,
+	)
+
+	return &profile, nil
+}
+
+func nullableID(id valueobject.ID) any {
+	if id.IsEmpty() {
+		return nil
+	}
+	return id.String()
+}
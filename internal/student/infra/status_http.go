@@ -0,0 +1,46 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/Jose-Salazar-27/go-university-server/internal/shared/kernel/infra/httpx"
+	"github.com/Jose-Salazar-27/go-university-server/internal/student/application"
+	"github.com/gofiber/fiber/v3"
+)
+
+type statusHandler struct {
+	interactor application.StatusInteractor
+}
+
+func NewStatusHandler(uc application.StatusInteractor) *statusHandler {
+	return &statusHandler{uc}
+}
+
+func (h statusHandler) ChangeStatus(c fiber.Ctx) error {
+	var req application.ChangeStatusInput
+
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	data, err := h.interactor.ChangeStatus(c.Context(), httpx.Actor(c), c.Params("id"), req)
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(data)
+}
+
+func (h statusHandler) GetHistory(c fiber.Ctx) error {
+	actor := httpx.Actor(c)
+	if actor.IsStudent() && actor.ID.String() != c.Params("id") {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "students can only view their own history"})
+	}
+
+	data, err := h.interactor.History(c.Context(), c.Params("id"))
+	if err != nil {
+		return httpx.ErrorResponse(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(data)
+}
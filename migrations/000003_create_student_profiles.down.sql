@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_students_current_status;
+DROP INDEX IF EXISTS idx_students_degree_id;
+
+ALTER TABLE students DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE students DROP COLUMN IF EXISTS created_at;
+ALTER TABLE students DROP COLUMN IF EXISTS degree_id;
+
+DROP SEQUENCE IF EXISTS student_number_seq;
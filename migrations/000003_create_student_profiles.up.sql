@@ -0,0 +1,12 @@
+-- Student numbers are built as <enrollment year><department code><sequence>.
+-- A single sequence keeps the numeric suffix unique across every prefix.
+CREATE SEQUENCE IF NOT EXISTS student_number_seq START WITH 1 INCREMENT BY 1;
+
+-- Degree the student is enrolled in; the department code of the degree is
+-- part of the institutional student number.
+ALTER TABLE students ADD COLUMN IF NOT EXISTS degree_id UUID REFERENCES degrees(id);
+ALTER TABLE students ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ DEFAULT NOW();
+ALTER TABLE students ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_students_degree_id ON students(degree_id);
+CREATE INDEX IF NOT EXISTS idx_students_current_status ON students(current_status);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_student_status_history_student;
+DROP TABLE IF EXISTS student_status_history CASCADE;
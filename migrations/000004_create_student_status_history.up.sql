@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS student_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    student_id UUID NOT NULL REFERENCES students(id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL
+        CHECK (from_status IN ('active', 'graduated', 'suspended', 'dropout')),
+    to_status VARCHAR(20) NOT NULL
+        CHECK (to_status IN ('active', 'graduated', 'suspended', 'dropout')),
+    reason TEXT NOT NULL,
+    effective_date DATE NOT NULL,
+    changed_by UUID REFERENCES users(id),
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_status_history_student ON student_status_history(student_id, created_at);
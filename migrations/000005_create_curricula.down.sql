@@ -0,0 +1,23 @@
+DROP INDEX IF EXISTS idx_students_curriculum_id;
+DROP INDEX IF EXISTS idx_curricula_degree_id;
+
+ALTER TABLE students DROP COLUMN IF EXISTS curriculum_id;
+
+-- Only the latest version of each plan fits the original primary keys
+DELETE FROM degree_electives de
+USING curricula c
+WHERE c.id = de.curriculum_id
+  AND c.version < (SELECT MAX(version) FROM curricula WHERE degree_id = c.degree_id);
+ALTER TABLE degree_electives DROP CONSTRAINT IF EXISTS degree_electives_pkey;
+ALTER TABLE degree_electives DROP COLUMN IF EXISTS curriculum_id;
+ALTER TABLE degree_electives ADD PRIMARY KEY (degree_id, elective_group, course_id);
+
+DELETE FROM degree_courses dc
+USING curricula c
+WHERE c.id = dc.curriculum_id
+  AND c.version < (SELECT MAX(version) FROM curricula WHERE degree_id = c.degree_id);
+ALTER TABLE degree_courses DROP CONSTRAINT IF EXISTS degree_courses_pkey;
+ALTER TABLE degree_courses DROP COLUMN IF EXISTS curriculum_id;
+ALTER TABLE degree_courses ADD PRIMARY KEY (degree_id, course_id);
+
+DROP TABLE IF EXISTS curricula CASCADE;
@@ -0,0 +1,49 @@
+-- Versioned curriculum plans. Published versions are immutable so students
+-- stay on the plan they started with.
+CREATE TABLE IF NOT EXISTS curricula (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    degree_id UUID NOT NULL REFERENCES degrees(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL CHECK (version > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published')),
+    elective_credits INTEGER NOT NULL DEFAULT 0 CHECK (elective_credits >= 0),
+    notes TEXT,
+    published_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE(degree_id, version)
+);
+
+-- Existing plans become version 1 of their degree
+INSERT INTO curricula (degree_id, version, status, published_at)
+SELECT degree_id, 1, 'published', NOW()
+FROM (
+    SELECT degree_id FROM degree_courses
+    UNION
+    SELECT degree_id FROM degree_electives
+) plans
+WHERE degree_id IS NOT NULL
+ON CONFLICT (degree_id, version) DO NOTHING;
+
+ALTER TABLE degree_courses ADD COLUMN IF NOT EXISTS curriculum_id UUID REFERENCES curricula(id) ON DELETE CASCADE;
+UPDATE degree_courses dc SET curriculum_id = c.id
+FROM curricula c
+WHERE c.degree_id = dc.degree_id AND c.version = 1;
+DELETE FROM degree_courses WHERE curriculum_id IS NULL;
+ALTER TABLE degree_courses DROP CONSTRAINT IF EXISTS degree_courses_pkey;
+ALTER TABLE degree_courses ALTER COLUMN curriculum_id SET NOT NULL;
+ALTER TABLE degree_courses ADD PRIMARY KEY (curriculum_id, course_id);
+
+ALTER TABLE degree_electives ADD COLUMN IF NOT EXISTS curriculum_id UUID REFERENCES curricula(id) ON DELETE CASCADE;
+UPDATE degree_electives de SET curriculum_id = c.id
+FROM curricula c
+WHERE c.degree_id = de.degree_id AND c.version = 1;
+DELETE FROM degree_electives WHERE curriculum_id IS NULL;
+ALTER TABLE degree_electives DROP CONSTRAINT IF EXISTS degree_electives_pkey;
+ALTER TABLE degree_electives ALTER COLUMN curriculum_id SET NOT NULL;
+ALTER TABLE degree_electives ADD PRIMARY KEY (curriculum_id, elective_group, course_id);
+
+-- Plan each student follows, assigned when the profile is created
+ALTER TABLE students ADD COLUMN IF NOT EXISTS curriculum_id UUID REFERENCES curricula(id);
+
+CREATE INDEX IF NOT EXISTS idx_curricula_degree_id ON curricula(degree_id);
+CREATE INDEX IF NOT EXISTS idx_students_curriculum_id ON students(curriculum_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_course_prerequisites_prerequisite;
+
+ALTER TABLE course_prerequisites DROP CONSTRAINT IF EXISTS chk_course_prerequisites_not_self;
+ALTER TABLE course_prerequisites DROP CONSTRAINT IF EXISTS chk_course_prerequisites_minimum_grade;
+UPDATE course_prerequisites SET minimum_grade = 99.99 WHERE minimum_grade > 99.99;
+ALTER TABLE course_prerequisites ALTER COLUMN minimum_grade TYPE DECIMAL(4,2);
@@ -0,0 +1,9 @@
+-- DECIMAL(4,2) can't store a minimum grade of 100
+ALTER TABLE course_prerequisites ALTER COLUMN minimum_grade TYPE DECIMAL(5,2);
+ALTER TABLE course_prerequisites ADD CONSTRAINT chk_course_prerequisites_minimum_grade
+    CHECK (minimum_grade BETWEEN 0 AND 100);
+ALTER TABLE course_prerequisites ADD CONSTRAINT chk_course_prerequisites_not_self
+    CHECK (course_id <> prerequisite_id);
+
+-- Reverse lookups: which courses depend on a given one
+CREATE INDEX IF NOT EXISTS idx_course_prerequisites_prerequisite ON course_prerequisites(prerequisite_id);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_academic_periods_status;
+DROP INDEX IF EXISTS uq_academic_periods_single_active;
+
+ALTER TABLE academic_periods DROP CONSTRAINT IF EXISTS chk_academic_periods_dates;
+ALTER TABLE academic_periods DROP CONSTRAINT IF EXISTS chk_academic_periods_active_status;
+ALTER TABLE academic_periods ALTER COLUMN is_active DROP NOT NULL;
+ALTER TABLE academic_periods ALTER COLUMN registration_end DROP NOT NULL;
+ALTER TABLE academic_periods ALTER COLUMN registration_start DROP NOT NULL;
+
+ALTER TABLE academic_periods DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE academic_periods DROP COLUMN IF EXISTS status;
@@ -0,0 +1,48 @@
+-- Lifecycle of academic periods: planned -> registration_open -> in_progress -> closed
+ALTER TABLE academic_periods ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'planned'
+    CHECK (status IN ('planned', 'registration_open', 'in_progress', 'closed'));
+ALTER TABLE academic_periods ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+UPDATE academic_periods SET
+    registration_start = COALESCE(registration_start, start_date),
+    registration_end = COALESCE(registration_end, start_date);
+
+ALTER TABLE academic_periods ALTER COLUMN registration_start SET NOT NULL;
+ALTER TABLE academic_periods ALTER COLUMN registration_end SET NOT NULL;
+
+UPDATE academic_periods SET status = CASE
+    WHEN end_date < CURRENT_DATE THEN 'closed'
+    WHEN start_date <= CURRENT_DATE THEN 'in_progress'
+    WHEN registration_start <= CURRENT_DATE THEN 'registration_open'
+    ELSE 'planned'
+END;
+
+-- Overlapping legacy periods: keep the latest one in progress, or else the
+-- first one with open registration, and move the others out of the way
+WITH chosen AS (
+    SELECT COALESCE(
+        (SELECT id FROM academic_periods WHERE status = 'in_progress' ORDER BY start_date DESC LIMIT 1),
+        (SELECT id FROM academic_periods WHERE status = 'registration_open' ORDER BY start_date LIMIT 1)
+    ) AS id
+)
+UPDATE academic_periods SET status = CASE status WHEN 'in_progress' THEN 'closed' ELSE 'planned' END
+WHERE status IN ('registration_open', 'in_progress')
+  AND id IS DISTINCT FROM (SELECT id FROM chosen);
+
+UPDATE academic_periods SET is_active = status IN ('registration_open', 'in_progress');
+
+ALTER TABLE academic_periods ALTER COLUMN is_active SET NOT NULL;
+ALTER TABLE academic_periods ADD CONSTRAINT chk_academic_periods_active_status
+    CHECK (is_active = (status IN ('registration_open', 'in_progress')));
+
+-- Legacy rows may break the ordering, they are kept but new writes are checked
+ALTER TABLE academic_periods ADD CONSTRAINT chk_academic_periods_dates
+    CHECK (end_date > start_date
+       AND registration_end >= registration_start
+       AND registration_start <= start_date
+       AND registration_end <= end_date) NOT VALID;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_academic_periods_single_active
+    ON academic_periods ((true)) WHERE is_active;
+
+CREATE INDEX IF NOT EXISTS idx_academic_periods_status ON academic_periods(status, start_date);
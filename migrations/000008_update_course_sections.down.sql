@@ -0,0 +1,4 @@
+ALTER TABLE course_sections DROP CONSTRAINT IF EXISTS chk_course_sections_capacity;
+
+DROP INDEX IF EXISTS idx_course_sections_professor;
+DROP INDEX IF EXISTS idx_course_sections_period;
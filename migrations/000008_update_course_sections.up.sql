@@ -0,0 +1,5 @@
+-- Schedule conflict checks scan the sections of a period
+CREATE INDEX IF NOT EXISTS idx_course_sections_period ON course_sections(academic_period_id);
+CREATE INDEX IF NOT EXISTS idx_course_sections_professor ON course_sections(professor_id, academic_period_id);
+
+ALTER TABLE course_sections ADD CONSTRAINT chk_course_sections_capacity CHECK (capacity > 0) NOT VALID;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_enrollments_section_status;
+
+ALTER TABLE enrollments DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE enrollments DROP COLUMN IF EXISTS created_at;
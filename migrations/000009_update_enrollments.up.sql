@@ -0,0 +1,5 @@
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ DEFAULT NOW();
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+-- Seat counting filters the enrollments of a section by status
+CREATE INDEX IF NOT EXISTS idx_enrollments_section_status ON enrollments(course_section_id, status);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS section_waitlist (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_section_id UUID NOT NULL REFERENCES course_sections(id) ON DELETE CASCADE,
+    student_id UUID NOT NULL REFERENCES students(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting'
+        CHECK (status IN ('waiting', 'offered', 'accepted', 'declined', 'expired', 'cancelled')),
+    offered_at TIMESTAMPTZ,
+    offer_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (status <> 'offered' OR offer_expires_at IS NOT NULL)
+);
+
+-- A student holds at most one place in the waitlist of a section
+CREATE UNIQUE INDEX IF NOT EXISTS uq_section_waitlist_active
+    ON section_waitlist(course_section_id, student_id) WHERE status IN ('waiting', 'offered');
+
+CREATE INDEX IF NOT EXISTS idx_section_waitlist_queue ON section_waitlist(course_section_id, status, created_at);
+CREATE INDEX IF NOT EXISTS idx_section_waitlist_offers ON section_waitlist(offer_expires_at) WHERE status = 'offered';
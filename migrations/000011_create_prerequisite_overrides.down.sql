@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_enrollments_student_status;
+DROP TABLE IF EXISTS prerequisite_overrides;
+
+ALTER TABLE course_prerequisites DROP COLUMN IF EXISTS is_corequisite;
@@ -0,0 +1,18 @@
+-- A corequisite can be taken in the same period instead of being passed first
+ALTER TABLE course_prerequisites ADD COLUMN IF NOT EXISTS is_corequisite BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS prerequisite_overrides (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    student_id UUID NOT NULL REFERENCES students(id) ON DELETE CASCADE,
+    granted_by UUID NOT NULL REFERENCES users(id),
+    reason TEXT NOT NULL CHECK (length(trim(reason)) > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (course_id, student_id)
+);
+
+-- Enrollment checks look up the overrides of a student
+CREATE INDEX IF NOT EXISTS idx_prerequisite_overrides_student ON prerequisite_overrides(student_id);
+
+-- Requirement checks find the completed enrollments of a student by course
+CREATE INDEX IF NOT EXISTS idx_enrollments_student_status ON enrollments(student_id, status);
@@ -0,0 +1 @@
+ALTER TABLE enrollments DROP COLUMN IF EXISTS clash_override_by;
@@ -0,0 +1,2 @@
+-- Admin that allowed the enrollment to clash with the student schedule
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS clash_override_by UUID REFERENCES users(id);
@@ -0,0 +1,7 @@
+ALTER TABLE degrees DROP CONSTRAINT IF EXISTS chk_degrees_good_standing_average;
+ALTER TABLE degrees DROP CONSTRAINT IF EXISTS chk_degrees_term_credits;
+
+ALTER TABLE degrees DROP COLUMN IF EXISTS good_standing_average;
+ALTER TABLE degrees DROP COLUMN IF EXISTS good_standing_max_credits;
+ALTER TABLE degrees DROP COLUMN IF EXISTS max_term_credits;
+ALTER TABLE degrees DROP COLUMN IF EXISTS min_term_credits;
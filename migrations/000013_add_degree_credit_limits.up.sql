@@ -0,0 +1,15 @@
+-- Credits a student can take per academic period. Students whose average
+-- final grade reaches good_standing_average may take up to
+-- good_standing_max_credits instead of max_term_credits.
+ALTER TABLE degrees ADD COLUMN IF NOT EXISTS min_term_credits INTEGER NOT NULL DEFAULT 12;
+ALTER TABLE degrees ADD COLUMN IF NOT EXISTS max_term_credits INTEGER NOT NULL DEFAULT 18;
+ALTER TABLE degrees ADD COLUMN IF NOT EXISTS good_standing_max_credits INTEGER NOT NULL DEFAULT 21;
+ALTER TABLE degrees ADD COLUMN IF NOT EXISTS good_standing_average DECIMAL(5,2) NOT NULL DEFAULT 80;
+
+ALTER TABLE degrees ADD CONSTRAINT chk_degrees_term_credits
+    CHECK (min_term_credits >= 0
+        AND max_term_credits > 0
+        AND min_term_credits <= max_term_credits
+        AND max_term_credits <= good_standing_max_credits);
+ALTER TABLE degrees ADD CONSTRAINT chk_degrees_good_standing_average
+    CHECK (good_standing_average BETWEEN 0 AND 100);
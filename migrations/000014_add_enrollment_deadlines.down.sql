@@ -0,0 +1,12 @@
+ALTER TABLE enrollments DROP COLUMN IF EXISTS drop_override_by;
+
+UPDATE enrollments SET status = 'dropped' WHERE status = 'withdrawn';
+ALTER TABLE enrollments DROP CONSTRAINT IF EXISTS chk_enrollments_status;
+ALTER TABLE enrollments ADD CONSTRAINT enrollments_status_check
+    CHECK (status IN ('enrolled', 'dropped', 'completed', 'failed'));
+
+ALTER TABLE academic_periods DROP CONSTRAINT IF EXISTS chk_academic_periods_deadlines;
+
+ALTER TABLE academic_periods DROP COLUMN IF EXISTS withdrawal_deadline;
+ALTER TABLE academic_periods DROP COLUMN IF EXISTS drop_deadline;
+ALTER TABLE academic_periods DROP COLUMN IF EXISTS add_deadline;
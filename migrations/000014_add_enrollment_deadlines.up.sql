@@ -0,0 +1,19 @@
+-- Enrollment deadlines of each period, NULL values fall back to the
+-- registration end for adds and drops and to the period end for withdrawals
+ALTER TABLE academic_periods ADD COLUMN IF NOT EXISTS add_deadline DATE;
+ALTER TABLE academic_periods ADD COLUMN IF NOT EXISTS drop_deadline DATE;
+ALTER TABLE academic_periods ADD COLUMN IF NOT EXISTS withdrawal_deadline DATE;
+
+ALTER TABLE academic_periods ADD CONSTRAINT chk_academic_periods_deadlines
+    CHECK (COALESCE(add_deadline, registration_end) >= registration_end
+       AND COALESCE(drop_deadline, add_deadline, registration_end) >= COALESCE(add_deadline, registration_end)
+       AND COALESCE(withdrawal_deadline, end_date) >= COALESCE(drop_deadline, add_deadline, registration_end)
+       AND COALESCE(withdrawal_deadline, end_date) <= end_date) NOT VALID;
+
+-- Withdrawals after the drop deadline stay on the transcript with a W
+ALTER TABLE enrollments DROP CONSTRAINT IF EXISTS enrollments_status_check;
+ALTER TABLE enrollments ADD CONSTRAINT chk_enrollments_status
+    CHECK (status IN ('enrolled', 'dropped', 'withdrawn', 'completed', 'failed'));
+
+-- Admin that allowed a withdrawal after the deadline
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS drop_override_by UUID REFERENCES users(id);
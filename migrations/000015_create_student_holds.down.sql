@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_student_holds_active;
+DROP TABLE IF EXISTS student_holds;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS student_holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    student_id UUID NOT NULL REFERENCES students(id) ON DELETE CASCADE,
+    hold_type VARCHAR(20) NOT NULL
+        CHECK (hold_type IN ('financial', 'disciplinary', 'advising')),
+    reason TEXT NOT NULL CHECK (length(trim(reason)) > 0),
+    -- Informative holds are shown on the profile but don't block enrollment
+    blocks_enrollment BOOLEAN NOT NULL DEFAULT true,
+    placed_by UUID REFERENCES users(id),
+    -- NULL keeps the hold until it is released
+    expires_at TIMESTAMPTZ,
+    released_at TIMESTAMPTZ,
+    released_by UUID REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Enrollment checks and profiles look up the unreleased holds of a student
+CREATE INDEX IF NOT EXISTS idx_student_holds_active ON student_holds(student_id) WHERE released_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_registration_tickets_period_rank;
+DROP TABLE IF EXISTS registration_tickets;
+DROP TABLE IF EXISTS student_priority_groups;
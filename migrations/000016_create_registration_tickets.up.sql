@@ -0,0 +1,27 @@
+-- Special groups, like athletes or students with accommodations, that
+-- register before everyone else
+CREATE TABLE IF NOT EXISTS student_priority_groups (
+    student_id UUID NOT NULL REFERENCES students(id) ON DELETE CASCADE,
+    group_code VARCHAR(30) NOT NULL CHECK (length(trim(group_code)) > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (student_id, group_code)
+);
+
+-- Moment each student may start enrolling in a period, the ranking data is
+-- kept as it was when the tickets were computed
+CREATE TABLE IF NOT EXISTS registration_tickets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    academic_period_id UUID NOT NULL REFERENCES academic_periods(id) ON DELETE CASCADE,
+    student_id UUID NOT NULL REFERENCES students(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    priority_rank INTEGER NOT NULL CHECK (priority_rank > 0),
+    credits_earned INTEGER NOT NULL DEFAULT 0,
+    degree_type VARCHAR(50),
+    priority_groups TEXT[],
+    -- Unpublished tickets are a draft and don't restrict enrollment
+    published_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (academic_period_id, student_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_registration_tickets_period_rank ON registration_tickets(academic_period_id, priority_rank);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS submission_versions;
+ALTER TABLE submissions
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,16 @@
+-- Version 0 marks the missing submissions nobody handed in
+ALTER TABLE submissions
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1 CHECK (version >= 0),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+-- Every version a student hands in, the submissions row holds the latest one
+CREATE TABLE IF NOT EXISTS submission_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    submission_id UUID NOT NULL REFERENCES submissions(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL CHECK (version > 0),
+    content TEXT,
+    attachments JSONB,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('submitted', 'late')),
+    submitted_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (submission_id, version)
+);
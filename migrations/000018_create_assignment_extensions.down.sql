@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_assignment_extensions_blanket;
+DROP INDEX IF EXISTS idx_assignment_extensions_assignment;
+DROP TABLE IF EXISTS assignment_extensions;
@@ -0,0 +1,21 @@
+-- Extra time granted to a student with accommodations, on one assignment or
+-- on every assignment of the section when assignment_id is NULL
+CREATE TABLE IF NOT EXISTS assignment_extensions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_section_id UUID NOT NULL REFERENCES course_sections(id) ON DELETE CASCADE,
+    student_id UUID NOT NULL REFERENCES students(id) ON DELETE CASCADE,
+    assignment_id UUID REFERENCES assignments(id) ON DELETE CASCADE,
+    -- Share of the time the assignment gave, from its creation to its due date
+    percentage INTEGER CHECK (percentage BETWEEN 1 AND 300),
+    offset_minutes INTEGER CHECK (offset_minutes BETWEEN 1 AND 43200),
+    reason TEXT NOT NULL CHECK (length(trim(reason)) > 0),
+    granted_by UUID REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((percentage IS NULL) <> (offset_minutes IS NULL))
+);
+
+-- A student holds at most one extension per assignment and one blanket one
+CREATE UNIQUE INDEX IF NOT EXISTS idx_assignment_extensions_assignment
+    ON assignment_extensions(student_id, assignment_id) WHERE assignment_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_assignment_extensions_blanket
+    ON assignment_extensions(student_id, course_section_id) WHERE assignment_id IS NULL;
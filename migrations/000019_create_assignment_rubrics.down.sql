@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_submission_feedbacks_submission;
+ALTER TABLE submission_feedbacks
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS released_at,
+    DROP COLUMN IF EXISTS grade_percentage,
+    DROP COLUMN IF EXISTS points_earned,
+    DROP COLUMN IF EXISTS submission_version;
+DROP TABLE IF EXISTS assignment_rubrics;
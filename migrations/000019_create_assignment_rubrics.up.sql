@@ -0,0 +1,16 @@
+-- Grading guide of an assignment: [{id, title, levels: [{id, title, points}]}]
+CREATE TABLE IF NOT EXISTS assignment_rubrics (
+    assignment_id UUID PRIMARY KEY REFERENCES assignments(id) ON DELETE CASCADE,
+    criteria JSONB NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Drafts keep their score here until they are released to the submission
+ALTER TABLE submission_feedbacks
+    ADD COLUMN IF NOT EXISTS submission_version INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS points_earned DECIMAL(5,2),
+    ADD COLUMN IF NOT EXISTS grade_percentage DECIMAL(5,2),
+    ADD COLUMN IF NOT EXISTS released_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_submission_feedbacks_submission ON submission_feedbacks(submission_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_final_grades_enrollment;
+ALTER TABLE final_grades DROP COLUMN IF EXISTS updated_at;
+DROP TABLE IF EXISTS grading_schemes;
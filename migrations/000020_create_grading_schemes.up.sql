@@ -0,0 +1,13 @@
+-- Weights of the assignment types in the final score of a section:
+-- [{assignment_type, weight, drop_lowest, extra_credit}]
+CREATE TABLE IF NOT EXISTS grading_schemes (
+    course_section_id UUID PRIMARY KEY REFERENCES course_sections(id) ON DELETE CASCADE,
+    categories JSONB NOT NULL,
+    updated_by UUID REFERENCES users(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE final_grades ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+-- An enrollment has a single final grade
+CREATE UNIQUE INDEX IF NOT EXISTS idx_final_grades_enrollment ON final_grades(enrollment_id);
@@ -0,0 +1,6 @@
+ALTER TABLE final_grades DROP COLUMN IF EXISTS grade_points;
+ALTER TABLE departments DROP COLUMN IF EXISTS grade_scale_id;
+ALTER TABLE degrees DROP COLUMN IF EXISTS grade_scale_id;
+DROP TABLE IF EXISTS grade_scale_bands;
+DROP INDEX IF EXISTS idx_grade_scales_default;
+DROP TABLE IF EXISTS grade_scales;
@@ -0,0 +1,53 @@
+-- Letter grade scales. A final score gets the letter of the band with the
+-- highest min_score it reaches. The scale of the degree of the student
+-- applies, then the one of the department offering the course and finally
+-- the default scale.
+CREATE TABLE IF NOT EXISTS grade_scales (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- A single scale is the default one
+CREATE UNIQUE INDEX IF NOT EXISTS idx_grade_scales_default ON grade_scales(is_default) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS grade_scale_bands (
+    grade_scale_id UUID NOT NULL REFERENCES grade_scales(id) ON DELETE CASCADE,
+    letter VARCHAR(2) NOT NULL,
+    min_score DECIMAL(5,2) NOT NULL CHECK (min_score BETWEEN 0 AND 100),
+    grade_points DECIMAL(3,2) NOT NULL CHECK (grade_points BETWEEN 0 AND 5),
+    is_passing BOOLEAN NOT NULL,
+    PRIMARY KEY (grade_scale_id, letter),
+    UNIQUE (grade_scale_id, min_score)
+);
+
+ALTER TABLE degrees ADD COLUMN IF NOT EXISTS grade_scale_id UUID REFERENCES grade_scales(id);
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS grade_scale_id UUID REFERENCES grade_scales(id);
+
+-- The letter of a final grade is derived from its score
+ALTER TABLE final_grades ADD COLUMN IF NOT EXISTS grade_points DECIMAL(3,2);
+
+WITH standard AS (
+    INSERT INTO grade_scales (name, is_default)
+    VALUES ('Standard', true)
+    ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+    RETURNING id
+)
+INSERT INTO grade_scale_bands (grade_scale_id, letter, min_score, grade_points, is_passing)
+SELECT standard.id, band.letter, band.min_score, band.grade_points, band.is_passing
+FROM standard, (VALUES
+    ('A', 93, 4.0, true),
+    ('A-', 90, 3.7, true),
+    ('B+', 87, 3.3, true),
+    ('B', 83, 3.0, true),
+    ('B-', 80, 2.7, true),
+    ('C+', 77, 2.3, true),
+    ('C', 73, 2.0, true),
+    ('C-', 70, 1.7, true),
+    ('D+', 67, 1.3, true),
+    ('D', 60, 1.0, true),
+    ('F', 0, 0.0, false)
+) AS band(letter, min_score, grade_points, is_passing)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,11 @@
+ALTER TABLE enrollments DROP COLUMN IF EXISTS grade_points;
+UPDATE enrollments SET final_grade = 99.99 WHERE final_grade > 99.99;
+ALTER TABLE enrollments ALTER COLUMN final_grade TYPE DECIMAL(4,2);
+
+DROP INDEX IF EXISTS idx_grade_change_requests_pending;
+DROP INDEX IF EXISTS idx_grade_change_requests_grade;
+DROP TABLE IF EXISTS grade_change_requests;
+
+DROP INDEX IF EXISTS idx_final_grade_reviews_open;
+DROP INDEX IF EXISTS idx_final_grade_reviews_section;
+DROP TABLE IF EXISTS final_grade_reviews;
@@ -0,0 +1,48 @@
+-- Each time the professor submits the final grades of a section the head of
+-- the department offering the course approves or returns them
+CREATE TABLE IF NOT EXISTS final_grade_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_section_id UUID NOT NULL REFERENCES course_sections(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'submitted'
+        CHECK (status IN ('submitted', 'returned', 'approved')),
+    submitted_by UUID NOT NULL REFERENCES users(id),
+    submitted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reviewed_by UUID REFERENCES users(id),
+    reviewed_at TIMESTAMPTZ,
+    comments TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_final_grade_reviews_section ON final_grade_reviews(course_section_id, submitted_at);
+
+-- A section has at most one review open and is approved once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_final_grade_reviews_open
+    ON final_grade_reviews(course_section_id) WHERE status IN ('submitted', 'approved');
+
+-- Published grades change through requests approved by the department head,
+-- the previous values are kept as the audit trail
+CREATE TABLE IF NOT EXISTS grade_change_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    final_grade_id UUID NOT NULL REFERENCES final_grades(id) ON DELETE CASCADE,
+    requested_by UUID NOT NULL REFERENCES users(id),
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reason TEXT NOT NULL,
+    previous_score DECIMAL(5,2) NOT NULL,
+    previous_letter VARCHAR(2),
+    new_score DECIMAL(5,2) NOT NULL CHECK (new_score BETWEEN 0 AND 100),
+    new_letter VARCHAR(2),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    reviewed_by UUID REFERENCES users(id),
+    reviewed_at TIMESTAMPTZ,
+    review_comments TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_grade_change_requests_grade ON grade_change_requests(final_grade_id);
+
+-- A grade has at most one change pending
+CREATE UNIQUE INDEX IF NOT EXISTS idx_grade_change_requests_pending
+    ON grade_change_requests(final_grade_id) WHERE status = 'pending';
+
+-- final_grade has to hold 100
+ALTER TABLE enrollments ALTER COLUMN final_grade TYPE DECIMAL(5,2);
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS grade_points DECIMAL(3,2);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_academic_records_standing;
+DROP TABLE IF EXISTS academic_records;
@@ -0,0 +1,14 @@
+-- GPA and academic standing of each student, recomputed when their grades
+-- are published. record holds the terms and courses the figures come from.
+CREATE TABLE IF NOT EXISTS academic_records (
+    student_id UUID PRIMARY KEY REFERENCES students(id) ON DELETE CASCADE,
+    cumulative_gpa DECIMAL(3,2),
+    credits_attempted INTEGER NOT NULL DEFAULT 0,
+    credits_earned INTEGER NOT NULL DEFAULT 0,
+    standing VARCHAR(20) NOT NULL DEFAULT 'good_standing'
+        CHECK (standing IN ('good_standing', 'probation', 'dismissal')),
+    record JSONB NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_academic_records_standing ON academic_records(standing);
@@ -0,0 +1,2 @@
+-- The backfilled curricula are kept, they can't be told apart from assigned ones
+SELECT 1;
@@ -0,0 +1,11 @@
+-- Students admitted before their degree had a published curriculum take the latest one
+UPDATE students s SET
+    curriculum_id = (
+        SELECT cu.id FROM curricula cu
+        WHERE cu.degree_id = s.degree_id AND cu.status = 'published'
+        ORDER BY cu.version DESC
+        LIMIT 1
+    ),
+    updated_at = NOW()
+WHERE s.curriculum_id IS NULL
+  AND EXISTS (SELECT 1 FROM curricula cu WHERE cu.degree_id = s.degree_id AND cu.status = 'published');
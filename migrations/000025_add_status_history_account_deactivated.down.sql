@@ -0,0 +1 @@
+ALTER TABLE student_status_history DROP COLUMN IF EXISTS account_deactivated;
@@ -0,0 +1,3 @@
+-- Dropouts whose login was turned off by the status change, readmission only
+-- turns back on those
+ALTER TABLE student_status_history ADD COLUMN IF NOT EXISTS account_deactivated BOOLEAN NOT NULL DEFAULT false;